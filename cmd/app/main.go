@@ -18,6 +18,12 @@ import (
 // @Description                **Each endpoint that requires authorization MUST be used with CSRF token in header. **
 // @Description                **Example usage: `X-CSRF-Token your-token`**.
 
+// @SecurityDefinitions.apikey Bearer
+// @In                         header
+// @Name                       Authorization
+// @Description                Personal access token for machine clients, CSRF token is not required with it.
+// @Description                **Example usage: `Authorization: Bearer wst_your-token`**.
+
 func main() {
 	var configPath string
 	flag.StringVar(&configPath, "config-path", "config.yml", "Path to application config file.")
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	"web-studio-backend/internal/app/app"
+	"web-studio-backend/internal/app/infrastructure/repository/filesystem"
+	"web-studio-backend/internal/app/infrastructure/repository/s3"
+	"web-studio-backend/internal/pkg/config"
+)
+
+// Copies files stored by the filesystem backend into the S3 storage configured in storage.s3 section.
+// Files already present in the bucket are skipped unless -overwrite is set, so it is safe to rerun.
+func main() {
+	var (
+		configPath string
+		srcDir     string
+		overwrite  bool
+		dryRun     bool
+	)
+	flag.StringVar(&configPath, "config-path", "config.default.yml", "Path to application config file.")
+	flag.StringVar(&srcDir, "src", "", "Filesystem storage directory, storage.dir from config by default.")
+	flag.BoolVar(&overwrite, "overwrite", false, "Overwrite files which already exist in the bucket.")
+	flag.BoolVar(&dryRun, "dry-run", false, "Only print files which would be copied.")
+	flag.Parse()
+
+	config.Read(configPath)
+	cfg := config.Get()
+
+	if srcDir == "" {
+		srcDir = cfg.Storage.Dir
+	}
+
+	if _, err := os.Stat(srcDir); err != nil {
+		log.Fatalf("checking source directory: %v", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	src, err := filesystem.New(srcDir)
+	if err != nil {
+		log.Fatalf("opening filesystem storage: %v", err)
+	}
+
+	s3Cfg, err := app.S3Config(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dst, err := s3.New(ctx, s3Cfg)
+	if err != nil {
+		log.Fatalf("connecting to s3 storage: %v", err)
+	}
+
+	var copied, skipped int
+	err = src.Walk(ctx, func(fileName string) error {
+		if !overwrite {
+			exists, err := dst.Exists(ctx, fileName)
+			if err != nil {
+				return fmt.Errorf("checking %s: %w", fileName, err)
+			}
+			if exists {
+				skipped++
+				return nil
+			}
+		}
+
+		if dryRun {
+			fmt.Println("Would copy:", fileName)
+			copied++
+			return nil
+		}
+
+		file, err := src.Open(ctx, fileName)
+		if err != nil {
+			return fmt.Errorf("opening %s: %w", fileName, err)
+		}
+		defer file.Close()
+
+		if file.Size == 0 {
+			fmt.Println("Skipping empty file:", fileName)
+			skipped++
+			return nil
+		}
+
+		err = dst.Save(ctx, file, file.Size, fileName)
+		if err != nil {
+			return fmt.Errorf("saving %s: %w", fileName, err)
+		}
+
+		fmt.Println("Copied:", fileName)
+		copied++
+		return nil
+	})
+	if err != nil {
+		log.Fatalf("migrating files (copied %d, skipped %d): %v", copied, skipped, err)
+	}
+
+	fmt.Printf("Files migration done: copied %d, skipped %d.\n", copied, skipped)
+}
@@ -9,10 +9,16 @@ import (
 	"web-studio-backend/internal/app/infrastructure/repository/postgresql"
 	"web-studio-backend/internal/app/service"
 	"web-studio-backend/internal/pkg/config"
+	"web-studio-backend/internal/pkg/mail"
+	"web-studio-backend/internal/pkg/passhash"
 	"web-studio-backend/internal/pkg/wcrypto"
 	"web-studio-backend/pkg/postgres"
 )
 
+type nopAuditor struct{}
+
+func (nopAuditor) Record(context.Context, domain.AuditAction, domain.AuditEntity, int32, any, any) {}
+
 func main() {
 	config.Read("config.yml")
 
@@ -34,7 +40,13 @@ func main() {
 
 	userRepo := postgresql.NewUserRepository(pg.Pool)
 	fs, _ := filesystem.New("")
-	userService := service.NewUserService(userRepo, fs)
+	hasher, err := passhash.New(cfg.Password.Algorithm)
+	if err != nil {
+		log.Fatalf("creating password hasher: %v", err)
+	}
+	// The first user is created by nobody, so there is no actor to audit
+	accounts := service.NewAccountMailer(postgresql.NewUserTokenRepository(pg.Pool), mail.Log{}, cfg.Mail.LinkURL)
+	userService := service.NewUserService(userRepo, fs, postgresql.NewSessionRepository(pg.Pool), postgresql.NewAPITokenRepository(pg.Pool), hasher, accounts, nopAuditor{})
 
 	_, err = userService.CreateUser(context.Background(), &domain.User{
 		Name:            "test",
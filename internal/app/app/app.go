@@ -11,10 +11,11 @@ import (
 	"time"
 
 	"web-studio-backend/internal/app/handler/http"
-	"web-studio-backend/internal/app/infrastructure/repository/filesystem"
 	"web-studio-backend/internal/app/infrastructure/repository/postgresql"
 	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/pkg/auth/session"
 	"web-studio-backend/internal/pkg/config"
+	"web-studio-backend/internal/pkg/passhash"
 	"web-studio-backend/internal/pkg/wcrypto"
 	"web-studio-backend/pkg/postgres"
 
@@ -22,8 +23,6 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
-const filesDir = "files"
-
 func Run(configPath string) error {
 	config.Read(configPath)
 
@@ -66,20 +65,94 @@ func Run(configPath string) error {
 	documentRepo := postgresql.NewDocumentRepository(pg.Pool)
 	teamRepo := postgresql.NewTeamRepository(pg.Pool)
 	projectCategoryRepo := postgresql.NewProjectCategoryRepository(pg.Pool)
+	mediaRepo := postgresql.NewProjectMediaRepository(pg.Pool)
+	techRepo := postgresql.NewTechnologyRepository(pg.Pool)
+	boardRepo := postgresql.NewBoardRepository(pg.Pool)
+	searchRepo := postgresql.NewSearchRepository(pg.Pool)
+	auditRepo := postgresql.NewAuditRepository(pg.Pool)
+	tokenRepo := postgresql.NewUserTokenRepository(pg.Pool)
+	apiTokenRepo := postgresql.NewAPITokenRepository(pg.Pool)
+	oidcRepo := postgresql.NewOIDCRepository(pg.Pool)
+	twoFactorRepo := postgresql.NewTwoFactorRepository(pg.Pool)
+	signInThrottleRepo := postgresql.NewSignInThrottleRepository(pg.Pool)
+	txManager := postgresql.NewTxManager(pg.Pool)
+
+	// Session store initialization
+	var sessionStore service.SessionStore
+	switch cfg.Session.Store {
+	case "memory":
+		sessionStore = session.NewMemoryStore()
+	case "postgres":
+		sessionStore = postgresql.NewSessionRepository(pg.Pool)
+	default:
+		return fmt.Errorf("unknown session store %q", cfg.Session.Store)
+	}
+
+	sweepCtx, stopSweep := context.WithCancel(context.Background())
+	defer stopSweep()
+	go session.Sweep(sweepCtx, sessionStore, cfg.Session.SweepInterval)
+
+	// Password hasher initialization
+	hasher, err := passhash.New(cfg.Password.Algorithm)
+	if err != nil {
+		return fmt.Errorf("creating password hasher: %w", err)
+	}
+
+	// File storage initialization
+	filesFS, err := newFileRepository(runCtx, cfg)
+	if err != nil {
+		return fmt.Errorf("creating file storage: %w", err)
+	}
+
+	// Mailer initialization
+	mailer, err := newMailer(cfg)
+	if err != nil {
+		return fmt.Errorf("creating mailer: %w", err)
+	}
 
-	// FS storage initialization
-	filesFS, err := filesystem.New(filesDir)
+	// SSO providers initialization
+	oidcProviders, err := newOIDCProviders(cfg)
 	if err != nil {
-		return fmt.Errorf("creating images fs: %w", err)
+		return fmt.Errorf("creating sso providers: %w", err)
 	}
 
 	// Services initialization
-	userService := service.NewUserService(userRepo, filesFS)
-	projectService := service.NewProjectService(projectRepo, userRepo, teamRepo, filesFS)
-	authService := service.NewAuthService(userRepo)
-	documentService := service.NewDocumentService(documentRepo, projectRepo, filesFS)
-	teamService := service.NewTeamService(teamRepo, filesFS)
-	projectCategoryService := service.NewProjectCategoryService(projectCategoryRepo)
+	accountMailer := service.NewAccountMailer(tokenRepo, mailer, cfg.Mail.LinkURL)
+	auditService := service.NewAuditService(auditRepo)
+	signInLimiter := service.NewSignInLimiter(signInThrottleRepo, txManager, auditService, service.SignInPolicy{
+		FreeAttempts:    cfg.SignIn.FreeAttempts,
+		IPFreeAttempts:  cfg.SignIn.IPFreeAttempts,
+		BackoffBase:     cfg.SignIn.BackoffBase,
+		BackoffMax:      cfg.SignIn.BackoffMax,
+		LockoutAttempts: cfg.SignIn.LockoutAttempts,
+		LockoutDuration: cfg.SignIn.LockoutDuration,
+		Window:          cfg.SignIn.Window,
+	})
+	twoFactorService := service.NewTwoFactorService(twoFactorRepo, sessionStore, signInLimiter, txManager, config.Block, service.TwoFactorPolicy{
+		Issuer:           cfg.TwoFactor.Issuer,
+		RequireForAdmins: cfg.TwoFactor.RequireForAdmins,
+	})
+	locales := service.Locales{Default: cfg.Locale.Default, Supported: cfg.Locale.Supported}
+	userService := service.NewUserService(userRepo, filesFS, sessionStore, apiTokenRepo, hasher, accountMailer, auditService)
+	projectService := service.NewProjectService(projectRepo, userRepo, teamRepo, documentRepo, mediaRepo, techRepo, filesFS, txManager, auditService, locales)
+	authService := service.NewAuthService(userRepo, sessionStore, apiTokenRepo, hasher, accountMailer, twoFactorService, signInLimiter)
+	documentService := service.NewDocumentService(documentRepo, projectRepo, filesFS, txManager, auditService)
+	teamService := service.NewTeamService(teamRepo, userRepo, filesFS, txManager, auditService, locales)
+	projectCategoryService := service.NewProjectCategoryService(projectCategoryRepo, auditService)
+	boardService := service.NewBoardService(boardRepo, projectRepo, userRepo)
+	searchService := service.NewSearchService(searchRepo)
+	apiTokenService := service.NewAPITokenService(apiTokenRepo)
+	oidcService := service.NewOIDCService(oidcProviders, oidcRepo, userRepo, twoFactorService)
+	publicService := service.NewPublicService(projectRepo, teamRepo, projectCategoryRepo, mediaRepo, techRepo, filesFS, locales)
+	mediaService := service.NewMediaService(mediaRepo, projectRepo, filesFS, txManager, auditService)
+	technologyService := service.NewTechnologyService(techRepo, projectRepo, auditService)
+
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	go purgeTrash(purgeCtx, cfg.Trash.PurgeInterval, cfg.Trash.Retention, map[string]trashPurger{
+		"project":  projectService,
+		"document": documentService,
+	})
 
 	// Handler initialization
 	handler := http.NewHandler(
@@ -89,6 +162,15 @@ func Run(configPath string) error {
 		documentService,
 		teamService,
 		projectCategoryService,
+		boardService,
+		searchService,
+		auditService,
+		apiTokenService,
+		oidcService,
+		twoFactorService,
+		publicService,
+		mediaService,
+		technologyService,
 	)
 
 	httpServer := &stdhttp.Server{
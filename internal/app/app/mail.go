@@ -0,0 +1,38 @@
+package app
+
+import (
+	"fmt"
+
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/pkg/config"
+	"web-studio-backend/internal/pkg/mail"
+	"web-studio-backend/internal/pkg/wcrypto"
+)
+
+// newMailer creates the mail backend selected in config.
+func newMailer(cfg *config.Config) (service.Mailer, error) {
+	switch cfg.Mail.Backend {
+	case "smtp":
+		username, password := cfg.Mail.SMTP.Username, cfg.Mail.SMTP.Password
+		if username != "" {
+			var err error
+			username, password, err = wcrypto.DecodeUserPass(username, password, config.Block)
+			if err != nil {
+				return nil, fmt.Errorf("decoding smtp credentials: %w", err)
+			}
+		}
+		return mail.NewSMTP(mail.SMTPConfig{
+			Host:     cfg.Mail.SMTP.Host,
+			Port:     cfg.Mail.SMTP.Port,
+			Username: username,
+			Password: password,
+			From:     cfg.Mail.From,
+		}), nil
+	case "file":
+		return mail.NewFile(cfg.Mail.Dir, cfg.Mail.From)
+	case "log":
+		return mail.Log{}, nil
+	default:
+		return nil, fmt.Errorf("unknown mail backend %q", cfg.Mail.Backend)
+	}
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"fmt"
+
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/pkg/config"
+	"web-studio-backend/internal/pkg/oidc"
+	"web-studio-backend/internal/pkg/wcrypto"
+)
+
+// newOIDCProviders creates identity providers configured for SSO.
+func newOIDCProviders(cfg *config.Config) (map[string]service.OIDCProvider, error) {
+	providers := make(map[string]service.OIDCProvider, len(cfg.OIDC.Providers))
+
+	for _, p := range cfg.OIDC.Providers {
+		if p.Name == "" || p.Issuer == "" {
+			return nil, fmt.Errorf("provider name and issuer are required")
+		}
+		if _, ok := providers[p.Name]; ok {
+			return nil, fmt.Errorf("duplicate provider %q", p.Name)
+		}
+
+		clientID, clientSecret, err := wcrypto.DecodeUserPass(p.ClientID, p.ClientSecret, config.Block)
+		if err != nil {
+			return nil, fmt.Errorf("decoding %s client credentials: %w", p.Name, err)
+		}
+
+		scopes := p.Scopes
+		if len(scopes) == 0 {
+			scopes = []string{"email", "profile"}
+		}
+
+		providers[p.Name] = oidc.NewProvider(oidc.Config{
+			Issuer:       p.Issuer,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       scopes,
+		}, nil)
+	}
+
+	return providers, nil
+}
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"web-studio-backend/internal/app/infrastructure/repository/filesystem"
+	"web-studio-backend/internal/app/infrastructure/repository/s3"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/pkg/config"
+	"web-studio-backend/internal/pkg/wcrypto"
+)
+
+// newFileRepository creates the file storage backend selected in config.
+func newFileRepository(ctx context.Context, cfg *config.Config) (service.FileRepository, error) {
+	switch cfg.Storage.Backend {
+	case "filesystem":
+		return filesystem.New(cfg.Storage.Dir)
+	case "s3":
+		s3Cfg, err := S3Config(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return s3.New(ctx, s3Cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Storage.Backend)
+	}
+}
+
+// S3Config returns S3 storage settings with decoded credentials.
+func S3Config(cfg *config.Config) (s3.Config, error) {
+	accessKey, secretKey, err := wcrypto.DecodeUserPass(cfg.Storage.S3.AccessKey, cfg.Storage.S3.SecretKey, config.Block)
+	if err != nil {
+		return s3.Config{}, fmt.Errorf("decoding s3 credentials: %w", err)
+	}
+
+	return s3.Config{
+		Endpoint:  cfg.Storage.S3.Endpoint,
+		Region:    cfg.Storage.S3.Region,
+		Bucket:    cfg.Storage.S3.Bucket,
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+		UseSSL:    cfg.Storage.S3.UseSSL,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type trashPurger interface {
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+}
+
+// purgeTrash periodically deletes objects which stayed in the trash longer than retention until ctx is done.
+func purgeTrash(ctx context.Context, interval, retention time.Duration, purgers map[string]trashPurger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			before := time.Now().Add(-retention)
+
+			for name, p := range purgers {
+				n, err := p.PurgeDeleted(ctx, before)
+				if n > 0 {
+					slog.Info("Deleted objects purged", slog.String("type", name), slog.Int("count", n))
+				}
+				if err != nil {
+					slog.Error("Purging deleted objects", slog.String("type", name), slog.String("error", err.Error()))
+				}
+			}
+		}
+	}
+}
@@ -10,6 +10,7 @@ const (
 	InvalidRequestType
 	DisabledType
 	DuplicateType
+	TooManyRequestsType
 )
 
 type (
@@ -73,6 +74,10 @@ func NewDuplicate(msg, field string) error {
 	return New(DuplicateType, msg, field)
 }
 
+func NewTooManyRequests(msg string) error {
+	return New(TooManyRequestsType, msg, "")
+}
+
 func NewInternal(msg string) error {
 	return New(InternalType, msg, "")
 }
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionCreate   AuditAction = "create"
+	AuditActionUpdate   AuditAction = "update"
+	AuditActionDelete   AuditAction = "delete"
+	AuditActionDisable  AuditAction = "disable"
+	AuditActionEnable   AuditAction = "enable"
+	AuditActionSetImage AuditAction = "set_image"
+	AuditActionRestore  AuditAction = "restore"
+
+	AuditActionAddParticipant    AuditAction = "add_participant"
+	AuditActionUpdateParticipant AuditAction = "update_participant"
+	AuditActionRemoveParticipant AuditAction = "remove_participant"
+
+	AuditActionAddMember    AuditAction = "add_member"
+	AuditActionUpdateMember AuditAction = "update_member"
+	AuditActionRemoveMember AuditAction = "remove_member"
+
+	AuditActionAddVersion     AuditAction = "add_version"
+	AuditActionRestoreVersion AuditAction = "restore_version"
+
+	AuditActionLock   AuditAction = "lock"
+	AuditActionUnlock AuditAction = "unlock"
+
+	AuditActionSetTranslation    AuditAction = "set_translation"
+	AuditActionDeleteTranslation AuditAction = "delete_translation"
+
+	AuditActionAddMedia     AuditAction = "add_media"
+	AuditActionUpdateMedia  AuditAction = "update_media"
+	AuditActionRemoveMedia  AuditAction = "remove_media"
+	AuditActionReorderMedia AuditAction = "reorder_media"
+)
+
+type AuditEntity string
+
+const (
+	AuditEntityUser            AuditEntity = "user"
+	AuditEntityProject         AuditEntity = "project"
+	AuditEntityTeam            AuditEntity = "team"
+	AuditEntityProjectCategory AuditEntity = "project_category"
+	AuditEntityDocument        AuditEntity = "document"
+	AuditEntityTechnology      AuditEntity = "technology"
+)
+
+type (
+	AuditRecord struct {
+		ID         int64       `json:"id"`
+		ActorID    *int32      `json:"actorID"` // Empty for events recorded by the application itself
+		Action     AuditAction `json:"action"`
+		EntityType AuditEntity `json:"entityType"`
+		EntityID   int32       `json:"entityID"`
+		// Diff is a JSON object with changed fields: {"field": {"old": ..., "new": ...}}
+		Diff      json.RawMessage `json:"diff" swaggertype:"object"`
+		CreatedAt time.Time       `json:"createdAt"`
+	}
+
+	AuditFilter struct {
+		ActorID    *int32
+		EntityType *AuditEntity
+		EntityID   *int32
+		From       *time.Time
+		To         *time.Time
+	}
+
+	auditChange struct {
+		Old any `json:"old,omitempty"`
+		New any `json:"new,omitempty"`
+	}
+)
+
+// NewAuditDiff compares JSON representations of the objects and returns changed fields.
+// Nil before means the object was created, nil after means it was deleted.
+// Fields hidden from JSON, such as passwords, never get into the diff.
+func NewAuditDiff(before, after any) (json.RawMessage, error) {
+	oldFields, err := jsonFields(before)
+	if err != nil {
+		return nil, fmt.Errorf("converting old object: %w", err)
+	}
+
+	newFields, err := jsonFields(after)
+	if err != nil {
+		return nil, fmt.Errorf("converting new object: %w", err)
+	}
+
+	diff := map[string]auditChange{}
+	for field, newVal := range newFields {
+		if oldVal, ok := oldFields[field]; !ok || !reflect.DeepEqual(oldVal, newVal) {
+			diff[field] = auditChange{Old: oldVal, New: newVal}
+		}
+	}
+	for field, oldVal := range oldFields {
+		if _, ok := newFields[field]; !ok {
+			diff[field] = auditChange{Old: oldVal}
+		}
+	}
+
+	return json.Marshal(diff)
+}
+
+func jsonFields(v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	if err = json.Unmarshal(buf, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		before any
+		after  any
+		diff   string
+	}{
+		{
+			name:   "created",
+			before: nil,
+			after:  &ProjectCategory{ID: 1, Name: "Web"},
+			diff:   `{"id":{"new":1},"name":{"new":"Web"}}`,
+		},
+		{
+			name:   "updated",
+			before: &ProjectCategory{ID: 1, Name: "Web"},
+			after:  &ProjectCategory{ID: 1, Name: "Mobile"},
+			diff:   `{"name":{"old":"Web","new":"Mobile"}}`,
+		},
+		{
+			name:   "deleted",
+			before: &ProjectCategory{ID: 1, Name: "Web"},
+			after:  nil,
+			diff:   `{"id":{"old":1},"name":{"old":"Web"}}`,
+		},
+		{
+			name:   "hidden fields",
+			before: &User{ID: 1, EncodedPassword: "old"},
+			after:  &User{ID: 1, EncodedPassword: "new"},
+			diff:   `{}`,
+		},
+		{
+			name:   "nothing",
+			before: nil,
+			after:  nil,
+			diff:   `{}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			diff, err := NewAuditDiff(tc.before, tc.after)
+			require.NoError(tt, err)
+			require.JSONEq(tt, tc.diff, string(diff))
+		})
+	}
+}
@@ -1,21 +1,58 @@
 package domain
 
+import "time"
+
 type (
 	AuthContext struct {
-		UserID   int32
-		Username string
-		Email    string
-		Role     UserRole
+		UserID    int32
+		Username  string
+		Email     string
+		Role      UserRole
+		SessionID string // Hash of the session ID, empty for API tokens
 	}
 
 	SignInRequest struct {
-		Login    string `json:"login"`
-		Password string `json:"password"`
+		Login     string `json:"login"`
+		Password  string `json:"password"`
+		IP        string `json:"-"` // Filled from the HTTP request
+		UserAgent string `json:"-"`
 	}
 
+	// SignInResponse either starts a session or asks for the second factor.
 	SignInResponse struct {
-		SessionID string `json:"-"`
-		CSRFToken string `json:"csrfToken"`
-		UserID    int32  `json:"userID"`
+		SessionID         string `json:"-"`
+		CSRFToken         string `json:"csrfToken,omitempty"`
+		UserID            int32  `json:"userID"`
+		TwoFactorRequired bool   `json:"twoFactorRequired,omitempty"`
+		TwoFactorToken    string `json:"twoFactorToken,omitempty"` // Must be sent with the code to finish sign in
+	}
+
+	ForgotPasswordRequest struct {
+		Email string `json:"email"`
+	}
+
+	ResetPasswordRequest struct {
+		Token    string `json:"token"` // Token from the link sent by email
+		Password string `json:"password"`
+	}
+
+	ChangePasswordRequest struct {
+		CurrentPassword string `json:"currentPassword"`
+		NewPassword     string `json:"newPassword"`
+	}
+
+	// Session describes where the user is logged in.
+	Session struct {
+		ID         string    `json:"id"`
+		IP         string    `json:"ip"`
+		UserAgent  string    `json:"userAgent"`
+		CreatedAt  time.Time `json:"createdAt"`
+		LastSeenAt time.Time `json:"lastSeenAt"`
+		ExpiresAt  time.Time `json:"expiresAt"`
+		Current    bool      `json:"current"` // Session of the request
+	}
+
+	VerifyEmailRequest struct {
+		Token string `json:"token"` // Token from the link sent by email
 	}
 )
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"fmt"
+
+	"web-studio-backend/internal/app/domain/apperr"
+)
+
+type BoardTaskStatus int16
+
+const (
+	BoardTaskStatusOpen BoardTaskStatus = iota + 1
+	BoardTaskStatusInProgress
+	BoardTaskStatusDone
+)
+
+func (s BoardTaskStatus) String() string {
+	switch s {
+	case BoardTaskStatusOpen:
+		return "Open"
+	case BoardTaskStatusInProgress:
+		return "In progress"
+	case BoardTaskStatusDone:
+		return "Done"
+	default:
+		return ""
+	}
+}
+
+type (
+	Board struct {
+		ID        int32  `json:"id"`
+		Title     string `json:"title"`
+		ProjectID int32  `json:"projectID"`
+	}
+
+	BoardColumn struct {
+		ID      int32  `json:"id"`
+		BoardID int32  `json:"boardID"`
+		Title   string `json:"title"`
+	}
+
+	BoardTask struct {
+		ID          int32           `json:"id"`
+		BoardID     int32           `json:"boardID"`
+		ColumnID    int32           `json:"columnID"`
+		Title       string          `json:"title"`
+		Description string          `json:"description"`
+		Status      BoardTaskStatus `json:"status"`
+	}
+
+	BoardTaskMember struct {
+		TaskID   int32  `json:"taskID"`
+		UserID   int32  `json:"userID"`
+		Name     string `json:"name"`
+		Surname  string `json:"surname"`
+		Username string `json:"username"`
+	}
+)
+
+func (b *Board) Validate() error {
+	if b.Title == "" || len(b.Title) > 128 {
+		return apperr.NewInvalidRequest(
+			fmt.Sprintf("Title cannot be empty and must not exceed %d characters.", 128),
+			"title",
+		)
+	}
+
+	return nil
+}
+
+func (bc *BoardColumn) Validate() error {
+	if bc.Title == "" || len(bc.Title) > 64 {
+		return apperr.NewInvalidRequest(
+			fmt.Sprintf("Title cannot be empty and must not exceed %d characters.", 64),
+			"title",
+		)
+	}
+
+	return nil
+}
+
+func (bt *BoardTask) Validate() error {
+	var validations []apperr.ValidationError
+
+	if bt.Title == "" || len(bt.Title) > 256 {
+		validations = append(validations, apperr.ValidationError{
+			Message: fmt.Sprintf("Title cannot be empty and must not exceed %d characters.", 256),
+			Field:   "title",
+		})
+	}
+
+	if len(bt.Description) > 10000 {
+		validations = append(validations, apperr.ValidationError{
+			Message: fmt.Sprintf("Description must be less than %d characters.", 10000),
+			Field:   "description",
+		})
+	}
+
+	if bt.Status.String() == "" {
+		validations = append(validations, apperr.ValidationError{
+			Message: fmt.Sprintf("Unknown status %d.", bt.Status),
+			Field:   "status",
+		})
+	}
+
+	if len(validations) > 0 {
+		return apperr.NewValidationError(validations, "")
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/pkg/strhelp"
+)
+
+func TestBoard_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantError bool
+		b         *Board
+	}{
+		{
+			name:      "empty structure",
+			wantError: true,
+			b:         &Board{},
+		},
+		{
+			name:      "title is too long",
+			wantError: true,
+			b: &Board{Title: (func() string {
+				s, _ := strhelp.GenerateRandomString(129)
+				return s
+			})()},
+		},
+		{
+			name:      "should pass",
+			wantError: false,
+			b:         &Board{Title: "Sprint 1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.b.Validate()
+			if tc.wantError {
+				require.Error(tt, err)
+				return
+			}
+			require.NoError(tt, err)
+		})
+	}
+}
+
+func TestBoardTask_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantError bool
+		bt        *BoardTask
+	}{
+		{
+			name:      "empty structure",
+			wantError: true,
+			bt:        &BoardTask{},
+		},
+		{
+			name:      "empty title",
+			wantError: true,
+			bt:        &BoardTask{Status: BoardTaskStatusOpen},
+		},
+		{
+			name:      "unknown status",
+			wantError: true,
+			bt:        &BoardTask{Title: "title", Status: 0},
+		},
+		{
+			name:      "description is too long",
+			wantError: true,
+			bt: &BoardTask{
+				Title:  "title",
+				Status: BoardTaskStatusDone,
+				Description: (func() string {
+					s, _ := strhelp.GenerateRandomString(10001)
+					return s
+				})(),
+			},
+		},
+		{
+			name:      "should pass",
+			wantError: false,
+			bt: &BoardTask{
+				Title:       "title",
+				Description: "description",
+				Status:      BoardTaskStatusInProgress,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.bt.Validate()
+			if tc.wantError {
+				require.Error(tt, err)
+				return
+			}
+			require.NoError(tt, err)
+		})
+	}
+}
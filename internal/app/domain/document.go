@@ -2,13 +2,28 @@ package domain
 
 import "time"
 
-type Document struct {
-	ID               int32     `json:"id"`
-	OriginalFilename string    `json:"originalFilename"`
-	FileID           string    `json:"-"`
-	UserID           int32     `json:"userID"`
-	CreatedAt        time.Time `json:"createdAt"`
-	MimeType         string    `json:"mimeType"`
-	SizeBytes        int32     `json:"sizeBytes"` // Size in bytes
-	Content          []byte    `json:"-"`
-}
+type (
+	// Document fields describe its current version.
+	Document struct {
+		ID               int32      `json:"id"`
+		OriginalFilename string     `json:"originalFilename"`
+		FileID           string     `json:"-"`
+		UserID           int32      `json:"userID"`
+		CreatedAt        time.Time  `json:"createdAt"`
+		MimeType         string     `json:"mimeType"`
+		SizeBytes        int32      `json:"sizeBytes"` // Size in bytes
+		Version          int32      `json:"version"`
+		DeletedAt        *time.Time `json:"deletedAt,omitempty"` // Set for documents in the trash
+	}
+
+	DocumentVersion struct {
+		DocumentID       int32     `json:"documentID"`
+		Version          int32     `json:"version"`
+		OriginalFilename string    `json:"originalFilename"`
+		FileID           string    `json:"-"`
+		UserID           int32     `json:"userID"` // Uploader of the version
+		MimeType         string    `json:"mimeType"`
+		SizeBytes        int32     `json:"sizeBytes"`
+		CreatedAt        time.Time `json:"createdAt"`
+	}
+)
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"io"
+	"time"
+)
+
+// File is a stored file opened for reading. It must be closed by the caller.
+type File struct {
+	io.ReadSeekCloser
+	Size    int64
+	ModTime time.Time
+	ETag    string // Quoted entity tag, may be empty
+}
@@ -0,0 +1,20 @@
+package domain
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 100
+)
+
+// SortField is a field to order list by.
+type SortField struct {
+	Name string
+	Desc bool
+}
+
+// ListParams describes the requested page of a list and its ordering.
+// Nil ListParams or zero Limit means the whole list in default order.
+type ListParams struct {
+	Limit  int
+	Offset int
+	Sort   []SortField
+}
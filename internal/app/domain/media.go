@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/pkg/markdown"
+)
+
+// MaxProjectMedia is the maximum number of images and videos in a project gallery.
+const MaxProjectMedia = 50
+
+type MediaType string
+
+const (
+	MediaTypeImage MediaType = "image"
+	MediaTypeVideo MediaType = "video"
+)
+
+// ProjectMedia is an image or a video link of the project gallery.
+type ProjectMedia struct {
+	ID          int32     `json:"id"`
+	ProjectID   int32     `json:"projectID"`
+	Type        MediaType `json:"type"`
+	ImageID     string    `json:"-"`
+	VideoURL    string    `json:"videoURL,omitempty"`
+	Caption     string    `json:"caption"`     // Markdown
+	CaptionHTML string    `json:"captionHtml"` // Caption rendered to sanitized HTML
+	Position    int32     `json:"position"`    // Media are ordered by positions starting from 1
+	IsCover     bool      `json:"isCover"`     // Only one image of the project can be the cover
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func (m *ProjectMedia) Validate() error {
+	var validations []apperr.ValidationError
+
+	if len(m.Caption) > 2000 {
+		validations = append(validations, apperr.ValidationError{
+			Message: fmt.Sprintf("Caption must be less than %d characters.", 2000),
+			Field:   "caption",
+		})
+	}
+
+	switch m.Type {
+	case MediaTypeImage:
+		if m.VideoURL != "" {
+			validations = append(validations, apperr.ValidationError{
+				Message: "Images cannot have video link.",
+				Field:   "videoURL",
+			})
+		}
+	case MediaTypeVideo:
+		u, err := url.ParseRequestURI(m.VideoURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(m.VideoURL) > 2048 {
+			validations = append(validations, apperr.ValidationError{
+				Message: "Video link must be a valid http or https URL.",
+				Field:   "videoURL",
+			})
+		}
+		if m.IsCover {
+			validations = append(validations, apperr.ValidationError{
+				Message: "Only images can be the cover.",
+				Field:   "isCover",
+			})
+		}
+	default:
+		validations = append(validations, apperr.ValidationError{
+			Message: "Unknown media type.",
+			Field:   "type",
+		})
+	}
+
+	if len(validations) > 0 {
+		return apperr.NewValidationError(validations, "")
+	}
+
+	return nil
+}
+
+// Render fills CaptionHTML from the Markdown caption.
+func (m *ProjectMedia) Render() {
+	m.CaptionHTML = markdown.Render(m.Caption)
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+type (
+	OIDCProvider struct {
+		Name string `json:"name"`
+	}
+
+	OIDCAuthURL struct {
+		AuthURL string `json:"authURL"` // Provider login page the user must be redirected to
+		State   string `json:"-"`
+	}
+
+	OIDCCallbackRequest struct {
+		Code      string `json:"code"` // Parameters of the provider redirect to the frontend
+		State     string `json:"state"`
+		IP        string `json:"-"` // Filled from the HTTP request
+		UserAgent string `json:"-"`
+	}
+
+	// OIDCState is a sign-in started with an identity provider.
+	OIDCState struct {
+		Hash         string
+		Provider     string
+		Nonce        string
+		CodeVerifier string
+		ExpiresAt    time.Time
+	}
+
+	// UserIdentity links account of an identity provider to the user.
+	UserIdentity struct {
+		Provider string
+		Subject  string
+		UserID   int32
+		Email    string
+	}
+)
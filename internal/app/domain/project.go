@@ -9,8 +9,22 @@ import (
 )
 
 type (
+	ProjectFilter struct {
+		CategoryID    *int32
+		TeamID        *int32
+		Technologies  []string // Names or aliases of technologies, resolved to TechnologyIDs by the service
+		TechnologyIDs []int32  // Projects must use all of the technologies
+		IsActive      *bool
+	}
+
+	ParticipantFilter struct {
+		Role     *UserRole
+		Position *UserPosition
+	}
+
 	Project struct {
 		ID           int32      `json:"id"`
+		Slug         string     `json:"slug"`
 		Title        string     `json:"title"`
 		Description  string     `json:"description"`
 		IsActive     bool       `json:"isActive"`
@@ -21,10 +35,10 @@ type (
 		CategoryID   int        `json:"-"`
 		Category     string     `json:"category"`
 		ImageId      string     `json:"-"`
-		ImageContent []byte     `json:"-"`
 		TeamID       *int32     `json:"teamID,omitempty"`
 		StartedAt    *time.Time `json:"startedAt,omitempty"`
 		EndedAt      *time.Time `json:"endedAt,omitempty"`
+		DeletedAt    *time.Time `json:"deletedAt,omitempty"` // Set for projects in the trash
 	}
 
 	ProjectParticipant struct {
@@ -68,6 +82,8 @@ func (p *Project) Validate() error {
 		})
 	}
 
+	validations = append(validations, slugValidation(p.Slug)...)
+
 	if p.Link != "" {
 		_, err := url.ParseRequestURI(p.Link)
 		if err != nil {
@@ -96,3 +112,8 @@ func (pp *ProjectParticipant) Validate() error {
 
 	return nil
 }
+
+// IsLead reports whether the participant can manage the project.
+func (pp *ProjectParticipant) IsLead() bool {
+	return pp.Role.AtLeast(UserRoleModerator)
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"time"
+
+	"web-studio-backend/internal/pkg/markdown"
+)
+
+// Types of the public portfolio API. They are curated for anonymous visitors:
+// objects are referenced by slugs, contacts and management details are left out.
+type (
+	PublicProjectFilter struct {
+		CategoryID   *int32
+		Team         string   // Team slug
+		Technologies []string // Projects must use all of the technologies
+	}
+
+	PublicProject struct {
+		Slug            string         `json:"slug"`
+		Title           string         `json:"title"`
+		Description     string         `json:"description"`     // Markdown
+		DescriptionHTML string         `json:"descriptionHtml"` // Description rendered to sanitized HTML
+		Category        string         `json:"category"`
+		Technologies    []string       `json:"technologies,omitempty"`
+		Link            string         `json:"link,omitempty"`
+		HasImage        bool           `json:"hasImage"`
+		StartedAt       *time.Time     `json:"startedAt,omitempty"`
+		EndedAt         *time.Time     `json:"endedAt,omitempty"`
+		Team            *PublicTeamRef `json:"team,omitempty"`
+	}
+
+	PublicTeamRef struct {
+		Slug  string `json:"slug"`
+		Title string `json:"title"`
+	}
+
+	PublicTeam struct {
+		Slug        string `json:"slug"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		HasImage    bool   `json:"hasImage"`
+	}
+
+	// PublicMedia is an item of the project gallery, images are requested by the identifier.
+	PublicMedia struct {
+		ID          int32     `json:"id"`
+		Type        MediaType `json:"type"`
+		VideoURL    string    `json:"videoURL,omitempty"`
+		CaptionHTML string    `json:"captionHtml"`
+		IsCover     bool      `json:"isCover"`
+	}
+
+	PublicMember struct {
+		Name     string `json:"name"`
+		Surname  string `json:"surname"`
+		Position string `json:"position,omitempty"`
+	}
+)
+
+func NewPublicProject(p *Project, team *PublicTeamRef) PublicProject {
+	return PublicProject{
+		Slug:            p.Slug,
+		Title:           p.Title,
+		Description:     p.Description,
+		DescriptionHTML: markdown.Render(p.Description),
+		Category:        p.Category,
+		Technologies:    p.Technologies,
+		Link:            p.Link,
+		HasImage:        p.ImageId != "",
+		StartedAt:       p.StartedAt,
+		EndedAt:         p.EndedAt,
+		Team:            team,
+	}
+}
+
+func NewPublicTeam(t *Team) PublicTeam {
+	return PublicTeam{
+		Slug:        t.Slug,
+		Title:       t.Title,
+		Description: t.Description,
+		HasImage:    t.HasImage,
+	}
+}
+
+func NewPublicMedia(m *ProjectMedia) PublicMedia {
+	return PublicMedia{
+		ID:          m.ID,
+		Type:        m.Type,
+		VideoURL:    m.VideoURL,
+		CaptionHTML: markdown.Render(m.Caption),
+		IsCover:     m.IsCover,
+	}
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"fmt"
+
+	"web-studio-backend/internal/app/domain/apperr"
+)
+
+type SearchResultType string
+
+const (
+	SearchResultProject  SearchResultType = "project"
+	SearchResultUser     SearchResultType = "user"
+	SearchResultTeam     SearchResultType = "team"
+	SearchResultDocument SearchResultType = "document"
+)
+
+// SearchResultTypes are all types of objects which can be searched.
+var SearchResultTypes = []SearchResultType{
+	SearchResultProject,
+	SearchResultUser,
+	SearchResultTeam,
+	SearchResultDocument,
+}
+
+type (
+	SearchRequest struct {
+		Query string
+		Types []SearchResultType // Types of objects to search, all types if empty
+		Limit int
+	}
+
+	SearchResult struct {
+		Type  SearchResultType `json:"type"`
+		ID    int32            `json:"id"`
+		Title string           `json:"title"`
+		// Snippet is HTML escaped text fragment with matched words wrapped in <mark> tags.
+		Snippet string  `json:"snippet"`
+		Rank    float32 `json:"rank"`
+	}
+)
+
+func (sr *SearchRequest) Validate() error {
+	var validations []apperr.ValidationError
+
+	if sr.Query == "" || len([]rune(sr.Query)) > 100 {
+		validations = append(validations, apperr.ValidationError{
+			Message: fmt.Sprintf("Query cannot be empty and must not exceed %d characters.", 100),
+			Field:   "q",
+		})
+	}
+
+	for _, t := range sr.Types {
+		if !t.valid() {
+			validations = append(validations, apperr.ValidationError{
+				Message: fmt.Sprintf("Unknown type %q.", t),
+				Field:   "types",
+			})
+		}
+	}
+
+	if sr.Limit < 1 || sr.Limit > MaxListLimit {
+		validations = append(validations, apperr.ValidationError{
+			Message: fmt.Sprintf("Limit must be a number from 1 to %d.", MaxListLimit),
+			Field:   "limit",
+		})
+	}
+
+	if len(validations) > 0 {
+		return apperr.NewValidationError(validations, "")
+	}
+
+	return nil
+}
+
+func (t SearchResultType) valid() bool {
+	for _, st := range SearchResultTypes {
+		if t == st {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantError bool
+		sr        *SearchRequest
+	}{
+		{
+			name:      "empty structure",
+			wantError: true,
+			sr:        &SearchRequest{},
+		},
+		{
+			name:      "query is too long",
+			wantError: true,
+			sr:        &SearchRequest{Query: strings.Repeat("я", 101), Limit: 10},
+		},
+		{
+			name:      "unknown type",
+			wantError: true,
+			sr:        &SearchRequest{Query: "golang", Types: []SearchResultType{"board"}, Limit: 10},
+		},
+		{
+			name:      "limit is too big",
+			wantError: true,
+			sr:        &SearchRequest{Query: "golang", Limit: MaxListLimit + 1},
+		},
+		{
+			name:      "should pass",
+			wantError: false,
+			sr:        &SearchRequest{Query: "golang", Types: []SearchResultType{SearchResultProject}, Limit: 10},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.sr.Validate()
+			if tc.wantError {
+				require.Error(tt, err)
+				return
+			}
+
+			require.NoError(tt, err)
+		})
+	}
+}
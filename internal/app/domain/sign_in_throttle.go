@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+type SignInThrottleKind string
+
+const (
+	SignInThrottleUser  SignInThrottleKind = "user"
+	SignInThrottleLogin SignInThrottleKind = "login"
+	SignInThrottleIP    SignInThrottleKind = "ip"
+)
+
+type (
+	// SignInThrottle counts recent failed sign in attempts to an account, with a login or from an IP address.
+	SignInThrottle struct {
+		Kind          SignInThrottleKind
+		Subject       string // User identifier, login in lower case or IP address
+		Failures      int
+		LastFailureAt time.Time
+		BlockedUntil  *time.Time // Sign in is rejected without checking the password until this time
+	}
+
+	// SignInLockout is the audit record details of a locked account.
+	SignInLockout struct {
+		Failures    int       `json:"failures"`
+		IP          string    `json:"ip"` // Address of the last failed attempt
+		LockedUntil time.Time `json:"lockedUntil"`
+	}
+)
+
+// Blocked reports whether sign in is rejected at the given time.
+func (t *SignInThrottle) Blocked(at time.Time) bool {
+	return t != nil && t.BlockedUntil != nil && at.Before(*t.BlockedUntil)
+}
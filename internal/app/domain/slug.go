@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"fmt"
+
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/pkg/slug"
+)
+
+// SlugMovedError is returned when an object is requested by its former slug.
+type SlugMovedError struct {
+	Slug string // Current slug of the object
+}
+
+func (e *SlugMovedError) Error() string {
+	return fmt.Sprintf("slug moved to %q", e.Slug)
+}
+
+// slugValidation returns validation error of the slug chosen by a user, empty slug is generated from the title.
+func slugValidation(s string) []apperr.ValidationError {
+	if s == "" || slug.Valid(s) {
+		return nil
+	}
+
+	return []apperr.ValidationError{{
+		Message: fmt.Sprintf("Slug must consist of lower case Latin letters and digits separated by hyphens, up to %d characters.", slug.MaxLength),
+		Field:   "slug",
+	}}
+}
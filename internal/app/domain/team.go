@@ -35,8 +35,13 @@ func (up UserPosition) String() string {
 }
 
 type (
+	TeamFilter struct {
+		Disabled *bool
+	}
+
 	Team struct {
 		ID          int32      `json:"id"`
+		Slug        string     `json:"slug"`
 		Title       string     `json:"title"`
 		Description string     `json:"description"`
 		HasImage    bool       `json:"hasImage"`
@@ -44,16 +49,19 @@ type (
 		UpdatedAt   time.Time  `json:"updatedAt"`
 		DisabledAt  *time.Time `json:"disabledAt,omitempty"`
 
-		ImageID      string `json:"-"`
-		ImageContent []byte `json:"-"`
+		ImageID string `json:"-"`
 	}
 
 	TeamMember struct {
 		UserID    int32        `json:"userID"`
 		TeamID    int32        `json:"teamID"`
+		Name      string       `json:"name"`
+		Surname   string       `json:"surname"`
+		Username  string       `json:"username"`
 		Role      UserRole     `json:"role"`
 		Position  UserPosition `json:"position"`
 		CreatedAt time.Time    `json:"createdAt"`
+		UpdatedAt time.Time    `json:"updatedAt"`
 	}
 )
 
@@ -67,6 +75,8 @@ func (t *Team) Validate() error {
 		})
 	}
 
+	validations = append(validations, slugValidation(t.Slug)...)
+
 	if len(t.Description) > 512 {
 		validations = append(validations, apperr.ValidationError{
 			Message: fmt.Sprintf("Description length must be less than %d characters.", 512),
@@ -80,3 +90,20 @@ func (t *Team) Validate() error {
 
 	return nil
 }
+
+func (tm *TeamMember) Validate() error {
+	if tm.Role.String() == "" {
+		return apperr.NewInvalidRequest("Unknown member role.", "role")
+	}
+
+	if tm.Position.String() == "" {
+		return apperr.NewInvalidRequest("Unknown member position.", "position")
+	}
+
+	return nil
+}
+
+// IsLead reports whether the member can manage the team.
+func (tm *TeamMember) IsLead() bool {
+	return tm.Role.AtLeast(UserRoleModerator)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamMember_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantError bool
+		tm        *TeamMember
+	}{
+		{
+			name:      "empty structure",
+			wantError: true,
+			tm:        &TeamMember{},
+		},
+		{
+			name:      "unknown role",
+			wantError: true,
+			tm:        &TeamMember{Role: 0, Position: UserPositionFrontend},
+		},
+		{
+			name:      "unknown position",
+			wantError: true,
+			tm:        &TeamMember{Role: UserRoleModerator, Position: 0},
+		},
+		{
+			name:      "should pass",
+			wantError: false,
+			tm: &TeamMember{
+				Role:     UserRoleUser,
+				Position: UserPositionDevOps,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.tm.Validate()
+			if tc.wantError {
+				require.Error(tt, err)
+				return
+			}
+			require.NoError(tt, err)
+		})
+	}
+}
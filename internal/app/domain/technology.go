@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"web-studio-backend/internal/app/domain/apperr"
+)
+
+type TechnologyCategory string
+
+const (
+	TechnologyCategoryLanguage  TechnologyCategory = "language"
+	TechnologyCategoryFramework TechnologyCategory = "framework"
+	TechnologyCategoryLibrary   TechnologyCategory = "library"
+	TechnologyCategoryDatabase  TechnologyCategory = "database"
+	TechnologyCategoryPlatform  TechnologyCategory = "platform"
+	TechnologyCategoryTool      TechnologyCategory = "tool"
+	TechnologyCategoryOther     TechnologyCategory = "other"
+)
+
+func (c TechnologyCategory) IsValid() bool {
+	switch c {
+	case TechnologyCategoryLanguage, TechnologyCategoryFramework, TechnologyCategoryLibrary,
+		TechnologyCategoryDatabase, TechnologyCategoryPlatform, TechnologyCategoryTool, TechnologyCategoryOther:
+		return true
+	}
+	return false
+}
+
+const maxTechnologyAliases = 20
+
+type (
+	// Technology is an entry of the catalog projects pick technologies from.
+	// Names and aliases are unique across the catalog and matched case-insensitively,
+	// so "golang" given by a client is saved as "Go".
+	Technology struct {
+		ID       int32              `json:"id"`
+		Name     string             `json:"name"`
+		Aliases  []string           `json:"aliases"`
+		Icon     string             `json:"icon,omitempty"` // Icon URL
+		Category TechnologyCategory `json:"category"`
+	}
+
+	TechnologyFilter struct {
+		Category *TechnologyCategory
+	}
+
+	// TechnologyUsage is a technology with the number of projects using it.
+	TechnologyUsage struct {
+		Technology
+		ProjectCount int `json:"projectCount"`
+	}
+)
+
+func (t *Technology) Validate() error {
+	var validations []apperr.ValidationError
+
+	if strings.TrimSpace(t.Name) == "" {
+		validations = append(validations, apperr.ValidationError{
+			Message: "Name cannot be empty.",
+			Field:   "name",
+		})
+	}
+	if len(t.Name) > 64 {
+		validations = append(validations, apperr.ValidationError{
+			Message: fmt.Sprintf("Name must be less than %d characters.", 64),
+			Field:   "name",
+		})
+	}
+
+	if len(t.Aliases) > maxTechnologyAliases {
+		validations = append(validations, apperr.ValidationError{
+			Message: fmt.Sprintf("Technology cannot have more than %d aliases.", maxTechnologyAliases),
+			Field:   "aliases",
+		})
+	}
+	seen := map[string]bool{strings.ToLower(t.Name): true}
+	for _, alias := range t.Aliases {
+		if strings.TrimSpace(alias) == "" || len(alias) > 64 {
+			validations = append(validations, apperr.ValidationError{
+				Message: fmt.Sprintf("Aliases must be from 1 to %d characters.", 64),
+				Field:   "aliases",
+			})
+			break
+		}
+		if seen[strings.ToLower(alias)] {
+			validations = append(validations, apperr.ValidationError{
+				Message: fmt.Sprintf("Alias %q repeats the name or another alias.", alias),
+				Field:   "aliases",
+			})
+			break
+		}
+		seen[strings.ToLower(alias)] = true
+	}
+
+	if t.Icon != "" {
+		_, err := url.ParseRequestURI(t.Icon)
+		if err != nil {
+			validations = append(validations, apperr.ValidationError{
+				Message: "Icon has invalid format.",
+				Field:   "icon",
+			})
+		}
+	}
+
+	if !t.Category.IsValid() {
+		validations = append(validations, apperr.ValidationError{
+			Message: "Unknown technology category.",
+			Field:   "category",
+		})
+	}
+
+	if len(validations) > 0 {
+		return apperr.NewValidationError(validations, "")
+	}
+
+	return nil
+}
+
+// Names returns the name and aliases of the technology.
+func (t *Technology) Names() []string {
+	return append([]string{t.Name}, t.Aliases...)
+}
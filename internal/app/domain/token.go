@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"web-studio-backend/internal/app/domain/apperr"
+)
+
+type UserTokenPurpose string
+
+const (
+	UserTokenPasswordReset     UserTokenPurpose = "password_reset"
+	UserTokenEmailVerification UserTokenPurpose = "email_verification"
+)
+
+// UserToken is a single-use token sent to user by email. Only hash of the token is stored.
+type UserToken struct {
+	Hash      string
+	UserID    int32
+	Purpose   UserTokenPurpose
+	ExpiresAt time.Time
+}
+
+type APITokenScope string
+
+const (
+	APITokenScopeRead  APITokenScope = "read"  // Only safe methods: GET and HEAD
+	APITokenScopeWrite APITokenScope = "write" // Any method
+)
+
+const maxAPITokenNameLength = 64
+
+type (
+	// APIToken is a personal access token of a user for machine clients. Only hash of the token is stored.
+	APIToken struct {
+		ID         int32           `json:"id"`
+		UserID     int32           `json:"userID"`
+		Name       string          `json:"name"`
+		Scopes     []APITokenScope `json:"scopes"`
+		Token      string          `json:"token,omitempty"` // Returned only once after creation
+		Hash       string          `json:"-"`
+		CreatedAt  time.Time       `json:"createdAt"`
+		ExpiresAt  *time.Time      `json:"expiresAt"` // Never expires if empty
+		LastUsedAt *time.Time      `json:"lastUsedAt"`
+	}
+
+	CreateAPITokenRequest struct {
+		Name      string          `json:"name"`
+		Scopes    []APITokenScope `json:"scopes"`
+		ExpiresAt *time.Time      `json:"expiresAt"`
+	}
+)
+
+func (r *CreateAPITokenRequest) Validate() error {
+	var validations []apperr.ValidationError
+
+	if r.Name == "" || len(r.Name) > maxAPITokenNameLength {
+		validations = append(validations, apperr.ValidationError{
+			Message: fmt.Sprintf("Name cannot be empty and must not exceed %d characters.", maxAPITokenNameLength),
+			Field:   "name",
+		})
+	}
+
+	if len(r.Scopes) == 0 {
+		validations = append(validations, apperr.ValidationError{
+			Message: "At least one scope is required.",
+			Field:   "scopes",
+		})
+	}
+	for _, scope := range r.Scopes {
+		if scope != APITokenScopeRead && scope != APITokenScopeWrite {
+			validations = append(validations, apperr.ValidationError{
+				Message: fmt.Sprintf("Unknown scope %q.", scope),
+				Field:   "scopes",
+			})
+		}
+	}
+
+	if r.ExpiresAt != nil && !r.ExpiresAt.After(time.Now()) {
+		validations = append(validations, apperr.ValidationError{
+			Message: "Expiration time must be in the future.",
+			Field:   "expiresAt",
+		})
+	}
+
+	if len(validations) > 0 {
+		return apperr.NewValidationError(validations, "")
+	}
+
+	return nil
+}
+
+// Allows reports whether the token scopes permit a request with the given HTTP method.
+func (t *APIToken) Allows(method string) bool {
+	for _, scope := range t.Scopes {
+		switch scope {
+		case APITokenScopeWrite:
+			return true
+		case APITokenScopeRead:
+			if method == http.MethodGet || method == http.MethodHead {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIToken_Allows(t *testing.T) {
+	read := &APIToken{Scopes: []APITokenScope{APITokenScopeRead}}
+	require.True(t, read.Allows(http.MethodGet))
+	require.False(t, read.Allows(http.MethodPost))
+	require.False(t, read.Allows(http.MethodDelete))
+
+	write := &APIToken{Scopes: []APITokenScope{APITokenScopeWrite}}
+	require.True(t, write.Allows(http.MethodGet))
+	require.True(t, write.Allows(http.MethodPut))
+
+	require.False(t, (&APIToken{}).Allows(http.MethodGet))
+}
+
+func TestCreateAPITokenRequest_Validate(t *testing.T) {
+	require.NoError(t, (&CreateAPITokenRequest{Name: "ci", Scopes: []APITokenScope{APITokenScopeRead}}).Validate())
+	require.Error(t, (&CreateAPITokenRequest{Scopes: []APITokenScope{APITokenScopeRead}}).Validate())
+	require.Error(t, (&CreateAPITokenRequest{Name: "ci"}).Validate())
+	require.Error(t, (&CreateAPITokenRequest{Name: "ci", Scopes: []APITokenScope{"admin"}}).Validate())
+}
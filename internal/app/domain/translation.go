@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// Translation is the title and description of a project or team in a language other than the default one.
+type Translation struct {
+	Locale      string    `json:"locale"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"` // Description in the default language is used if empty
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// ValidateProject checks the translation with the same rules as the project fields.
+func (t *Translation) ValidateProject() error {
+	p := Project{Title: t.Title, Description: t.Description}
+	return p.Validate()
+}
+
+// ValidateTeam checks the translation with the same rules as the team fields.
+func (t *Translation) ValidateTeam() error {
+	team := Team{Title: t.Title, Description: t.Description}
+	return team.Validate()
+}
+
+// Translate replaces the project title and description with the translation.
+func (p *Project) Translate(t *Translation) {
+	p.Title = t.Title
+	if t.Description != "" {
+		p.Description = t.Description
+	}
+}
+
+// Translate replaces the team title and description with the translation.
+func (t *Team) Translate(tr *Translation) {
+	t.Title = tr.Title
+	if tr.Description != "" {
+		t.Description = tr.Description
+	}
+}
@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+type (
+	// TwoFactor is TOTP settings of the user. Enrollment is pending until EnabledAt is set.
+	TwoFactor struct {
+		UserID    int32
+		Secret    string // Encrypted
+		LastStep  int64
+		EnabledAt *time.Time
+	}
+
+	// TwoFactorChallenge is a sign-in with valid password waiting for the second factor.
+	TwoFactorChallenge struct {
+		Hash      string
+		UserID    int32
+		Attempts  int
+		ExpiresAt time.Time
+	}
+
+	TwoFactorStatus struct {
+		Enabled           bool `json:"enabled"`
+		Required          bool `json:"required"` // Required by the policy for the user role
+		RecoveryCodesLeft int  `json:"recoveryCodesLeft"`
+	}
+
+	TwoFactorSetup struct {
+		Secret string `json:"secret"` // For manual entry in authenticator app
+		URI    string `json:"uri"`    // Provisioning URI to show as QR code
+	}
+
+	TwoFactorCodeRequest struct {
+		Code string `json:"code"` // Code from authenticator app or recovery code
+	}
+
+	RecoveryCodes struct {
+		Codes []string `json:"codes"` // Shown only once, each code can be used instead of TOTP code once
+	}
+
+	TwoFactorSignInRequest struct {
+		Token     string `json:"token"` // Token from sign in response
+		Code      string `json:"code"`  // Code from authenticator app or recovery code
+		IP        string `json:"-"`     // Filled from the HTTP request
+		UserAgent string `json:"-"`
+	}
+)
+
+// Enabled reports whether the enrollment is finished.
+func (tf *TwoFactor) Enabled() bool {
+	return tf != nil && tf.EnabledAt != nil
+}
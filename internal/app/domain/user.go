@@ -1,12 +1,12 @@
 package domain
 
 import (
-	"crypto/sha512"
 	"fmt"
+	"strings"
 	"time"
 
 	"web-studio-backend/internal/app/domain/apperr"
-	"web-studio-backend/internal/pkg/strhelp"
+	"web-studio-backend/internal/pkg/passhash"
 )
 
 type UserRole int16
@@ -33,6 +33,16 @@ func (ur UserRole) String() string {
 	}
 }
 
+// AtLeast reports whether the role is equal to or higher than the given one.
+func (ur UserRole) AtLeast(role UserRole) bool {
+	return ur >= role
+}
+
+type UserFilter struct {
+	Role     *UserRole
+	Disabled *bool
+}
+
 type User struct {
 	ID         int32      `json:"id"`
 	Name       string     `json:"name"`
@@ -46,11 +56,12 @@ type User struct {
 	UpdatedAt  time.Time  `json:"updatedAt"`
 	DisabledAt *time.Time `json:"disabledAt,omitempty"`
 
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt,omitempty"`
+
 	Salt            string `json:"-"`
 	EncodedPassword string `json:"-"`
 
-	ImageID      string `json:"-"`
-	ImageContent []byte `json:"-"`
+	ImageID string `json:"-"`
 }
 
 func (u *User) Validate() error {
@@ -84,29 +95,56 @@ func (u *User) Validate() error {
 	return nil
 }
 
-func (u *User) EncodePassword() error {
+const maxPasswordLength = 20
+
+// ValidatePassword checks password chosen by user, field is reported in the error.
+func ValidatePassword(password, field string) error {
+	if password == "" || len(password) > maxPasswordLength {
+		return apperr.NewInvalidRequest(
+			fmt.Sprintf("Password cannot be empty and must not exceed %d characters.", maxPasswordLength),
+			field,
+		)
+	}
+
+	return nil
+}
+
+// EncodePassword replaces plain password in EncodedPassword with its hash.
+// Salt is a part of the encoded hash, so the legacy Salt field is cleared.
+func (u *User) EncodePassword(h passhash.Hasher) error {
 	if u.EncodedPassword == "" {
 		return fmt.Errorf("empty password")
 	}
 
-	salt, err := strhelp.GenerateRandomString(32)
+	encoded, err := h.Hash(u.EncodedPassword)
 	if err != nil {
-		return fmt.Errorf("generating random string: %w", err)
+		return fmt.Errorf("hashing password: %w", err)
 	}
-	u.Salt = salt
-
-	u.EncodedPassword = fmt.Sprintf("%x", sha512.Sum512([]byte(u.EncodedPassword+u.Salt)))
+	u.EncodedPassword = encoded
+	u.Salt = ""
 
 	return nil
 }
 
-func (u *User) ComparePassword(password string) bool {
-	if password == "" || u.Salt == "" || u.EncodedPassword == "" {
+// PasswordHash returns encoded password hash in PHC format.
+// Legacy SHA-512 hashes are stored with a separate salt, so they are converted.
+func (u *User) PasswordHash() string {
+	if u.Salt != "" && !strings.HasPrefix(u.EncodedPassword, "$") {
+		return passhash.FormatSHA512(u.Salt, u.EncodedPassword)
+	}
+
+	return u.EncodedPassword
+}
+
+func (u *User) ComparePassword(h passhash.Hasher, password string) bool {
+	if password == "" || u.EncodedPassword == "" {
 		return false
 	}
 
-	passwordHashBytes := sha512.Sum512(append([]byte(password), u.Salt...))
-	passwordHash := fmt.Sprintf("%x", passwordHashBytes)
+	ok, err := h.Verify(password, u.PasswordHash())
+	if err != nil {
+		return false
+	}
 
-	return passwordHash == u.EncodedPassword
+	return ok
 }
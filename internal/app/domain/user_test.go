@@ -1,12 +1,21 @@
 package domain
 
 import (
+	"crypto/sha512"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/pkg/passhash"
 )
 
 func TestUser_ComparePassword(t *testing.T) {
+	hasher := &passhash.Upgrading{
+		Current: &passhash.Bcrypt{Cost: 4},
+		Legacy:  []passhash.Hasher{passhash.SHA512{}},
+	}
+
 	tests := []struct {
 		name     string
 		result   bool
@@ -38,6 +47,18 @@ func TestUser_ComparePassword(t *testing.T) {
 			password: "123",
 			u:        &User{EncodedPassword: "123"},
 		},
+		{
+			name:     "legacy passwords match",
+			result:   true,
+			password: "123",
+			u:        &User{EncodedPassword: fmt.Sprintf("%x", sha512.Sum512([]byte("123321"))), Salt: "321"},
+		},
+		{
+			name:     "legacy passwords dont match",
+			result:   false,
+			password: "1234",
+			u:        &User{EncodedPassword: fmt.Sprintf("%x", sha512.Sum512([]byte("123321"))), Salt: "321"},
+		},
 		{
 			name:     "passwords match",
 			result:   true,
@@ -57,11 +78,11 @@ func TestUser_ComparePassword(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(tt *testing.T) {
 			if tc.encode {
-				err := tc.u.EncodePassword()
+				err := tc.u.EncodePassword(hasher)
 				require.NoError(tt, err)
 			}
 
-			res := tc.u.ComparePassword(tc.password)
+			res := tc.u.ComparePassword(hasher, tc.password)
 			require.Equal(tt, tc.result, res)
 		})
 	}
@@ -0,0 +1,100 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+)
+
+//go:generate mockgen -source=api_token.go -destination=./mocks/api_token.go -package=mocks
+type APITokenService interface {
+	CreateAPIToken(ctx context.Context, req *domain.CreateAPITokenRequest) (*domain.APIToken, error)
+	GetAPITokens(ctx context.Context) ([]domain.APIToken, error)
+	RevokeAPIToken(ctx context.Context, id int32) error
+	Authenticate(ctx context.Context, token string) (*domain.APIToken, error)
+}
+
+type apiTokenHandler struct {
+	tokenService APITokenService
+}
+
+func newAPITokenHandler(srv APITokenService) *apiTokenHandler {
+	return &apiTokenHandler{srv}
+}
+
+// createAPIToken godoc
+// @Security     CSRF
+// @Summary      Create API token
+// @Description  Creates a personal access token for machine clients. Available only for signed in users.
+// @Description
+// @Description  The token is returned only once, it must be sent in header: `Authorization: Bearer <token>`.
+// @Description  Requests with the token don't need CSRF token. Scope `read` allows only GET requests, `write` allows any.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        request body domain.CreateAPITokenRequest true "Request body."
+// @Success      200  {object}  domain.APIToken
+// @Failure      400  {object}  Error
+// @Failure      401  {object}  Error
+// @Failure      403  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/tokens [post]
+func (h *apiTokenHandler) createAPIToken(w http.ResponseWriter, r *http.Request) {
+	var req domain.CreateAPITokenRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.tokenService.CreateAPIToken(r.Context(), &req)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getAPITokens godoc
+// @Summary      Get API tokens
+// @Description  Returns personal access tokens of the authorized user without the tokens themselves.
+// @Tags         Auth
+// @Produce      json
+// @Success      200  {array}   domain.APIToken
+// @Failure      401  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/tokens [get]
+func (h *apiTokenHandler) getAPITokens(w http.ResponseWriter, r *http.Request) {
+	response, err := h.tokenService.GetAPITokens(r.Context())
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// revokeAPIToken godoc
+// @Security     CSRF
+// @Summary      Revoke API token
+// @Description  Deletes personal access token of the authorized user.
+// @Tags         Auth
+// @Param        token_id path int true "Token identifier."
+// @Success      200
+// @Failure      401  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/tokens/{token_id} [delete]
+func (h *apiTokenHandler) revokeAPIToken(w http.ResponseWriter, r *http.Request) {
+	tokenID := httphelp.ParseParamInt32("token_id", r)
+
+	err := h.tokenService.RevokeAPIToken(r.Context(), tokenID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
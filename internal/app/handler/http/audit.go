@@ -0,0 +1,90 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+)
+
+//go:generate mockgen -source=audit.go -destination=./mocks/audit.go -package=mocks
+type AuditService interface {
+	GetRecords(ctx context.Context, params *domain.ListParams, filter *domain.AuditFilter) ([]domain.AuditRecord, int, error)
+}
+
+type auditHandler struct {
+	auditService AuditService
+}
+
+func newAuditHandler(auditService AuditService) *auditHandler {
+	return &auditHandler{auditService: auditService}
+}
+
+// getRecords godoc
+// @Summary      Get audit log
+// @Description  Returns a page of audit records, newest first.
+// @Description  Total number of records is returned in `X-Total-Count` header, links to other pages in `Link` header.
+// @Tags         Audit
+// @Produce      json
+// @Param        limit       query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset      query int    false "Number of records to skip."
+// @Param        sort        query string false "Comma separated fields, `-` prefix for descending order: createdAt."
+// @Param        actor_id    query int    false "Identifier of user who performed actions."
+// @Param        entity_type query string false "Type of changed entities: user, project, team, project_category, document."
+// @Param        entity_id   query int    false "Identifier of changed entity."
+// @Param        from        query string false "Beginning of time range, inclusive, in RFC 3339 format."
+// @Param        to          query string false "End of time range, exclusive, in RFC 3339 format."
+// @Success      200  {array}   domain.AuditRecord
+// @Failure      400  {object}  Error
+// @Failure      401  {object}  Error
+// @Failure      403  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/audit [get]
+func (h *auditHandler) getRecords(w http.ResponseWriter, r *http.Request) {
+	params, err := httphelp.ParseListParams(r, "createdAt")
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	var filter domain.AuditFilter
+
+	if entityType := r.URL.Query().Get("entity_type"); entityType != "" {
+		et := domain.AuditEntity(entityType)
+		filter.EntityType = &et
+	}
+
+	filter.ActorID, err = httphelp.QueryInt32("actor_id", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	filter.EntityID, err = httphelp.QueryInt32("entity_id", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	filter.From, err = httphelp.QueryTime("from", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	filter.To, err = httphelp.QueryTime("to", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, total, err := h.auditService.GetRecords(r.Context(), params, &filter)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
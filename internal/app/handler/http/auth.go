@@ -2,29 +2,54 @@ package http
 
 import (
 	"context"
-	"fmt"
+	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
 	"web-studio-backend/internal/app/handler/http/httphelp"
 	"web-studio-backend/internal/pkg/auth"
 	"web-studio-backend/internal/pkg/auth/session"
 )
 
+// sessionRoutes is the prefix of routes managing sessions, they let a user locked by two-factor policy sign out devices.
+const sessionRoutes = `/api/v1/auth/sessions`
+
 //go:generate mockgen -source=auth.go -destination=./mocks/auth.go -package=mocks
 type AuthService interface {
 	SignIn(ctx context.Context, req *domain.SignInRequest) (*domain.SignInResponse, error)
-	SignOut(ctx context.Context, sessionID string)
+	SignOut(ctx context.Context, sessionID string) error
+	GetSession(ctx context.Context, sessionID string) (*session.Session, error)
+
+	ForgotPassword(ctx context.Context, req *domain.ForgotPasswordRequest) error
+	ResetPassword(ctx context.Context, req *domain.ResetPasswordRequest) error
+	ChangePassword(ctx context.Context, req *domain.ChangePasswordRequest) error
+	VerifyEmail(ctx context.Context, req *domain.VerifyEmailRequest) error
+	SendEmailVerification(ctx context.Context) error
+
+	GetSessions(ctx context.Context) ([]domain.Session, error)
+	RevokeSession(ctx context.Context, publicID string) error
+	RevokeOtherSessions(ctx context.Context) error
+
+	UnlockUser(ctx context.Context, userID int32) error
 }
 
 type authHandler struct {
-	authService AuthService
-	userService UserService
+	authService      AuthService
+	userService      UserService
+	tokenService     APITokenService
+	twoFactorService TwoFactorService
 }
 
-func newAuthHandler(authService AuthService, userService UserService) *authHandler {
-	return &authHandler{authService: authService, userService: userService}
+func newAuthHandler(authService AuthService, userService UserService, tokenService APITokenService, twoFactorService TwoFactorService) *authHandler {
+	return &authHandler{
+		authService:      authService,
+		userService:      userService,
+		tokenService:     tokenService,
+		twoFactorService: twoFactorService,
+	}
 }
 
 // signIn godoc
@@ -36,10 +61,17 @@ func newAuthHandler(authService AuthService, userService UserService) *authHandl
 // @Description  Example: `X-CSRF-token: <token>`.
 // @Description
 // @Description  If authorization will fail, `401 Unauthorized` status code will be returned without any additional data.
+// @Description
+// @Description  If the user has enabled two-factor authentication, session is not started and `twoFactorRequired` is returned
+// @Description  with `twoFactorToken`, which must be sent with the code to `/api/v1/auth/sign-in/2fa`.
+// @Description
+// @Description  After several failed attempts with a login next ones are delayed, and after too many the login is locked
+// @Description  until the lockout expires or an admin unlocks it. Meanwhile `429 Too Many Requests` is returned.
 // @Tags         Auth
 // @Param        request body domain.SignInRequest true "Request body."
 // @Success      200  {object}	domain.SignInResponse
 // @Failure      401  {object}  Error
+// @Failure      429  {object}  Error
 // @Failure      500  {object}  Error
 // @Router       /api/v1/auth/sign-in [post]
 func (h *authHandler) signIn(w http.ResponseWriter, r *http.Request) {
@@ -48,6 +80,8 @@ func (h *authHandler) signIn(w http.ResponseWriter, r *http.Request) {
 		httphelp.SendError(err, w)
 		return
 	}
+	req.IP = clientIP(r)
+	req.UserAgent = r.UserAgent()
 
 	response, err := h.authService.SignIn(r.Context(), &req)
 	if err != nil {
@@ -55,17 +89,26 @@ func (h *authHandler) signIn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setSessionCookie(w, response.SessionID)
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// setSessionCookie sets cookie of the started session, nothing is set if sign in waits for the second factor.
+func setSessionCookie(w http.ResponseWriter, sessionID string) {
+	if sessionID == "" {
+		return
+	}
+
 	http.SetCookie(w, &http.Cookie{
 		Name:     "session_id",
-		Value:    response.SessionID,
+		Value:    sessionID,
 		SameSite: http.SameSiteNoneMode,
 		Path:     "/",
 		HttpOnly: true,
 		Secure:   true,
 		Expires:  time.Now().Add(session.TTL),
 	})
-
-	httphelp.SendJSON(http.StatusOK, response, w)
 }
 
 // signOut godoc
@@ -83,41 +126,322 @@ func (h *authHandler) signOut(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.authService.SignOut(r.Context(), cookie.Value)
+	err = h.authService.SignOut(r.Context(), cookie.Value)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// forgotPassword godoc
+// @Summary      Request password reset
+// @Description  Sends a password reset link to the email if it belongs to a user.
+// @Description  The response is the same for unknown emails. The link is valid for an hour and can be used once.
+// @Tags         Auth
+// @Accept       json
+// @Param        request body domain.ForgotPasswordRequest true "Request body."
+// @Success      200
+// @Failure      400  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/password/forgot [post]
+func (h *authHandler) forgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ForgotPasswordRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	err := h.authService.ForgotPassword(r.Context(), &req)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// resetPassword godoc
+// @Summary      Reset password
+// @Description  Sets a new password using the token from the password reset link. Ends all user sessions and revokes API tokens.
+// @Tags         Auth
+// @Accept       json
+// @Param        request body domain.ResetPasswordRequest true "Request body."
+// @Success      200
+// @Failure      400  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/password/reset [post]
+func (h *authHandler) resetPassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ResetPasswordRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	err := h.authService.ResetPassword(r.Context(), &req)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// changePassword godoc
+// @Security     CSRF
+// @Summary      Change password
+// @Description  Changes password of the authorized user. Ends other sessions of the user.
+// @Tags         Auth
+// @Accept       json
+// @Param        request body domain.ChangePasswordRequest true "Request body."
+// @Success      200
+// @Failure      400  {object}  Error
+// @Failure      401  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/password/change [post]
+func (h *authHandler) changePassword(w http.ResponseWriter, r *http.Request) {
+	var req domain.ChangePasswordRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	err := h.authService.ChangePassword(r.Context(), &req)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// verifyEmail godoc
+// @Summary      Verify email
+// @Description  Confirms user email using the token from the verification link.
+// @Tags         Auth
+// @Accept       json
+// @Param        request body domain.VerifyEmailRequest true "Request body."
+// @Success      200
+// @Failure      400  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/email/verify [post]
+func (h *authHandler) verifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req domain.VerifyEmailRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	err := h.authService.VerifyEmail(r.Context(), &req)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
 }
 
+// sendEmailVerification godoc
+// @Security     CSRF
+// @Summary      Send email verification
+// @Description  Sends a new verification link to the email of the authorized user. Previous links stop working.
+// @Tags         Auth
+// @Success      200
+// @Failure      400  {object}  Error
+// @Failure      401  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/email/verification [post]
+func (h *authHandler) sendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	err := h.authService.SendEmailVerification(r.Context())
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// getSessions godoc
+// @Summary      Get sessions
+// @Description  Returns active sessions of the authorized user, recently used first.
+// @Tags         Auth
+// @Produce      json
+// @Success      200  {array}   domain.Session
+// @Failure      401  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/sessions [get]
+func (h *authHandler) getSessions(w http.ResponseWriter, r *http.Request) {
+	response, err := h.authService.GetSessions(r.Context())
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// revokeSession godoc
+// @Security     CSRF
+// @Summary      Revoke session
+// @Description  Ends session of the authorized user. Revoking the current session signs the user out.
+// @Tags         Auth
+// @Param        session_id path string true "Session identifier from the sessions list."
+// @Success      200
+// @Failure      401  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/sessions/{session_id} [delete]
+func (h *authHandler) revokeSession(w http.ResponseWriter, r *http.Request) {
+	err := h.authService.RevokeSession(r.Context(), httphelp.ParseParamString("session_id", r))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// revokeOtherSessions godoc
+// @Security     CSRF
+// @Summary      Revoke other sessions
+// @Description  Ends all sessions of the authorized user except the current one.
+// @Tags         Auth
+// @Success      200
+// @Failure      401  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/sessions [delete]
+func (h *authHandler) revokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	err := h.authService.RevokeOtherSessions(r.Context())
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// unlockUser godoc
+// @Security     CSRF
+// @Summary      Unlock user
+// @Description  Lifts the sign in lockout caused by too many failed attempts and resets the failures counter.
+// @Description  Available only for admins.
+// @Tags         Users
+// @Param        user_id path int true "User identifier."
+// @Success      200
+// @Failure      401  {object}  Error
+// @Failure      403  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/users/{user_id}/unlock [post]
+func (h *authHandler) unlockUser(w http.ResponseWriter, r *http.Request) {
+	userID := httphelp.ParseParamInt32("user_id", r)
+
+	err := h.authService.UnlockUser(r.Context(), userID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// bearerToken returns token from `Authorization: Bearer <token>` header.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return token, true
+}
+
+// clientIP returns IP of the client set by trustedRealIP.
+// Without proxy headers RemoteAddr contains the port as well.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// authMiddleware authorizes request by API token from Authorization header or by session cookie.
+// Tokens are not sent by browsers automatically, so CSRF token is checked only for sessions.
 func (h *authHandler) authMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		cookie, err := r.Cookie("session_id")
-		if err != nil {
-			http.Error(w, "session not found", http.StatusUnauthorized)
-			return
+		var userID int32
+		var sessionID string
+
+		if bearer, ok := bearerToken(r); ok {
+			token, err := h.tokenService.Authenticate(r.Context(), bearer)
+			if err != nil {
+				httphelp.SendError(err, w)
+				return
+			}
+
+			if !token.Allows(r.Method) {
+				httphelp.SendError(apperr.NewForbidden("API token scopes don't allow the request."), w)
+				return
+			}
+
+			userID = token.UserID
+		} else {
+			cookie, err := r.Cookie("session_id")
+			if err != nil {
+				httphelp.SendError(apperr.NewUnauthorized("Session not found."), w)
+				return
+			}
+
+			sess, err := h.authService.GetSession(r.Context(), cookie.Value)
+			if err != nil {
+				httphelp.SendError(err, w)
+				return
+			}
+
+			token := r.Header.Get("X-CSRF-Token")
+			if token != sess.CSRFToken {
+				httphelp.SendError(apperr.NewUnauthorized("Invalid CSRF token."), w)
+				return
+			}
+
+			userID = sess.UserID
+			sessionID = sess.ID
 		}
 
-		sess, err := session.GetSession(cookie.Value)
+		user, err := h.userService.GetUser(r.Context(), userID)
 		if err != nil {
-			http.Error(w, fmt.Sprintf("user session not found(session id: %s)", cookie.Value), http.StatusUnauthorized)
+			if isNotFound(err) {
+				httphelp.SendError(apperr.NewUnauthorized("User not found."), w)
+				return
+			}
+			httphelp.SendError(err, w)
 			return
 		}
 
-		token := r.Header.Get("X-CSRF-Token")
-		if token != sess.CSRFToken {
-			http.Error(w, "invalid csrf token("+token+")", http.StatusUnauthorized)
+		if user.DisabledAt != nil {
+			httphelp.SendError(apperr.NewUnauthorized("User is disabled."), w)
 			return
 		}
 
-		user, err := h.userService.GetUser(r.Context(), sess.UserID)
-		if err != nil {
-			http.Error(w, "user not found", http.StatusUnauthorized)
-			return
+		// Until required two-factor authentication is enabled, only its setup and sessions are available
+		if !strings.HasPrefix(r.URL.Path, twoFactorRoutes) && !strings.HasPrefix(r.URL.Path, sessionRoutes) {
+			required, err := h.twoFactorService.SetupRequired(r.Context(), user)
+			if err != nil {
+				httphelp.SendError(err, w)
+				return
+			}
+			if required {
+				httphelp.SendError(apperr.NewForbidden("Two-factor authentication must be enabled."), w)
+				return
+			}
 		}
 
 		ctx := auth.NewContext(r.Context(), &domain.AuthContext{
-			UserID:   user.ID,
-			Username: user.Username,
-			Email:    user.Email,
-			Role:     user.Role,
+			UserID:    user.ID,
+			Username:  user.Username,
+			Email:     user.Email,
+			Role:      user.Role,
+			SessionID: sessionID,
 		})
 
 		next.ServeHTTP(w, r.WithContext(ctx))
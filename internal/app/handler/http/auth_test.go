@@ -0,0 +1,207 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	smocks "web-studio-backend/internal/app/handler/http/mocks"
+	"web-studio-backend/internal/pkg/auth"
+	"web-studio-backend/internal/pkg/auth/session"
+)
+
+func TestAuthHandler_AuthMiddleware(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+
+	authService := smocks.NewMockAuthService(mockCtl)
+	userService := smocks.NewMockUserService(mockCtl)
+	tokenService := smocks.NewMockAPITokenService(mockCtl)
+	twoFactorService := smocks.NewMockTwoFactorService(mockCtl)
+	handler := newAuthHandler(authService, userService, tokenService, twoFactorService)
+
+	next := handler.authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	sess := &session.Session{ID: "hash", UserID: 1, CSRFToken: "csrf"}
+
+	tests := []struct {
+		name string
+		mock func()
+		code int
+	}{
+		{
+			name: "should pass",
+			mock: func() {
+				authService.EXPECT().GetSession(gomock.Any(), "sid").Return(sess, nil)
+				userService.EXPECT().GetUser(gomock.Any(), int32(1)).Return(&domain.User{ID: 1}, nil)
+				twoFactorService.EXPECT().SetupRequired(gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "should not authorize deleted user",
+			mock: func() {
+				authService.EXPECT().GetSession(gomock.Any(), "sid").Return(sess, nil)
+				userService.EXPECT().GetUser(gomock.Any(), int32(1)).Return(nil, apperr.NewNotFound("user_id"))
+			},
+			code: http.StatusUnauthorized,
+		},
+		{
+			name: "should report internal error of loading user",
+			mock: func() {
+				authService.EXPECT().GetSession(gomock.Any(), "sid").Return(sess, nil)
+				userService.EXPECT().GetUser(gomock.Any(), int32(1)).Return(nil, errors.New("connection refused"))
+			},
+			code: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mock()
+
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+			r.AddCookie(&http.Cookie{Name: "session_id", Value: "sid"})
+			r.Header.Set("X-CSRF-Token", "csrf")
+			w := httptest.NewRecorder()
+
+			next.ServeHTTP(w, r)
+
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestAuthHandler_AuthMiddleware_Bearer(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+
+	authService := smocks.NewMockAuthService(mockCtl)
+	userService := smocks.NewMockUserService(mockCtl)
+	tokenService := smocks.NewMockAPITokenService(mockCtl)
+	twoFactorService := smocks.NewMockTwoFactorService(mockCtl)
+	handler := newAuthHandler(authService, userService, tokenService, twoFactorService)
+
+	next := handler.authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ac, ok := auth.FromContext(r.Context())
+		require.True(t, ok)
+		require.Equal(t, int32(1), ac.UserID)
+		require.Empty(t, ac.SessionID)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	readToken := &domain.APIToken{ID: 1, UserID: 1, Scopes: []domain.APITokenScope{domain.APITokenScopeRead}}
+
+	tests := []struct {
+		name   string
+		method string
+		header string
+		mock   func()
+		code   int
+	}{
+		{
+			name:   "should pass without csrf token",
+			method: http.MethodGet,
+			header: "Bearer wst_token",
+			mock: func() {
+				tokenService.EXPECT().Authenticate(gomock.Any(), "wst_token").Return(readToken, nil)
+				userService.EXPECT().GetUser(gomock.Any(), int32(1)).Return(&domain.User{ID: 1}, nil)
+				twoFactorService.EXPECT().SetupRequired(gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name:   "should forbid write with read scope",
+			method: http.MethodPost,
+			header: "Bearer wst_token",
+			mock: func() {
+				tokenService.EXPECT().Authenticate(gomock.Any(), "wst_token").Return(readToken, nil)
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name:   "should fail on invalid token",
+			method: http.MethodGet,
+			header: "Bearer wst_invalid",
+			mock: func() {
+				tokenService.EXPECT().Authenticate(gomock.Any(), "wst_invalid").
+					Return(nil, apperr.NewUnauthorized("Invalid API token."))
+			},
+			code: http.StatusUnauthorized,
+		},
+		{
+			name:   "should use session without bearer",
+			method: http.MethodGet,
+			header: "Basic dXNlcjpwYXNz",
+			mock: func() {
+				authService.EXPECT().GetSession(gomock.Any(), "sid").
+					Return(&session.Session{ID: "sid", UserID: 1, CSRFToken: "csrf"}, nil)
+			},
+			code: http.StatusUnauthorized, // CSRF token is missing
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mock()
+
+			r := httptest.NewRequest(tc.method, "/", nil)
+			r.Header.Set("Authorization", tc.header)
+			r.AddCookie(&http.Cookie{Name: "session_id", Value: "sid"})
+			w := httptest.NewRecorder()
+
+			next.ServeHTTP(w, r)
+
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestAuthHandler_AuthMiddleware_TwoFactorSetupRequired(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+
+	authService := smocks.NewMockAuthService(mockCtl)
+	userService := smocks.NewMockUserService(mockCtl)
+	twoFactorService := smocks.NewMockTwoFactorService(mockCtl)
+	handler := newAuthHandler(authService, userService, smocks.NewMockAPITokenService(mockCtl), twoFactorService)
+
+	next := handler.authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	authService.EXPECT().GetSession(gomock.Any(), "sid").
+		Return(&session.Session{ID: "sid", UserID: 1, CSRFToken: "csrf"}, nil).AnyTimes()
+	userService.EXPECT().GetUser(gomock.Any(), int32(1)).Return(&domain.User{ID: 1, Role: domain.UserRoleAdmin}, nil).AnyTimes()
+	twoFactorService.EXPECT().SetupRequired(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		code   int
+	}{
+		{name: "should forbid other routes", method: http.MethodGet, path: "/api/v1/projects", code: http.StatusForbidden},
+		{name: "should allow setup", method: http.MethodPost, path: "/api/v1/auth/2fa/setup", code: http.StatusNoContent},
+		{name: "should allow listing sessions", method: http.MethodGet, path: "/api/v1/auth/sessions", code: http.StatusNoContent},
+		{name: "should allow revoking session", method: http.MethodDelete, path: "/api/v1/auth/sessions/abc", code: http.StatusNoContent},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, tc.path, nil)
+			r.Header.Set("X-CSRF-Token", "csrf")
+			r.AddCookie(&http.Cookie{Name: "session_id", Value: "sid"})
+			w := httptest.NewRecorder()
+
+			next.ServeHTTP(w, r)
+
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+	"web-studio-backend/internal/pkg/auth"
+)
+
+// policy reports whether the authenticated user is permitted to access the requested route.
+type policy func(r *http.Request, ac *domain.AuthContext) (bool, error)
+
+type authorizer struct {
+	projectService ProjectService
+	teamService    TeamService
+}
+
+func newAuthorizer(projectService ProjectService, teamService TeamService) *authorizer {
+	return &authorizer{projectService: projectService, teamService: teamService}
+}
+
+// allow returns middleware which passes the request through if at least one of the policies permits it.
+//
+//	Must be used after authMiddleware.
+func (a *authorizer) allow(policies ...policy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ac, ok := auth.FromContext(r.Context())
+			if !ok {
+				httphelp.SendError(apperr.NewUnauthorized("Authorization required."), w)
+				return
+			}
+
+			for _, p := range policies {
+				permitted, err := p(r, ac)
+				if err != nil {
+					httphelp.SendError(err, w)
+					return
+				}
+				if permitted {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			httphelp.SendError(apperr.NewForbidden("Not enough permissions."), w)
+		})
+	}
+}
+
+// roleAtLeast permits users with the given or higher role.
+func roleAtLeast(role domain.UserRole) policy {
+	return func(_ *http.Request, ac *domain.AuthContext) (bool, error) {
+		return ac.Role.AtLeast(role), nil
+	}
+}
+
+// self permits user whose identifier is in the given URL parameter.
+func self(param string) policy {
+	return func(r *http.Request, ac *domain.AuthContext) (bool, error) {
+		return ac.UserID == httphelp.ParseParamInt32(param, r), nil
+	}
+}
+
+// projectParticipant permits participants of the project whose identifier is in the given URL parameter.
+func (a *authorizer) projectParticipant(param string) policy {
+	return func(r *http.Request, ac *domain.AuthContext) (bool, error) {
+		projectID := httphelp.ParseParamInt32(param, r)
+
+		_, err := a.projectService.GetParticipant(r.Context(), ac.UserID, projectID)
+		if err != nil {
+			if isNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		return true, nil
+	}
+}
+
+// projectLead permits project participants with a lead role.
+func (a *authorizer) projectLead(param string) policy {
+	return func(r *http.Request, ac *domain.AuthContext) (bool, error) {
+		projectID := httphelp.ParseParamInt32(param, r)
+
+		participant, err := a.projectService.GetParticipant(r.Context(), ac.UserID, projectID)
+		if err != nil {
+			if isNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		return participant.IsLead(), nil
+	}
+}
+
+// teamLead permits team members with a lead role.
+func (a *authorizer) teamLead(param string) policy {
+	return func(r *http.Request, ac *domain.AuthContext) (bool, error) {
+		teamID := httphelp.ParseParamInt32(param, r)
+
+		member, err := a.teamService.GetMember(r.Context(), ac.UserID, teamID)
+		if err != nil {
+			if isNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		return member.IsLead(), nil
+	}
+}
+
+func isNotFound(err error) bool {
+	var ae *apperr.Error
+	return errors.As(err, &ae) && ae.Type == apperr.NotFoundType
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/pkg/auth"
+)
+
+func TestAuthorizer_Allow(t *testing.T) {
+	az := newAuthorizer(nil, nil)
+
+	tests := []struct {
+		name     string
+		ac       *domain.AuthContext
+		userID   string
+		policies []policy
+		code     int
+	}{
+		{
+			name:     "no auth context",
+			policies: []policy{roleAtLeast(domain.UserRoleUser)},
+			code:     http.StatusUnauthorized,
+		},
+		{
+			name:     "role is too low",
+			ac:       &domain.AuthContext{UserID: 1, Role: domain.UserRoleModerator},
+			policies: []policy{roleAtLeast(domain.UserRoleAdmin)},
+			code:     http.StatusForbidden,
+		},
+		{
+			name:     "role is enough",
+			ac:       &domain.AuthContext{UserID: 1, Role: domain.UserRoleGlobalAdmin},
+			policies: []policy{roleAtLeast(domain.UserRoleAdmin)},
+			code:     http.StatusOK,
+		},
+		{
+			name:     "self access",
+			ac:       &domain.AuthContext{UserID: 5, Role: domain.UserRoleUser},
+			userID:   "5",
+			policies: []policy{roleAtLeast(domain.UserRoleAdmin), self("user_id")},
+			code:     http.StatusOK,
+		},
+		{
+			name:     "access to another user",
+			ac:       &domain.AuthContext{UserID: 5, Role: domain.UserRoleUser},
+			userID:   "6",
+			policies: []policy{roleAtLeast(domain.UserRoleAdmin), self("user_id")},
+			code:     http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			handler := az.allow(tc.policies...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/users/"+tc.userID, nil)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("user_id", tc.userID)
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+			if tc.ac != nil {
+				ctx = auth.NewContext(ctx, tc.ac)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req.WithContext(ctx))
+
+			require.Equal(tt, tc.code, rec.Code)
+		})
+	}
+}
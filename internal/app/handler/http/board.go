@@ -0,0 +1,529 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/handler/http/dto"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+)
+
+//go:generate mockgen -source=board.go -destination=./mocks/board.go -package=mocks
+type BoardService interface {
+	GetBoards(ctx context.Context, projectID int32) ([]domain.Board, error)
+	GetBoard(ctx context.Context, projectID, boardID int32) (*domain.Board, error)
+	CreateBoard(ctx context.Context, board *domain.Board) (*domain.Board, error)
+	UpdateBoard(ctx context.Context, board *domain.Board) (*domain.Board, error)
+	DeleteBoard(ctx context.Context, projectID, boardID int32) error
+
+	GetColumns(ctx context.Context, projectID, boardID int32) ([]domain.BoardColumn, error)
+	CreateColumn(ctx context.Context, projectID int32, column *domain.BoardColumn) (*domain.BoardColumn, error)
+	UpdateColumn(ctx context.Context, projectID int32, column *domain.BoardColumn) (*domain.BoardColumn, error)
+	DeleteColumn(ctx context.Context, projectID, boardID, columnID int32) error
+
+	GetTasks(ctx context.Context, projectID, boardID int32) ([]domain.BoardTask, error)
+	GetTask(ctx context.Context, projectID, boardID, taskID int32) (*domain.BoardTask, error)
+	CreateTask(ctx context.Context, projectID int32, task *domain.BoardTask) (*domain.BoardTask, error)
+	UpdateTask(ctx context.Context, projectID int32, task *domain.BoardTask) (*domain.BoardTask, error)
+	DeleteTask(ctx context.Context, projectID, boardID, taskID int32) error
+
+	GetTaskMembers(ctx context.Context, projectID, boardID, taskID int32) ([]domain.BoardTaskMember, error)
+	AddTaskMember(ctx context.Context, projectID, boardID, taskID, userID int32) (*domain.BoardTaskMember, error)
+	RemoveTaskMember(ctx context.Context, projectID, boardID, taskID, userID int32) error
+}
+
+type boardHandler struct {
+	boardService BoardService
+}
+
+func newBoardHandler(bs BoardService) *boardHandler {
+	return &boardHandler{bs}
+}
+
+// getBoards godoc
+// @Summary      Get project boards
+// @Description  Returns list of project boards.
+// @Tags         Boards
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Success      200  {array}   domain.Board
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards [get]
+func (h *boardHandler) getBoards(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+
+	response, err := h.boardService.GetBoards(r.Context(), projectID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getBoard godoc
+// @Summary      Get board
+// @Description  Returns information about single project board.
+// @Tags         Boards
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Success      200  {object}  domain.Board
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id} [get]
+func (h *boardHandler) getBoard(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+
+	response, err := h.boardService.GetBoard(r.Context(), projectID, boardID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// createBoard godoc
+// @Summary      Create board
+// @Description  Creates a new project board. Returns an object with information about created board.
+// @Tags         Boards
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        request body dto.CreateBoardRequest true "Request body."
+// @Success      200  {object}  domain.Board
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards [post]
+func (h *boardHandler) createBoard(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+
+	var req dto.CreateBoardRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.boardService.CreateBoard(r.Context(), req.ToDomain(projectID))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// updateBoard godoc
+// @Summary      Update board
+// @Description  Updates a project board.
+// @Tags         Boards
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        request body dto.UpdateBoardRequest true "Request body."
+// @Success      200  {object}  domain.Board
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id} [put]
+func (h *boardHandler) updateBoard(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+
+	var req dto.UpdateBoardRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.boardService.UpdateBoard(r.Context(), req.ToDomain(projectID, boardID))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// deleteBoard godoc
+// @Summary      Delete board
+// @Description  Deletes project board with all its columns and tasks.
+// @Tags         Boards
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Success      200
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id} [delete]
+func (h *boardHandler) deleteBoard(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+
+	err := h.boardService.DeleteBoard(r.Context(), projectID, boardID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// getColumns godoc
+// @Summary      Get board columns
+// @Description  Returns list of board columns.
+// @Tags         Boards
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Success      200  {array}   domain.BoardColumn
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/columns [get]
+func (h *boardHandler) getColumns(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+
+	response, err := h.boardService.GetColumns(r.Context(), projectID, boardID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// createColumn godoc
+// @Summary      Create board column
+// @Description  Creates a new board column.
+// @Tags         Boards
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        request body dto.CreateBoardColumnRequest true "Request body."
+// @Success      200  {object}  domain.BoardColumn
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/columns [post]
+func (h *boardHandler) createColumn(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+
+	var req dto.CreateBoardColumnRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.boardService.CreateColumn(r.Context(), projectID, req.ToDomain(boardID))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// updateColumn godoc
+// @Summary      Update board column
+// @Description  Updates a board column.
+// @Tags         Boards
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        column_id path int true "Column identifier."
+// @Param        request body dto.UpdateBoardColumnRequest true "Request body."
+// @Success      200  {object}  domain.BoardColumn
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/columns/{column_id} [put]
+func (h *boardHandler) updateColumn(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+	columnID := httphelp.ParseParamInt32("column_id", r)
+
+	var req dto.UpdateBoardColumnRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.boardService.UpdateColumn(r.Context(), projectID, req.ToDomain(boardID, columnID))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// deleteColumn godoc
+// @Summary      Delete board column
+// @Description  Deletes board column.
+// @Description
+// @Description  **All tasks of the column are deleted as well.**
+// @Tags         Boards
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        column_id path int true "Column identifier."
+// @Success      200
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/columns/{column_id} [delete]
+func (h *boardHandler) deleteColumn(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+	columnID := httphelp.ParseParamInt32("column_id", r)
+
+	err := h.boardService.DeleteColumn(r.Context(), projectID, boardID, columnID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// getTasks godoc
+// @Summary      Get board tasks
+// @Description  Returns list of board tasks.
+// @Tags         Boards
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Success      200  {array}   domain.BoardTask
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/tasks [get]
+func (h *boardHandler) getTasks(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+
+	response, err := h.boardService.GetTasks(r.Context(), projectID, boardID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getTask godoc
+// @Summary      Get board task
+// @Description  Returns information about single board task.
+// @Tags         Boards
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        task_id path int true "Task identifier."
+// @Success      200  {object}  domain.BoardTask
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id} [get]
+func (h *boardHandler) getTask(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+	taskID := httphelp.ParseParamInt32("task_id", r)
+
+	response, err := h.boardService.GetTask(r.Context(), projectID, boardID, taskID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// createTask godoc
+// @Summary      Create board task
+// @Description  Creates a new task in the given board column.
+// @Description
+// @Description  If status is not presented, task will be created with `Open` status.
+// @Tags         Boards
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        request body dto.CreateBoardTaskRequest true "Request body."
+// @Success      200  {object}  domain.BoardTask
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/tasks [post]
+func (h *boardHandler) createTask(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+
+	var req dto.CreateBoardTaskRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.boardService.CreateTask(r.Context(), projectID, req.ToDomain(boardID))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// updateTask godoc
+// @Summary      Update board task
+// @Description  Updates a board task. Changing `columnID` moves the task to another column of the same board.
+// @Tags         Boards
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        task_id path int true "Task identifier."
+// @Param        request body dto.UpdateBoardTaskRequest true "Request body."
+// @Success      200  {object}  domain.BoardTask
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id} [put]
+func (h *boardHandler) updateTask(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+	taskID := httphelp.ParseParamInt32("task_id", r)
+
+	var req dto.UpdateBoardTaskRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.boardService.UpdateTask(r.Context(), projectID, req.ToDomain(boardID, taskID))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// deleteTask godoc
+// @Summary      Delete board task
+// @Description  Deletes board task.
+// @Tags         Boards
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        task_id path int true "Task identifier."
+// @Success      200
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id} [delete]
+func (h *boardHandler) deleteTask(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+	taskID := httphelp.ParseParamInt32("task_id", r)
+
+	err := h.boardService.DeleteTask(r.Context(), projectID, boardID, taskID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// getTaskMembers godoc
+// @Summary      Get task members
+// @Description  Returns list of users assigned to the task.
+// @Tags         Boards
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        task_id path int true "Task identifier."
+// @Success      200  {array}   domain.BoardTaskMember
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id}/members [get]
+func (h *boardHandler) getTaskMembers(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+	taskID := httphelp.ParseParamInt32("task_id", r)
+
+	response, err := h.boardService.GetTaskMembers(r.Context(), projectID, boardID, taskID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// addTaskMember godoc
+// @Summary      Assign user to task
+// @Description  Adds user to task members list.
+// @Description
+// @Description  On success returns information about added member.
+// @Tags         Boards
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        task_id path int true "Task identifier."
+// @Param        request body dto.AddBoardTaskMemberRequest true "Request body."
+// @Success      200  {object}  domain.BoardTaskMember
+// @Failure      404  {object}  Error
+// @Failure      409  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id}/members [post]
+func (h *boardHandler) addTaskMember(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+	taskID := httphelp.ParseParamInt32("task_id", r)
+
+	var req dto.AddBoardTaskMemberRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.boardService.AddTaskMember(r.Context(), projectID, boardID, taskID, req.UserID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// removeTaskMember godoc
+// @Summary      Unassign user from task
+// @Description  Deletes the user from task members list.
+// @Tags         Boards
+// @Param        project_id path int true "Project identifier."
+// @Param        board_id path int true "Board identifier."
+// @Param        task_id path int true "Task identifier."
+// @Param        user_id path int true "User identifier."
+// @Success      200
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id}/members/{user_id} [delete]
+func (h *boardHandler) removeTaskMember(w http.ResponseWriter, r *http.Request) {
+	projectID := httphelp.ParseParamInt32("project_id", r)
+	boardID := httphelp.ParseParamInt32("board_id", r)
+	taskID := httphelp.ParseParamInt32("task_id", r)
+	userID := httphelp.ParseParamInt32("user_id", r)
+
+	err := h.boardService.RemoveTaskMember(r.Context(), projectID, boardID, taskID, userID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
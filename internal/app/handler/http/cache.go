@@ -0,0 +1,121 @@
+package http
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// publicCache lets browsers and proxies cache successful responses for maxAge.
+// JSON responses get ETag computed from the body, so unchanged data is revalidated with 304 Not Modified.
+// Files are written through, they set ETag and handle conditional requests themselves.
+func publicCache(maxAge time.Duration) func(http.Handler) http.Handler {
+	cacheControl := fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds()))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cw := &cacheWriter{ResponseWriter: w, r: r, cacheControl: cacheControl}
+			next.ServeHTTP(cw, r)
+			cw.finish()
+		})
+	}
+}
+
+// exceptPrefix applies the middleware to requests with paths not starting with the prefix.
+func exceptPrefix(prefix string, mw func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		wrapped := mw(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, prefix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			wrapped.ServeHTTP(w, r)
+		})
+	}
+}
+
+type cacheWriter struct {
+	http.ResponseWriter
+	r            *http.Request
+	cacheControl string
+	status       int
+	buf          *bytes.Buffer // Body of the response which needs ETag, nil if the response is written through
+}
+
+func (cw *cacheWriter) WriteHeader(code int) {
+	if cw.status != 0 {
+		return
+	}
+	cw.status = code
+
+	h := cw.Header()
+	switch code {
+	case http.StatusOK, http.StatusPartialContent, http.StatusNotModified:
+		h.Set("Cache-Control", cw.cacheControl)
+	}
+
+	if code == http.StatusOK && h.Get("ETag") == "" {
+		cw.buf = &bytes.Buffer{}
+		return
+	}
+
+	cw.ResponseWriter.WriteHeader(code)
+}
+
+func (cw *cacheWriter) Write(b []byte) (int, error) {
+	if cw.status == 0 {
+		cw.WriteHeader(http.StatusOK)
+	}
+	if cw.buf != nil {
+		return cw.buf.Write(b)
+	}
+	return cw.ResponseWriter.Write(b)
+}
+
+// finish writes the buffered response or 304 Not Modified if the client has it already.
+func (cw *cacheWriter) finish() {
+	if cw.buf == nil {
+		return
+	}
+
+	sum := sha256.Sum256(cw.buf.Bytes())
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	h := cw.Header()
+	h.Set("ETag", etag)
+
+	if etagMatches(cw.r.Header.Get("If-None-Match"), etag) {
+		h.Del("Content-Type")
+		h.Del("Content-Length")
+		cw.ResponseWriter.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	h.Set("Content-Length", strconv.Itoa(cw.buf.Len()))
+	cw.ResponseWriter.WriteHeader(http.StatusOK)
+	if cw.r.Method != http.MethodHead {
+		_, _ = cw.ResponseWriter.Write(cw.buf.Bytes())
+	}
+}
+
+// etagMatches reports whether If-None-Match header contains the ETag, weak comparison is used.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicCache(t *testing.T) {
+	h := publicCache(5 * time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"slug":"shop"}`))
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/projects", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "public, max-age=300", rec.Header().Get("Cache-Control"))
+	require.Equal(t, `{"slug":"shop"}`, rec.Body.String())
+
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	t.Run("should revalidate unchanged response", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/projects", nil)
+		req.Header.Set("If-None-Match", `"other", W/`+etag)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusNotModified, rec.Code)
+		require.Empty(t, rec.Body.String())
+		require.Equal(t, etag, rec.Header().Get("ETag"))
+	})
+
+	t.Run("should not cache errors", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		require.Empty(t, rec.Header().Get("Cache-Control"))
+		require.Empty(t, rec.Header().Get("ETag"))
+	})
+}
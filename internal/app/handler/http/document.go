@@ -1,9 +1,7 @@
 package http
 
 import (
-	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,11 +12,18 @@ import (
 
 //go:generate mockgen -source=document.go -destination=./mocks/document.go -package=mocks
 type DocumentService interface {
-	GetDocument(ctx context.Context, id int32) (*domain.Document, error)
+	OpenDocument(ctx context.Context, id int32) (*domain.Document, *domain.File, error)
 
-	GetProjectDocuments(ctx context.Context, id int32) ([]domain.Document, error)
-	AddDocumentToProject(ctx context.Context, doc *domain.Document, projectID int32) (*domain.Document, error)
+	GetProjectDocuments(ctx context.Context, id int32, params *domain.ListParams) ([]domain.Document, int, error)
+	AddDocumentToProject(ctx context.Context, doc *domain.Document, content io.Reader, projectID int32) (*domain.Document, error)
 	DeleteDocumentFromProject(ctx context.Context, docID int32, projectID int32) error
+	GetDeletedProjectDocuments(ctx context.Context, projectID int32, params *domain.ListParams) ([]domain.Document, int, error)
+	RestoreDocument(ctx context.Context, docID, projectID int32) (*domain.Document, error)
+
+	AddDocumentVersion(ctx context.Context, v *domain.DocumentVersion, content io.Reader, projectID int32) (*domain.Document, error)
+	GetDocumentVersions(ctx context.Context, docID int32, params *domain.ListParams) ([]domain.DocumentVersion, int, error)
+	OpenDocumentVersion(ctx context.Context, docID, version int32) (*domain.DocumentVersion, *domain.File, error)
+	RestoreDocumentVersion(ctx context.Context, docID, version, projectID int32) (*domain.Document, error)
 }
 
 type documentHandler struct {
@@ -32,6 +37,7 @@ func newDocumentHandler(documentService DocumentService) *documentHandler {
 // downloadDocument godoc
 // @Summary      Download document
 // @Description  Returns document file content if document exists.
+// @Description  Supports `Range` requests and conditional requests with `ETag` and `Last-Modified`.
 // @Tags         Documents
 // @Produce      octet-stream
 // @Param        document_id path int true "Document identifier."
@@ -42,33 +48,46 @@ func newDocumentHandler(documentService DocumentService) *documentHandler {
 func (h *documentHandler) downloadDocument(w http.ResponseWriter, r *http.Request) {
 	did := httphelp.ParseParamInt32("document_id", r)
 
-	doc, err := h.documentService.GetDocument(r.Context(), did)
+	doc, file, err := h.documentService.OpenDocument(r.Context(), did)
 	if err != nil {
 		httphelp.SendError(fmt.Errorf("getting document: %w", err), w)
 		return
 	}
+	defer file.Close()
 
-	http.ServeContent(w, r, doc.OriginalFilename, doc.CreatedAt, bytes.NewReader(doc.Content))
+	httphelp.ServeFile(w, r, doc.OriginalFilename, file)
 }
 
 // getProjectDocuments godoc
 // @Summary      Get project documents
-// @Description  Returns list of project documents.
+// @Description  Returns a page of project documents.
+// @Description  Total number of documents is returned in `X-Total-Count` header, links to other pages in `Link` header.
 // @Tags         Documents
-// @Param        project_id path int true "Project identifier."
+// @Param        project_id path  int    true  "Project identifier."
+// @Param        limit      query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset     query int    false "Number of documents to skip."
+// @Param        sort       query string false "Comma separated fields, `-` prefix for descending order: id, originalFilename, sizeBytes, createdAt."
 // @Success      200  {array}   domain.Document
+// @Failure      400  {object}  Error
 // @Failure      404  {object}  Error
 // @Failure      500  {object}  Error
 // @Router       /api/v1/projects/{project_id}/documents [get]
 func (h *documentHandler) getProjectDocuments(w http.ResponseWriter, r *http.Request) {
 	pid := httphelp.ParseParamInt32("project_id", r)
 
-	docs, err := h.documentService.GetProjectDocuments(r.Context(), pid)
+	params, err := httphelp.ParseListParams(r, "id", "originalFilename", "sizeBytes", "createdAt")
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	docs, total, err := h.documentService.GetProjectDocuments(r.Context(), pid, params)
 	if err != nil {
 		httphelp.SendError(fmt.Errorf("getting project documents: %w", err), w)
 		return
 	}
 
+	httphelp.SetListHeaders(w, r, params, total)
 	httphelp.SendJSON(http.StatusOK, docs, w)
 }
 
@@ -77,7 +96,7 @@ func (h *documentHandler) getProjectDocuments(w http.ResponseWriter, r *http.Req
 // @Summary      Add document to project
 // @Description  Adds document to project.
 // @Description
-// @Description  Accepts `multipart/form-data` and document file.
+// @Description  Accepts `multipart/form-data` and document file up to 5MB, the file is streamed to the storage.
 // @Tags         Documents
 // @Accept       mpfd
 // @Produce      json
@@ -91,31 +110,19 @@ func (h *documentHandler) getProjectDocuments(w http.ResponseWriter, r *http.Req
 func (h *documentHandler) addDocumentToProject(w http.ResponseWriter, r *http.Request) {
 	pid := httphelp.ParseParamInt32("project_id", r)
 
-	file, header, err := r.FormFile("file")
+	// File is streamed to the storage straight from the request body.
+	part, err := httphelp.FormFilePart(r, "file")
 	if err != nil {
-		if errors.Is(err, http.ErrMissingFile) {
-			// TODO: custom http error
-			httphelp.SendError(fmt.Errorf("file is not presented"), w)
-			return
-		}
 		httphelp.SendError(fmt.Errorf("parsing form file: %w", err), w)
 		return
 	}
-	defer file.Close()
-
-	content, err := io.ReadAll(file)
-	if err != nil {
-		httphelp.SendError(fmt.Errorf("reading file: %w", err), w)
-		return
-	}
+	defer part.Close()
 
 	doc := &domain.Document{
-		OriginalFilename: header.Filename,
-		SizeBytes:        int32(header.Size),
-		Content:          content,
+		OriginalFilename: part.FileName(),
 	}
 
-	document, err := h.documentService.AddDocumentToProject(r.Context(), doc, pid)
+	document, err := h.documentService.AddDocumentToProject(r.Context(), doc, part, pid)
 	if err != nil {
 		httphelp.SendError(fmt.Errorf("adding document to project: %w", err), w)
 		return
@@ -126,9 +133,8 @@ func (h *documentHandler) addDocumentToProject(w http.ResponseWriter, r *http.Re
 
 // removeDocumentFromProject godoc
 // @Summary      Delete document from project
-// @Description  Deletes document from projects.
-// @Description
-// @Description  **Also deletes file forever, it will never be accepted in the future.**
+// @Description  Moves document to the trash. It can be restored until the retention period ends,
+// @Description  then it is deleted forever with files of all its versions.
 // @Tags         Documents
 // @Param        project_id path int true "Project identifier."
 // @Param        document_id path int true "Document identifier."
@@ -149,3 +155,191 @@ func (h *documentHandler) removeDocumentFromProject(w http.ResponseWriter, r *ht
 
 	w.WriteHeader(http.StatusOK)
 }
+
+// getDeletedProjectDocuments godoc
+// @Summary      Get project documents in the trash
+// @Description  Returns a page of deleted project documents which are not purged yet.
+// @Description  Total number of documents is returned in `X-Total-Count` header, links to other pages in `Link` header.
+// @Tags         Trash
+// @Produce      json
+// @Param        project_id path  int    true  "Project identifier."
+// @Param        limit      query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset     query int    false "Number of documents to skip."
+// @Param        sort       query string false "Comma separated fields, `-` prefix for descending order: id, originalFilename, sizeBytes, createdAt, deletedAt."
+// @Success      200  {array}   domain.Document
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/trash/documents [get]
+func (h *documentHandler) getDeletedProjectDocuments(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+
+	params, err := httphelp.ParseListParams(r, "id", "originalFilename", "sizeBytes", "createdAt", "deletedAt")
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	docs, total, err := h.documentService.GetDeletedProjectDocuments(r.Context(), pid, params)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("getting deleted project documents: %w", err), w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
+	httphelp.SendJSON(http.StatusOK, docs, w)
+}
+
+// restoreDocument godoc
+// @Security     CSRF
+// @Summary      Restore project document
+// @Description  Moves document out of the trash.
+// @Tags         Trash
+// @Produce      json
+// @Param        project_id  path int true "Project identifier."
+// @Param        document_id path int true "Document identifier."
+// @Success      200  {object}	domain.Document
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/trash/documents/{document_id}/restore [post]
+func (h *documentHandler) restoreDocument(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+	did := httphelp.ParseParamInt32("document_id", r)
+
+	document, err := h.documentService.RestoreDocument(r.Context(), did, pid)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("restoring document: %w", err), w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, document, w)
+}
+
+// getDocumentVersions godoc
+// @Summary      Get document versions
+// @Description  Returns a page of document versions, the latest first by default.
+// @Description  Total number of versions is returned in `X-Total-Count` header, links to other pages in `Link` header.
+// @Tags         Documents
+// @Param        document_id path  int    true  "Document identifier."
+// @Param        limit       query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset      query int    false "Number of versions to skip."
+// @Param        sort        query string false "Comma separated fields, `-` prefix for descending order: version, createdAt."
+// @Success      200  {array}   domain.DocumentVersion
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/documents/{document_id}/versions [get]
+func (h *documentHandler) getDocumentVersions(w http.ResponseWriter, r *http.Request) {
+	did := httphelp.ParseParamInt32("document_id", r)
+
+	params, err := httphelp.ParseListParams(r, "version", "createdAt")
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	versions, total, err := h.documentService.GetDocumentVersions(r.Context(), did, params)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("getting document versions: %w", err), w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
+	httphelp.SendJSON(http.StatusOK, versions, w)
+}
+
+// downloadDocumentVersion godoc
+// @Summary      Download document version
+// @Description  Returns file content of the document version.
+// @Description  Supports `Range` requests and conditional requests with `ETag` and `Last-Modified`.
+// @Tags         Documents
+// @Produce      octet-stream
+// @Param        document_id path int true "Document identifier."
+// @Param        version     path int true "Version number."
+// @Success      200
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/documents/{document_id}/versions/{version} [get]
+func (h *documentHandler) downloadDocumentVersion(w http.ResponseWriter, r *http.Request) {
+	did := httphelp.ParseParamInt32("document_id", r)
+	version := httphelp.ParseParamInt32("version", r)
+
+	v, file, err := h.documentService.OpenDocumentVersion(r.Context(), did, version)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("getting document version: %w", err), w)
+		return
+	}
+	defer file.Close()
+
+	httphelp.ServeFile(w, r, v.OriginalFilename, file)
+}
+
+// addDocumentVersion godoc
+// @Security     CSRF
+// @Summary      Upload new document version
+// @Description  Uploads a new version of the document and makes it current. Previous versions are kept.
+// @Description
+// @Description  Accepts `multipart/form-data` and document file up to 5MB, the file is streamed to the storage.
+// @Tags         Documents
+// @Accept       mpfd
+// @Produce      json
+// @Param        project_id  path int true "Project identifier."
+// @Param        document_id path int true "Document identifier."
+// @Param        file formData file true "Document file."
+// @Success      200  {object}	domain.Document
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/documents/{document_id}/versions [post]
+func (h *documentHandler) addDocumentVersion(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+	did := httphelp.ParseParamInt32("document_id", r)
+
+	part, err := httphelp.FormFilePart(r, "file")
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("parsing form file: %w", err), w)
+		return
+	}
+	defer part.Close()
+
+	v := &domain.DocumentVersion{
+		DocumentID:       did,
+		OriginalFilename: part.FileName(),
+	}
+
+	document, err := h.documentService.AddDocumentVersion(r.Context(), v, part, pid)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("adding document version: %w", err), w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, document, w)
+}
+
+// restoreDocumentVersion godoc
+// @Security     CSRF
+// @Summary      Restore document version
+// @Description  Makes a copy of the given version the current version of the document.
+// @Tags         Documents
+// @Produce      json
+// @Param        project_id  path int true "Project identifier."
+// @Param        document_id path int true "Document identifier."
+// @Param        version     path int true "Version number."
+// @Success      200  {object}	domain.Document
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/documents/{document_id}/versions/{version}/restore [post]
+func (h *documentHandler) restoreDocumentVersion(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+	did := httphelp.ParseParamInt32("document_id", r)
+	version := httphelp.ParseParamInt32("version", r)
+
+	document, err := h.documentService.RestoreDocumentVersion(r.Context(), did, version, pid)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("restoring document version: %w", err), w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, document, w)
+}
@@ -0,0 +1,114 @@
+package dto
+
+import "web-studio-backend/internal/app/domain"
+
+type (
+	CreateBoardRequest struct {
+		Title string `json:"title"`
+	}
+
+	UpdateBoardRequest struct {
+		Title string `json:"title"`
+	}
+
+	CreateBoardColumnRequest struct {
+		Title string `json:"title"`
+	}
+
+	UpdateBoardColumnRequest struct {
+		Title string `json:"title"`
+	}
+
+	CreateBoardTaskRequest struct {
+		ColumnID    int32                  `json:"columnID"`
+		Title       string                 `json:"title"`
+		Description string                 `json:"description"`
+		Status      domain.BoardTaskStatus `json:"status,omitempty"`
+	}
+
+	UpdateBoardTaskRequest struct {
+		ColumnID    int32                  `json:"columnID"`
+		Title       string                 `json:"title"`
+		Description string                 `json:"description"`
+		Status      domain.BoardTaskStatus `json:"status"`
+	}
+
+	AddBoardTaskMemberRequest struct {
+		UserID int32 `json:"userID"`
+	}
+)
+
+func (r *CreateBoardRequest) ToDomain(projectID int32) *domain.Board {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.Board{
+		Title:     r.Title,
+		ProjectID: projectID,
+	}
+}
+
+func (r *UpdateBoardRequest) ToDomain(projectID, boardID int32) *domain.Board {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.Board{
+		ID:        boardID,
+		Title:     r.Title,
+		ProjectID: projectID,
+	}
+}
+
+func (r *CreateBoardColumnRequest) ToDomain(boardID int32) *domain.BoardColumn {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.BoardColumn{
+		BoardID: boardID,
+		Title:   r.Title,
+	}
+}
+
+func (r *UpdateBoardColumnRequest) ToDomain(boardID, columnID int32) *domain.BoardColumn {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.BoardColumn{
+		ID:      columnID,
+		BoardID: boardID,
+		Title:   r.Title,
+	}
+}
+
+func (r *CreateBoardTaskRequest) ToDomain(boardID int32) *domain.BoardTask {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.BoardTask{
+		BoardID:     boardID,
+		ColumnID:    r.ColumnID,
+		Title:       r.Title,
+		Description: r.Description,
+		Status:      r.Status,
+	}
+}
+
+func (r *UpdateBoardTaskRequest) ToDomain(boardID, taskID int32) *domain.BoardTask {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.BoardTask{
+		ID:          taskID,
+		BoardID:     boardID,
+		ColumnID:    r.ColumnID,
+		Title:       r.Title,
+		Description: r.Description,
+		Status:      r.Status,
+	}
+}
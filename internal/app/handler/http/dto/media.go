@@ -0,0 +1,49 @@
+package dto
+
+import "web-studio-backend/internal/app/domain"
+
+type (
+	AddVideoRequest struct {
+		VideoURL string `json:"videoURL"`
+		Caption  string `json:"caption"` // Markdown
+		IsCover  bool   `json:"isCover"`
+	}
+
+	UpdateMediaRequest struct {
+		VideoURL string `json:"videoURL,omitempty"` // Only for videos, kept unchanged if empty
+		Caption  string `json:"caption"`            // Markdown
+		IsCover  bool   `json:"isCover"`
+	}
+
+	ReorderMediaRequest struct {
+		IDs []int32 `json:"ids"` // Identifiers of all gallery items in the new order
+	}
+)
+
+func (r *AddVideoRequest) ToDomain(projectID int32) *domain.ProjectMedia {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.ProjectMedia{
+		ProjectID: projectID,
+		Type:      domain.MediaTypeVideo,
+		VideoURL:  r.VideoURL,
+		Caption:   r.Caption,
+		IsCover:   r.IsCover,
+	}
+}
+
+func (r *UpdateMediaRequest) ToDomain(mediaID, projectID int32) *domain.ProjectMedia {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.ProjectMedia{
+		ID:        mediaID,
+		ProjectID: projectID,
+		VideoURL:  r.VideoURL,
+		Caption:   r.Caption,
+		IsCover:   r.IsCover,
+	}
+}
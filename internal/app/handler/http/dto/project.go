@@ -9,6 +9,7 @@ import (
 type (
 	CreateProjectRequest struct {
 		Title        string     `json:"title"`
+		Slug         string     `json:"slug,omitempty"` // Generated from the title if empty
 		Description  string     `json:"description"`
 		Link         string     `json:"link,omitempty"`
 		StartedAt    *time.Time `json:"startedAt,omitempty"`
@@ -20,6 +21,7 @@ type (
 
 	UpdateProjectRequest struct {
 		Title        string     `json:"title"`
+		Slug         string     `json:"slug,omitempty"` // Kept unchanged if empty
 		Description  string     `json:"description"`
 		Link         string     `json:"link,omitempty"`
 		StartedAt    *time.Time `json:"startedAt,omitempty"`
@@ -55,6 +57,7 @@ func (r *CreateProjectRequest) ToDomain() *domain.Project {
 
 	return &domain.Project{
 		Title:        r.Title,
+		Slug:         r.Slug,
 		Description:  r.Description,
 		Link:         r.Link,
 		TeamID:       r.TeamID,
@@ -73,6 +76,7 @@ func (r *UpdateProjectRequest) ToDomain(projectID int32) *domain.Project {
 	return &domain.Project{
 		ID:           projectID,
 		Title:        r.Title,
+		Slug:         r.Slug,
 		Description:  r.Description,
 		Link:         r.Link,
 		TeamID:       r.TeamID,
@@ -5,12 +5,25 @@ import "web-studio-backend/internal/app/domain"
 type (
 	CreateTeamRequest struct {
 		Title       string `json:"title"`
+		Slug        string `json:"slug,omitempty"` // Generated from the title if empty
 		Description string `json:"description"`
 	}
 	UpdateTeamRequest struct {
 		Title       string `json:"title"`
+		Slug        string `json:"slug,omitempty"` // Kept unchanged if empty
 		Description string `json:"description"`
 	}
+
+	AddTeamMemberRequest struct {
+		UserID   int32               `json:"userID"`
+		Role     domain.UserRole     `json:"role"`
+		Position domain.UserPosition `json:"position"`
+	}
+
+	UpdateTeamMemberRequest struct {
+		Role     domain.UserRole     `json:"role"`
+		Position domain.UserPosition `json:"position"`
+	}
 )
 
 func (r *CreateTeamRequest) ToDomain() *domain.Team {
@@ -20,6 +33,7 @@ func (r *CreateTeamRequest) ToDomain() *domain.Team {
 
 	return &domain.Team{
 		Title:       r.Title,
+		Slug:        r.Slug,
 		Description: r.Description,
 	}
 }
@@ -32,6 +46,33 @@ func (r *UpdateTeamRequest) ToDomain(teamID int32) *domain.Team {
 	return &domain.Team{
 		ID:          teamID,
 		Title:       r.Title,
+		Slug:        r.Slug,
 		Description: r.Description,
 	}
 }
+
+func (r *AddTeamMemberRequest) ToDomain(teamID int32) *domain.TeamMember {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.TeamMember{
+		UserID:   r.UserID,
+		TeamID:   teamID,
+		Role:     r.Role,
+		Position: r.Position,
+	}
+}
+
+func (r *UpdateTeamMemberRequest) ToDomain(teamID, userID int32) *domain.TeamMember {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.TeamMember{
+		UserID:   userID,
+		TeamID:   teamID,
+		Role:     r.Role,
+		Position: r.Position,
+	}
+}
@@ -0,0 +1,33 @@
+package dto
+
+import "web-studio-backend/internal/app/domain"
+
+// TechnologyRequest is used both to create and to update a technology.
+type TechnologyRequest struct {
+	Name     string                    `json:"name"`
+	Aliases  []string                  `json:"aliases"`            // Alternative spellings, e.g. "golang" for Go
+	Icon     string                    `json:"icon"`               // Icon URL
+	Category domain.TechnologyCategory `json:"category,omitempty"` // Default is "other"
+}
+
+func (r *TechnologyRequest) ToDomain(id int32) *domain.Technology {
+	if r == nil {
+		return nil
+	}
+
+	t := &domain.Technology{
+		ID:       id,
+		Name:     r.Name,
+		Aliases:  r.Aliases,
+		Icon:     r.Icon,
+		Category: r.Category,
+	}
+	if t.Aliases == nil {
+		t.Aliases = []string{}
+	}
+	if t.Category == "" {
+		t.Category = domain.TechnologyCategoryOther
+	}
+
+	return t
+}
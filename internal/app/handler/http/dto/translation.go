@@ -0,0 +1,20 @@
+package dto
+
+import "web-studio-backend/internal/app/domain"
+
+type SetTranslationRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"` // Description in the default language is used if empty
+}
+
+func (r *SetTranslationRequest) ToDomain(locale string) *domain.Translation {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.Translation{
+		Locale:      locale,
+		Title:       r.Title,
+		Description: r.Description,
+	}
+}
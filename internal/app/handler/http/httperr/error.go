@@ -17,6 +17,7 @@ const (
 	ErrorTypeUnauthorized   ErrorType = "UNAUTHORIZED"
 	ErrorTypeForbidden      ErrorType = "FORBIDDEN"
 	ErrorTypeValidation     ErrorType = "VALIDATION"
+	ErrorTypeTooMany        ErrorType = "TOO_MANY_REQUESTS"
 )
 
 type (
@@ -65,6 +66,9 @@ func ParseAppError(ae *apperr.Error) *Error {
 	case apperr.ForbiddenType:
 		httpError.Type = ErrorTypeForbidden
 		httpError.HttpCode = http.StatusForbidden
+	case apperr.TooManyRequestsType:
+		httpError.Type = ErrorTypeTooMany
+		httpError.HttpCode = http.StatusTooManyRequests
 	default:
 		// TODO: hide message
 		httpError.Type = ErrorTypeInternal
@@ -0,0 +1,57 @@
+package httphelp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+)
+
+// ServeFile replies with the file content. Range, If-Range, If-None-Match and
+// If-Modified-Since requests are handled the same way as by http.ServeContent.
+func ServeFile(w http.ResponseWriter, r *http.Request, name string, file *domain.File) {
+	if file.ETag != "" {
+		w.Header().Set("ETag", file.ETag)
+	}
+
+	http.ServeContent(w, r, name, file.ModTime, file)
+}
+
+// FormFilePart returns multipart part with file of the given form field without buffering
+// the request body in memory or temporary files, unlike r.FormFile.
+// Fields which precede the file are skipped.
+func FormFilePart(r *http.Request, name string) (*multipart.Part, error) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, apperr.NewInvalidRequest("Request must be multipart/form-data.", "")
+	}
+
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, apperr.NewInvalidRequest("File is not presented.", name)
+			}
+			return nil, fmt.Errorf("reading multipart: %w", err)
+		}
+
+		if part.FormName() == name && part.FileName() != "" {
+			return part, nil
+		}
+		_ = part.Close()
+	}
+}
+
+// QueryImageSize parses optional `size` query parameter of image endpoints, zero means the default size.
+func QueryImageSize(r *http.Request) (int, error) {
+	size, err := QueryInt32("size", r)
+	if err != nil || size == nil {
+		return 0, err
+	}
+
+	return int(*size), nil
+}
@@ -0,0 +1,76 @@
+package httphelp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/domain"
+)
+
+type nopCloser struct {
+	*strings.Reader
+}
+
+func (nopCloser) Close() error { return nil }
+
+func TestServeFile(t *testing.T) {
+	modTime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	newFile := func() *domain.File {
+		return &domain.File{
+			ReadSeekCloser: nopCloser{strings.NewReader("hello, world!")},
+			Size:           13,
+			ModTime:        modTime,
+			ETag:           `"etag"`,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "full content",
+			wantCode: http.StatusOK,
+			wantBody: "hello, world!",
+		},
+		{
+			name:     "range",
+			headers:  map[string]string{"Range": "bytes=7-11"},
+			wantCode: http.StatusPartialContent,
+			wantBody: "world",
+		},
+		{
+			name:     "etag matches",
+			headers:  map[string]string{"If-None-Match": `"etag"`},
+			wantCode: http.StatusNotModified,
+		},
+		{
+			name:     "not modified since",
+			headers:  map[string]string{"If-Modified-Since": modTime.Format(http.TimeFormat)},
+			wantCode: http.StatusNotModified,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/file", nil)
+			for k, v := range tc.headers {
+				r.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+
+			ServeFile(w, r, "file.txt", newFile())
+
+			require.Equal(tt, tc.wantCode, w.Code)
+			require.Equal(tt, tc.wantBody, w.Body.String())
+			require.Equal(tt, `"etag"`, w.Header().Get("ETag"))
+		})
+	}
+}
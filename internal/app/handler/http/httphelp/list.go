@@ -0,0 +1,162 @@
+package httphelp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+)
+
+// ParseListParams parses limit, offset and sort query parameters.
+//
+// Sort is a comma separated list of fields, "-" prefix means descending order, e.g. ?sort=-createdAt,title.
+// Only sortable fields are accepted.
+func ParseListParams(r *http.Request, sortable ...string) (*domain.ListParams, error) {
+	params := &domain.ListParams{Limit: domain.DefaultListLimit}
+
+	query := r.URL.Query()
+
+	if limit := query.Get("limit"); limit != "" {
+		val, err := strconv.Atoi(limit)
+		if err != nil || val < 1 || val > domain.MaxListLimit {
+			return nil, apperr.NewInvalidRequest(
+				fmt.Sprintf("Limit must be a number from 1 to %d.", domain.MaxListLimit),
+				"limit",
+			)
+		}
+		params.Limit = val
+	}
+
+	if offset := query.Get("offset"); offset != "" {
+		val, err := strconv.Atoi(offset)
+		if err != nil || val < 0 {
+			return nil, apperr.NewInvalidRequest("Offset must be a non-negative number.", "offset")
+		}
+		params.Offset = val
+	}
+
+	if sort := query.Get("sort"); sort != "" {
+		for _, field := range strings.Split(sort, ",") {
+			var sf domain.SortField
+			sf.Name, sf.Desc = strings.CutPrefix(strings.TrimSpace(field), "-")
+
+			if !slices.Contains(sortable, sf.Name) {
+				return nil, apperr.NewInvalidRequest(
+					fmt.Sprintf("Cannot sort by %q, available fields: %s.", sf.Name, strings.Join(sortable, ", ")),
+					"sort",
+				)
+			}
+
+			params.Sort = append(params.Sort, sf)
+		}
+	}
+
+	return params, nil
+}
+
+// QueryInt32 parses optional integer query parameter.
+func QueryInt32(name string, r *http.Request) (*int32, error) {
+	param := r.URL.Query().Get(name)
+	if param == "" {
+		return nil, nil
+	}
+
+	val, err := strconv.ParseInt(param, 10, 32)
+	if err != nil {
+		return nil, apperr.NewInvalidRequest(fmt.Sprintf("Parameter %s must be a number.", name), name)
+	}
+
+	res := int32(val)
+	return &res, nil
+}
+
+// QueryBool parses optional boolean query parameter.
+func QueryBool(name string, r *http.Request) (*bool, error) {
+	param := r.URL.Query().Get(name)
+	if param == "" {
+		return nil, nil
+	}
+
+	val, err := strconv.ParseBool(param)
+	if err != nil {
+		return nil, apperr.NewInvalidRequest(fmt.Sprintf("Parameter %s must be true or false.", name), name)
+	}
+
+	return &val, nil
+}
+
+// QueryTime parses optional RFC 3339 time query parameter.
+func QueryTime(name string, r *http.Request) (*time.Time, error) {
+	param := r.URL.Query().Get(name)
+	if param == "" {
+		return nil, nil
+	}
+
+	val, err := time.Parse(time.RFC3339, param)
+	if err != nil {
+		return nil, apperr.NewInvalidRequest(fmt.Sprintf("Parameter %s must be a time in RFC 3339 format.", name), name)
+	}
+
+	return &val, nil
+}
+
+// QueryStrings parses optional comma separated list query parameter.
+func QueryStrings(name string, r *http.Request) []string {
+	param := r.URL.Query().Get(name)
+	if param == "" {
+		return nil
+	}
+
+	var res []string
+	for _, s := range strings.Split(param, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			res = append(res, s)
+		}
+	}
+
+	return res
+}
+
+// SetListHeaders sets X-Total-Count header and Link header (RFC 8288)
+// with first, prev, next and last pages of the list.
+//
+//	Must be called before the response body is written.
+func SetListHeaders(w http.ResponseWriter, r *http.Request, params *domain.ListParams, total int) {
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+
+	if params == nil || params.Limit <= 0 {
+		return
+	}
+
+	var links []string
+	link := func(offset int, rel string) {
+		query := r.URL.Query()
+		query.Set("limit", strconv.Itoa(params.Limit))
+		query.Set("offset", strconv.Itoa(offset))
+
+		u := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+		links = append(links, fmt.Sprintf(`<%s>; rel="%s"`, u.String(), rel))
+	}
+
+	last := 0
+	if total > 0 {
+		last = (total - 1) / params.Limit * params.Limit
+	}
+
+	link(0, "first")
+	if params.Offset > 0 {
+		link(min(max(params.Offset-params.Limit, 0), last), "prev")
+	}
+	if params.Offset+params.Limit < total {
+		link(params.Offset+params.Limit, "next")
+	}
+	link(last, "last")
+
+	w.Header().Set("Link", strings.Join(links, ", "))
+}
@@ -0,0 +1,80 @@
+package httphelp
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/domain"
+)
+
+func TestParseListParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		res     *domain.ListParams
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			url:  "/api/v1/projects",
+			res:  &domain.ListParams{Limit: domain.DefaultListLimit},
+		},
+		{
+			name: "all params",
+			url:  "/api/v1/projects?limit=10&offset=20&sort=-createdAt,title",
+			res: &domain.ListParams{
+				Limit:  10,
+				Offset: 20,
+				Sort:   []domain.SortField{{Name: "createdAt", Desc: true}, {Name: "title"}},
+			},
+		},
+		{
+			name:    "limit too big",
+			url:     "/api/v1/projects?limit=1000",
+			wantErr: true,
+		},
+		{
+			name:    "negative offset",
+			url:     "/api/v1/projects?offset=-1",
+			wantErr: true,
+		},
+		{
+			name:    "unknown sort field",
+			url:     "/api/v1/projects?sort=password",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			r := httptest.NewRequest("GET", tc.url, nil)
+
+			res, err := ParseListParams(r, "title", "createdAt")
+			if tc.wantErr {
+				require.Error(tt, err)
+				return
+			}
+
+			require.NoError(tt, err)
+			require.Equal(tt, tc.res, res)
+		})
+	}
+}
+
+func TestSetListHeaders(t *testing.T) {
+	r := httptest.NewRequest("GET", "/api/v1/projects?limit=10&offset=10&sort=title", nil)
+	w := httptest.NewRecorder()
+
+	SetListHeaders(w, r, &domain.ListParams{Limit: 10, Offset: 10}, 35)
+
+	require.Equal(t, "35", w.Header().Get("X-Total-Count"))
+	require.Equal(t,
+		`</api/v1/projects?limit=10&offset=0&sort=title>; rel="first", `+
+			`</api/v1/projects?limit=10&offset=0&sort=title>; rel="prev", `+
+			`</api/v1/projects?limit=10&offset=20&sort=title>; rel="next", `+
+			`</api/v1/projects?limit=10&offset=30&sort=title>; rel="last"`,
+		w.Header().Get("Link"),
+	)
+}
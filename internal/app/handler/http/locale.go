@@ -0,0 +1,23 @@
+package http
+
+import (
+	"net/http"
+
+	"web-studio-backend/internal/pkg/locale"
+)
+
+// negotiateLocale picks the response language from `lang` query parameter or Accept-Language header.
+// Caches are told that responses vary by the header, the chosen language is sent in Content-Language.
+func negotiateLocale(supported []string, fallback string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			loc := locale.Negotiate(r.URL.Query().Get("lang"), r.Header.Get("Accept-Language"), supported, fallback)
+
+			h := w.Header()
+			h.Add("Vary", "Accept-Language")
+			h.Set("Content-Language", loc)
+
+			next.ServeHTTP(w, r.WithContext(locale.NewContext(r.Context(), loc)))
+		})
+	}
+}
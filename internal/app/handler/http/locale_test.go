@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/pkg/locale"
+)
+
+func TestNegotiateLocale(t *testing.T) {
+	t.Parallel()
+
+	var got string
+	handler := negotiateLocale([]string{"ru", "en"}, "ru")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = locale.FromContext(r.Context())
+	}))
+
+	tests := []struct {
+		name           string
+		target         string
+		acceptLanguage string
+		want           string
+	}{
+		{"should use default locale", "/projects", "", "ru"},
+		{"should use Accept-Language header", "/projects", "de, en-US;q=0.8, ru;q=0.5", "en"},
+		{"should prefer query parameter", "/projects?lang=ru", "en", "ru"},
+		{"should ignore unsupported query parameter", "/projects?lang=de", "en", "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.acceptLanguage != "" {
+				r.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.want, w.Header().Get("Content-Language"))
+			require.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/handler/http/dto"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+)
+
+//go:generate mockgen -source=media.go -destination=./mocks/media.go -package=mocks
+type MediaService interface {
+	GetProjectMedia(ctx context.Context, projectID int32) ([]domain.ProjectMedia, error)
+	GetMedia(ctx context.Context, mediaID, projectID int32) (*domain.ProjectMedia, error)
+	AddMedia(ctx context.Context, media *domain.ProjectMedia, img []byte) (*domain.ProjectMedia, error)
+	UpdateMedia(ctx context.Context, media *domain.ProjectMedia) (*domain.ProjectMedia, error)
+	ReorderMedia(ctx context.Context, projectID int32, mediaIDs []int32) ([]domain.ProjectMedia, error)
+	DeleteMedia(ctx context.Context, mediaID, projectID int32) error
+	GetMediaImage(ctx context.Context, mediaID, projectID int32, size int) (*domain.ProjectMedia, *domain.File, error)
+}
+
+type mediaHandler struct {
+	mediaService MediaService
+}
+
+func newMediaHandler(ms MediaService) *mediaHandler {
+	return &mediaHandler{ms}
+}
+
+// getProjectMedia godoc
+// @Summary      Get project gallery
+// @Description  Returns images and video links of the project gallery in display order.
+// @Description  Captions are Markdown, `captionHtml` contains them rendered to sanitized HTML.
+// @Tags         Project media
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Success      200  {array}   domain.ProjectMedia
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/media [get]
+func (h *mediaHandler) getProjectMedia(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+
+	response, err := h.mediaService.GetProjectMedia(r.Context(), pid)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getMedia godoc
+// @Summary      Get project gallery item
+// @Description  Returns single image or video link of the project gallery.
+// @Tags         Project media
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        media_id   path int true "Gallery item identifier."
+// @Success      200  {object}  domain.ProjectMedia
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/media/{media_id} [get]
+func (h *mediaHandler) getMedia(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+	mid := httphelp.ParseParamInt32("media_id", r)
+
+	response, err := h.mediaService.GetMedia(r.Context(), mid, pid)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// addImage godoc
+// @Summary      Add image to project gallery
+// @Description  Appends an image to the end of the project gallery. Accepts `multipart/form-data`.
+// @Description
+// @Description  Note: if the image is the cover, the previous cover image stops being it.
+// @Tags         Project media
+// @Accept       mpfd
+// @Produce      json
+// @Param        project_id path     int    true  "Project identifier."
+// @Param        file       formData file   true  "Image file. MUST have one of the following mime types: [`image/jpeg`, `image/png`, `image/webp`]"
+// @Param        caption    formData string false "Caption in Markdown."
+// @Param        isCover    formData bool   false "Whether the image is the project cover."
+// @Success      201  {object}  domain.ProjectMedia
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      409  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/media/images [post]
+func (h *mediaHandler) addImage(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		if errors.Is(err, http.ErrMissingFile) {
+			httphelp.SendError(apperr.NewInvalidRequest("Image file is required.", "file"), w)
+			return
+		}
+		httphelp.SendError(fmt.Errorf("parsing form file: %w", err), w)
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("reading file: %w", err), w)
+		return
+	}
+
+	media := &domain.ProjectMedia{
+		ProjectID: pid,
+		Type:      domain.MediaTypeImage,
+		Caption:   r.FormValue("caption"),
+	}
+
+	if isCover := r.FormValue("isCover"); isCover != "" {
+		media.IsCover, err = strconv.ParseBool(isCover)
+		if err != nil {
+			httphelp.SendError(apperr.NewInvalidRequest("Field isCover must be true or false.", "isCover"), w)
+			return
+		}
+	}
+
+	response, err := h.mediaService.AddMedia(r.Context(), media, content)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("adding media image: %w", err), w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusCreated, response, w)
+}
+
+// addVideo godoc
+// @Summary      Add video link to project gallery
+// @Description  Appends a link to a video, e.g. on a video hosting, to the end of the project gallery.
+// @Tags         Project media
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        request body dto.AddVideoRequest true "Request body."
+// @Success      201  {object}  domain.ProjectMedia
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/media/videos [post]
+func (h *mediaHandler) addVideo(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+
+	var req dto.AddVideoRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.mediaService.AddMedia(r.Context(), req.ToDomain(pid), nil)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusCreated, response, w)
+}
+
+// updateMedia godoc
+// @Summary      Update project gallery item
+// @Description  Updates caption and cover flag of the gallery item, videos can also get a new link.
+// @Description
+// @Description  Note: if the image becomes the cover, the previous cover image stops being it.
+// @Tags         Project media
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        media_id   path int true "Gallery item identifier."
+// @Param        request body dto.UpdateMediaRequest true "Request body."
+// @Success      200  {object}  domain.ProjectMedia
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      409  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/media/{media_id} [put]
+func (h *mediaHandler) updateMedia(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+	mid := httphelp.ParseParamInt32("media_id", r)
+
+	var req dto.UpdateMediaRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.mediaService.UpdateMedia(r.Context(), req.ToDomain(mid, pid))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// reorderMedia godoc
+// @Summary      Reorder project gallery
+// @Description  Arranges the gallery in the order of identifiers. Every item of the gallery must be listed exactly once.
+// @Tags         Project media
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        request body dto.ReorderMediaRequest true "Request body."
+// @Success      200  {array}   domain.ProjectMedia
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/media/order [put]
+func (h *mediaHandler) reorderMedia(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+
+	var req dto.ReorderMediaRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.mediaService.ReorderMedia(r.Context(), pid, req.IDs)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// deleteMedia godoc
+// @Summary      Delete project gallery item
+// @Description  Removes image or video link from the project gallery.
+// @Tags         Project media
+// @Param        project_id path int true "Project identifier."
+// @Param        media_id   path int true "Gallery item identifier."
+// @Success      200
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/media/{media_id} [delete]
+func (h *mediaHandler) deleteMedia(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+	mid := httphelp.ParseParamInt32("media_id", r)
+
+	err := h.mediaService.DeleteMedia(r.Context(), mid, pid)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// getMediaImage godoc
+// @Summary      Get project gallery image
+// @Description  Returns image of the gallery item.
+// @Description  Images are stored in several sizes, the largest one (1024px) is returned by default.
+// @Tags         Project media
+// @Produce      octet-stream
+// @Param        project_id path  int true  "Project identifier."
+// @Param        media_id   path  int true  "Gallery item identifier."
+// @Param        size       query int false "Maximum width and height of the image: 64, 256 or 1024."
+// @Success      200
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/media/{media_id}/image [get]
+func (h *mediaHandler) getMediaImage(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+	mid := httphelp.ParseParamInt32("media_id", r)
+
+	size, err := httphelp.QueryImageSize(r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	media, file, err := h.mediaService.GetMediaImage(r.Context(), mid, pid, size)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("getting media image: %w", err), w)
+		return
+	}
+	defer file.Close()
+
+	httphelp.ServeFile(w, r, fmt.Sprintf("media-%d%s", media.ID, filepath.Ext(media.ImageID)), file)
+}
@@ -0,0 +1,291 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: board.go
+//
+// Generated by this command:
+//
+//	mockgen -source=board.go -destination=./mocks/board.go -package=mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "web-studio-backend/internal/app/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBoardService is a mock of BoardService interface.
+type MockBoardService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardServiceMockRecorder
+}
+
+// MockBoardServiceMockRecorder is the mock recorder for MockBoardService.
+type MockBoardServiceMockRecorder struct {
+	mock *MockBoardService
+}
+
+// NewMockBoardService creates a new mock instance.
+func NewMockBoardService(ctrl *gomock.Controller) *MockBoardService {
+	mock := &MockBoardService{ctrl: ctrl}
+	mock.recorder = &MockBoardServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardService) EXPECT() *MockBoardServiceMockRecorder {
+	return m.recorder
+}
+
+// AddTaskMember mocks base method.
+func (m *MockBoardService) AddTaskMember(ctx context.Context, projectID, boardID, taskID, userID int32) (*domain.BoardTaskMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTaskMember", ctx, projectID, boardID, taskID, userID)
+	ret0, _ := ret[0].(*domain.BoardTaskMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTaskMember indicates an expected call of AddTaskMember.
+func (mr *MockBoardServiceMockRecorder) AddTaskMember(ctx, projectID, boardID, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTaskMember", reflect.TypeOf((*MockBoardService)(nil).AddTaskMember), ctx, projectID, boardID, taskID, userID)
+}
+
+// CreateBoard mocks base method.
+func (m *MockBoardService) CreateBoard(ctx context.Context, board *domain.Board) (*domain.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBoard", ctx, board)
+	ret0, _ := ret[0].(*domain.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBoard indicates an expected call of CreateBoard.
+func (mr *MockBoardServiceMockRecorder) CreateBoard(ctx, board any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoard", reflect.TypeOf((*MockBoardService)(nil).CreateBoard), ctx, board)
+}
+
+// CreateColumn mocks base method.
+func (m *MockBoardService) CreateColumn(ctx context.Context, projectID int32, column *domain.BoardColumn) (*domain.BoardColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateColumn", ctx, projectID, column)
+	ret0, _ := ret[0].(*domain.BoardColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateColumn indicates an expected call of CreateColumn.
+func (mr *MockBoardServiceMockRecorder) CreateColumn(ctx, projectID, column any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateColumn", reflect.TypeOf((*MockBoardService)(nil).CreateColumn), ctx, projectID, column)
+}
+
+// CreateTask mocks base method.
+func (m *MockBoardService) CreateTask(ctx context.Context, projectID int32, task *domain.BoardTask) (*domain.BoardTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", ctx, projectID, task)
+	ret0, _ := ret[0].(*domain.BoardTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockBoardServiceMockRecorder) CreateTask(ctx, projectID, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockBoardService)(nil).CreateTask), ctx, projectID, task)
+}
+
+// DeleteBoard mocks base method.
+func (m *MockBoardService) DeleteBoard(ctx context.Context, projectID, boardID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBoard", ctx, projectID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBoard indicates an expected call of DeleteBoard.
+func (mr *MockBoardServiceMockRecorder) DeleteBoard(ctx, projectID, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoard", reflect.TypeOf((*MockBoardService)(nil).DeleteBoard), ctx, projectID, boardID)
+}
+
+// DeleteColumn mocks base method.
+func (m *MockBoardService) DeleteColumn(ctx context.Context, projectID, boardID, columnID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteColumn", ctx, projectID, boardID, columnID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteColumn indicates an expected call of DeleteColumn.
+func (mr *MockBoardServiceMockRecorder) DeleteColumn(ctx, projectID, boardID, columnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteColumn", reflect.TypeOf((*MockBoardService)(nil).DeleteColumn), ctx, projectID, boardID, columnID)
+}
+
+// DeleteTask mocks base method.
+func (m *MockBoardService) DeleteTask(ctx context.Context, projectID, boardID, taskID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTask", ctx, projectID, boardID, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTask indicates an expected call of DeleteTask.
+func (mr *MockBoardServiceMockRecorder) DeleteTask(ctx, projectID, boardID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockBoardService)(nil).DeleteTask), ctx, projectID, boardID, taskID)
+}
+
+// GetBoard mocks base method.
+func (m *MockBoardService) GetBoard(ctx context.Context, projectID, boardID int32) (*domain.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoard", ctx, projectID, boardID)
+	ret0, _ := ret[0].(*domain.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoard indicates an expected call of GetBoard.
+func (mr *MockBoardServiceMockRecorder) GetBoard(ctx, projectID, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockBoardService)(nil).GetBoard), ctx, projectID, boardID)
+}
+
+// GetBoards mocks base method.
+func (m *MockBoardService) GetBoards(ctx context.Context, projectID int32) ([]domain.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoards", ctx, projectID)
+	ret0, _ := ret[0].([]domain.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoards indicates an expected call of GetBoards.
+func (mr *MockBoardServiceMockRecorder) GetBoards(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoards", reflect.TypeOf((*MockBoardService)(nil).GetBoards), ctx, projectID)
+}
+
+// GetColumns mocks base method.
+func (m *MockBoardService) GetColumns(ctx context.Context, projectID, boardID int32) ([]domain.BoardColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumns", ctx, projectID, boardID)
+	ret0, _ := ret[0].([]domain.BoardColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumns indicates an expected call of GetColumns.
+func (mr *MockBoardServiceMockRecorder) GetColumns(ctx, projectID, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumns", reflect.TypeOf((*MockBoardService)(nil).GetColumns), ctx, projectID, boardID)
+}
+
+// GetTask mocks base method.
+func (m *MockBoardService) GetTask(ctx context.Context, projectID, boardID, taskID int32) (*domain.BoardTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTask", ctx, projectID, boardID, taskID)
+	ret0, _ := ret[0].(*domain.BoardTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTask indicates an expected call of GetTask.
+func (mr *MockBoardServiceMockRecorder) GetTask(ctx, projectID, boardID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockBoardService)(nil).GetTask), ctx, projectID, boardID, taskID)
+}
+
+// GetTaskMembers mocks base method.
+func (m *MockBoardService) GetTaskMembers(ctx context.Context, projectID, boardID, taskID int32) ([]domain.BoardTaskMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskMembers", ctx, projectID, boardID, taskID)
+	ret0, _ := ret[0].([]domain.BoardTaskMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskMembers indicates an expected call of GetTaskMembers.
+func (mr *MockBoardServiceMockRecorder) GetTaskMembers(ctx, projectID, boardID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskMembers", reflect.TypeOf((*MockBoardService)(nil).GetTaskMembers), ctx, projectID, boardID, taskID)
+}
+
+// GetTasks mocks base method.
+func (m *MockBoardService) GetTasks(ctx context.Context, projectID, boardID int32) ([]domain.BoardTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasks", ctx, projectID, boardID)
+	ret0, _ := ret[0].([]domain.BoardTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasks indicates an expected call of GetTasks.
+func (mr *MockBoardServiceMockRecorder) GetTasks(ctx, projectID, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasks", reflect.TypeOf((*MockBoardService)(nil).GetTasks), ctx, projectID, boardID)
+}
+
+// RemoveTaskMember mocks base method.
+func (m *MockBoardService) RemoveTaskMember(ctx context.Context, projectID, boardID, taskID, userID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTaskMember", ctx, projectID, boardID, taskID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTaskMember indicates an expected call of RemoveTaskMember.
+func (mr *MockBoardServiceMockRecorder) RemoveTaskMember(ctx, projectID, boardID, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTaskMember", reflect.TypeOf((*MockBoardService)(nil).RemoveTaskMember), ctx, projectID, boardID, taskID, userID)
+}
+
+// UpdateBoard mocks base method.
+func (m *MockBoardService) UpdateBoard(ctx context.Context, board *domain.Board) (*domain.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoard", ctx, board)
+	ret0, _ := ret[0].(*domain.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBoard indicates an expected call of UpdateBoard.
+func (mr *MockBoardServiceMockRecorder) UpdateBoard(ctx, board any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoard", reflect.TypeOf((*MockBoardService)(nil).UpdateBoard), ctx, board)
+}
+
+// UpdateColumn mocks base method.
+func (m *MockBoardService) UpdateColumn(ctx context.Context, projectID int32, column *domain.BoardColumn) (*domain.BoardColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateColumn", ctx, projectID, column)
+	ret0, _ := ret[0].(*domain.BoardColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateColumn indicates an expected call of UpdateColumn.
+func (mr *MockBoardServiceMockRecorder) UpdateColumn(ctx, projectID, column any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateColumn", reflect.TypeOf((*MockBoardService)(nil).UpdateColumn), ctx, projectID, column)
+}
+
+// UpdateTask mocks base method.
+func (m *MockBoardService) UpdateTask(ctx context.Context, projectID int32, task *domain.BoardTask) (*domain.BoardTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTask", ctx, projectID, task)
+	ret0, _ := ret[0].(*domain.BoardTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTask indicates an expected call of UpdateTask.
+func (mr *MockBoardServiceMockRecorder) UpdateTask(ctx, projectID, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockBoardService)(nil).UpdateTask), ctx, projectID, task)
+}
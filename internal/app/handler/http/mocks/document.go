@@ -10,6 +10,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 	domain "web-studio-backend/internal/app/domain"
 
@@ -40,18 +41,33 @@ func (m *MockDocumentService) EXPECT() *MockDocumentServiceMockRecorder {
 }
 
 // AddDocumentToProject mocks base method.
-func (m *MockDocumentService) AddDocumentToProject(ctx context.Context, doc *domain.Document, projectID int32) (*domain.Document, error) {
+func (m *MockDocumentService) AddDocumentToProject(ctx context.Context, doc *domain.Document, content io.Reader, projectID int32) (*domain.Document, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddDocumentToProject", ctx, doc, projectID)
+	ret := m.ctrl.Call(m, "AddDocumentToProject", ctx, doc, content, projectID)
 	ret0, _ := ret[0].(*domain.Document)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddDocumentToProject indicates an expected call of AddDocumentToProject.
-func (mr *MockDocumentServiceMockRecorder) AddDocumentToProject(ctx, doc, projectID any) *gomock.Call {
+func (mr *MockDocumentServiceMockRecorder) AddDocumentToProject(ctx, doc, content, projectID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDocumentToProject", reflect.TypeOf((*MockDocumentService)(nil).AddDocumentToProject), ctx, doc, projectID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDocumentToProject", reflect.TypeOf((*MockDocumentService)(nil).AddDocumentToProject), ctx, doc, content, projectID)
+}
+
+// AddDocumentVersion mocks base method.
+func (m *MockDocumentService) AddDocumentVersion(ctx context.Context, v *domain.DocumentVersion, content io.Reader, projectID int32) (*domain.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDocumentVersion", ctx, v, content, projectID)
+	ret0, _ := ret[0].(*domain.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDocumentVersion indicates an expected call of AddDocumentVersion.
+func (mr *MockDocumentServiceMockRecorder) AddDocumentVersion(ctx, v, content, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDocumentVersion", reflect.TypeOf((*MockDocumentService)(nil).AddDocumentVersion), ctx, v, content, projectID)
 }
 
 // DeleteDocumentFromProject mocks base method.
@@ -68,32 +84,112 @@ func (mr *MockDocumentServiceMockRecorder) DeleteDocumentFromProject(ctx, docID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDocumentFromProject", reflect.TypeOf((*MockDocumentService)(nil).DeleteDocumentFromProject), ctx, docID, projectID)
 }
 
-// GetDocument mocks base method.
-func (m *MockDocumentService) GetDocument(ctx context.Context, id int32) (*domain.Document, error) {
+// GetDeletedProjectDocuments mocks base method.
+func (m *MockDocumentService) GetDeletedProjectDocuments(ctx context.Context, projectID int32, params *domain.ListParams) ([]domain.Document, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedProjectDocuments", ctx, projectID, params)
+	ret0, _ := ret[0].([]domain.Document)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedProjectDocuments indicates an expected call of GetDeletedProjectDocuments.
+func (mr *MockDocumentServiceMockRecorder) GetDeletedProjectDocuments(ctx, projectID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedProjectDocuments", reflect.TypeOf((*MockDocumentService)(nil).GetDeletedProjectDocuments), ctx, projectID, params)
+}
+
+// GetDocumentVersions mocks base method.
+func (m *MockDocumentService) GetDocumentVersions(ctx context.Context, docID int32, params *domain.ListParams) ([]domain.DocumentVersion, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentVersions", ctx, docID, params)
+	ret0, _ := ret[0].([]domain.DocumentVersion)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDocumentVersions indicates an expected call of GetDocumentVersions.
+func (mr *MockDocumentServiceMockRecorder) GetDocumentVersions(ctx, docID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentVersions", reflect.TypeOf((*MockDocumentService)(nil).GetDocumentVersions), ctx, docID, params)
+}
+
+// GetProjectDocuments mocks base method.
+func (m *MockDocumentService) GetProjectDocuments(ctx context.Context, id int32, params *domain.ListParams) ([]domain.Document, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectDocuments", ctx, id, params)
+	ret0, _ := ret[0].([]domain.Document)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectDocuments indicates an expected call of GetProjectDocuments.
+func (mr *MockDocumentServiceMockRecorder) GetProjectDocuments(ctx, id, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDocuments", reflect.TypeOf((*MockDocumentService)(nil).GetProjectDocuments), ctx, id, params)
+}
+
+// OpenDocument mocks base method.
+func (m *MockDocumentService) OpenDocument(ctx context.Context, id int32) (*domain.Document, *domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDocument", ctx, id)
+	ret0, _ := ret[0].(*domain.Document)
+	ret1, _ := ret[1].(*domain.File)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenDocument indicates an expected call of OpenDocument.
+func (mr *MockDocumentServiceMockRecorder) OpenDocument(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDocument", reflect.TypeOf((*MockDocumentService)(nil).OpenDocument), ctx, id)
+}
+
+// OpenDocumentVersion mocks base method.
+func (m *MockDocumentService) OpenDocumentVersion(ctx context.Context, docID, version int32) (*domain.DocumentVersion, *domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDocumentVersion", ctx, docID, version)
+	ret0, _ := ret[0].(*domain.DocumentVersion)
+	ret1, _ := ret[1].(*domain.File)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenDocumentVersion indicates an expected call of OpenDocumentVersion.
+func (mr *MockDocumentServiceMockRecorder) OpenDocumentVersion(ctx, docID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDocumentVersion", reflect.TypeOf((*MockDocumentService)(nil).OpenDocumentVersion), ctx, docID, version)
+}
+
+// RestoreDocument mocks base method.
+func (m *MockDocumentService) RestoreDocument(ctx context.Context, docID, projectID int32) (*domain.Document, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDocument", ctx, id)
+	ret := m.ctrl.Call(m, "RestoreDocument", ctx, docID, projectID)
 	ret0, _ := ret[0].(*domain.Document)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDocument indicates an expected call of GetDocument.
-func (mr *MockDocumentServiceMockRecorder) GetDocument(ctx, id any) *gomock.Call {
+// RestoreDocument indicates an expected call of RestoreDocument.
+func (mr *MockDocumentServiceMockRecorder) RestoreDocument(ctx, docID, projectID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocument", reflect.TypeOf((*MockDocumentService)(nil).GetDocument), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDocument", reflect.TypeOf((*MockDocumentService)(nil).RestoreDocument), ctx, docID, projectID)
 }
 
-// GetProjectDocuments mocks base method.
-func (m *MockDocumentService) GetProjectDocuments(ctx context.Context, id int32) ([]domain.Document, error) {
+// RestoreDocumentVersion mocks base method.
+func (m *MockDocumentService) RestoreDocumentVersion(ctx context.Context, docID, version, projectID int32) (*domain.Document, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProjectDocuments", ctx, id)
-	ret0, _ := ret[0].([]domain.Document)
+	ret := m.ctrl.Call(m, "RestoreDocumentVersion", ctx, docID, version, projectID)
+	ret0, _ := ret[0].(*domain.Document)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProjectDocuments indicates an expected call of GetProjectDocuments.
-func (mr *MockDocumentServiceMockRecorder) GetProjectDocuments(ctx, id any) *gomock.Call {
+// RestoreDocumentVersion indicates an expected call of RestoreDocumentVersion.
+func (mr *MockDocumentServiceMockRecorder) RestoreDocumentVersion(ctx, docID, version, projectID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDocuments", reflect.TypeOf((*MockDocumentService)(nil).GetProjectDocuments), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDocumentVersion", reflect.TypeOf((*MockDocumentService)(nil).RestoreDocumentVersion), ctx, docID, version, projectID)
 }
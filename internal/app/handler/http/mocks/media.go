@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: media.go
+//
+// Generated by this command:
+//
+//	mockgen -source=media.go -destination=./mocks/media.go -package=mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "web-studio-backend/internal/app/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMediaService is a mock of MediaService interface.
+type MockMediaService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaServiceMockRecorder
+}
+
+// MockMediaServiceMockRecorder is the mock recorder for MockMediaService.
+type MockMediaServiceMockRecorder struct {
+	mock *MockMediaService
+}
+
+// NewMockMediaService creates a new mock instance.
+func NewMockMediaService(ctrl *gomock.Controller) *MockMediaService {
+	mock := &MockMediaService{ctrl: ctrl}
+	mock.recorder = &MockMediaServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaService) EXPECT() *MockMediaServiceMockRecorder {
+	return m.recorder
+}
+
+// AddMedia mocks base method.
+func (m *MockMediaService) AddMedia(ctx context.Context, media *domain.ProjectMedia, img []byte) (*domain.ProjectMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMedia", ctx, media, img)
+	ret0, _ := ret[0].(*domain.ProjectMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMedia indicates an expected call of AddMedia.
+func (mr *MockMediaServiceMockRecorder) AddMedia(ctx, media, img any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMedia", reflect.TypeOf((*MockMediaService)(nil).AddMedia), ctx, media, img)
+}
+
+// DeleteMedia mocks base method.
+func (m *MockMediaService) DeleteMedia(ctx context.Context, mediaID, projectID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMedia", ctx, mediaID, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMedia indicates an expected call of DeleteMedia.
+func (mr *MockMediaServiceMockRecorder) DeleteMedia(ctx, mediaID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMedia", reflect.TypeOf((*MockMediaService)(nil).DeleteMedia), ctx, mediaID, projectID)
+}
+
+// GetMedia mocks base method.
+func (m *MockMediaService) GetMedia(ctx context.Context, mediaID, projectID int32) (*domain.ProjectMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMedia", ctx, mediaID, projectID)
+	ret0, _ := ret[0].(*domain.ProjectMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMedia indicates an expected call of GetMedia.
+func (mr *MockMediaServiceMockRecorder) GetMedia(ctx, mediaID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedia", reflect.TypeOf((*MockMediaService)(nil).GetMedia), ctx, mediaID, projectID)
+}
+
+// GetMediaImage mocks base method.
+func (m *MockMediaService) GetMediaImage(ctx context.Context, mediaID, projectID int32, size int) (*domain.ProjectMedia, *domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMediaImage", ctx, mediaID, projectID, size)
+	ret0, _ := ret[0].(*domain.ProjectMedia)
+	ret1, _ := ret[1].(*domain.File)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMediaImage indicates an expected call of GetMediaImage.
+func (mr *MockMediaServiceMockRecorder) GetMediaImage(ctx, mediaID, projectID, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMediaImage", reflect.TypeOf((*MockMediaService)(nil).GetMediaImage), ctx, mediaID, projectID, size)
+}
+
+// GetProjectMedia mocks base method.
+func (m *MockMediaService) GetProjectMedia(ctx context.Context, projectID int32) ([]domain.ProjectMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectMedia", ctx, projectID)
+	ret0, _ := ret[0].([]domain.ProjectMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectMedia indicates an expected call of GetProjectMedia.
+func (mr *MockMediaServiceMockRecorder) GetProjectMedia(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectMedia", reflect.TypeOf((*MockMediaService)(nil).GetProjectMedia), ctx, projectID)
+}
+
+// ReorderMedia mocks base method.
+func (m *MockMediaService) ReorderMedia(ctx context.Context, projectID int32, mediaIDs []int32) ([]domain.ProjectMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderMedia", ctx, projectID, mediaIDs)
+	ret0, _ := ret[0].([]domain.ProjectMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderMedia indicates an expected call of ReorderMedia.
+func (mr *MockMediaServiceMockRecorder) ReorderMedia(ctx, projectID, mediaIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderMedia", reflect.TypeOf((*MockMediaService)(nil).ReorderMedia), ctx, projectID, mediaIDs)
+}
+
+// UpdateMedia mocks base method.
+func (m *MockMediaService) UpdateMedia(ctx context.Context, media *domain.ProjectMedia) (*domain.ProjectMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMedia", ctx, media)
+	ret0, _ := ret[0].(*domain.ProjectMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMedia indicates an expected call of UpdateMedia.
+func (mr *MockMediaServiceMockRecorder) UpdateMedia(ctx, media any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMedia", reflect.TypeOf((*MockMediaService)(nil).UpdateMedia), ctx, media)
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oidc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=oidc.go -destination=./mocks/oidc.go -package=mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "web-studio-backend/internal/app/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOIDCService is a mock of OIDCService interface.
+type MockOIDCService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCServiceMockRecorder
+}
+
+// MockOIDCServiceMockRecorder is the mock recorder for MockOIDCService.
+type MockOIDCServiceMockRecorder struct {
+	mock *MockOIDCService
+}
+
+// NewMockOIDCService creates a new mock instance.
+func NewMockOIDCService(ctrl *gomock.Controller) *MockOIDCService {
+	mock := &MockOIDCService{ctrl: ctrl}
+	mock.recorder = &MockOIDCServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCService) EXPECT() *MockOIDCServiceMockRecorder {
+	return m.recorder
+}
+
+// FinishSignIn mocks base method.
+func (m *MockOIDCService) FinishSignIn(ctx context.Context, provider string, req *domain.OIDCCallbackRequest, boundState string) (*domain.SignInResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishSignIn", ctx, provider, req, boundState)
+	ret0, _ := ret[0].(*domain.SignInResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishSignIn indicates an expected call of FinishSignIn.
+func (mr *MockOIDCServiceMockRecorder) FinishSignIn(ctx, provider, req, boundState any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishSignIn", reflect.TypeOf((*MockOIDCService)(nil).FinishSignIn), ctx, provider, req, boundState)
+}
+
+// GetProviders mocks base method.
+func (m *MockOIDCService) GetProviders(ctx context.Context) []domain.OIDCProvider {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProviders", ctx)
+	ret0, _ := ret[0].([]domain.OIDCProvider)
+	return ret0
+}
+
+// GetProviders indicates an expected call of GetProviders.
+func (mr *MockOIDCServiceMockRecorder) GetProviders(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProviders", reflect.TypeOf((*MockOIDCService)(nil).GetProviders), ctx)
+}
+
+// StartSignIn mocks base method.
+func (m *MockOIDCService) StartSignIn(ctx context.Context, provider string) (*domain.OIDCAuthURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSignIn", ctx, provider)
+	ret0, _ := ret[0].(*domain.OIDCAuthURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSignIn indicates an expected call of StartSignIn.
+func (mr *MockOIDCServiceMockRecorder) StartSignIn(ctx, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSignIn", reflect.TypeOf((*MockOIDCService)(nil).StartSignIn), ctx, provider)
+}
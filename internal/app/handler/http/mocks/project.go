@@ -69,6 +69,50 @@ func (mr *MockProjectServiceMockRecorder) CreateProject(ctx, project any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectService)(nil).CreateProject), ctx, project)
 }
 
+// DeleteProject mocks base method.
+func (m *MockProjectService) DeleteProject(ctx context.Context, projectID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockProjectServiceMockRecorder) DeleteProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectService)(nil).DeleteProject), ctx, projectID)
+}
+
+// DeleteProjectTranslation mocks base method.
+func (m *MockProjectService) DeleteProjectTranslation(ctx context.Context, projectID int32, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectTranslation", ctx, projectID, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectTranslation indicates an expected call of DeleteProjectTranslation.
+func (mr *MockProjectServiceMockRecorder) DeleteProjectTranslation(ctx, projectID, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectTranslation", reflect.TypeOf((*MockProjectService)(nil).DeleteProjectTranslation), ctx, projectID, locale)
+}
+
+// GetDeletedProjects mocks base method.
+func (m *MockProjectService) GetDeletedProjects(ctx context.Context, params *domain.ListParams) ([]domain.Project, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedProjects", ctx, params)
+	ret0, _ := ret[0].([]domain.Project)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedProjects indicates an expected call of GetDeletedProjects.
+func (mr *MockProjectServiceMockRecorder) GetDeletedProjects(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedProjects", reflect.TypeOf((*MockProjectService)(nil).GetDeletedProjects), ctx, params)
+}
+
 // GetParticipant mocks base method.
 func (m *MockProjectService) GetParticipant(ctx context.Context, participantID, projectID int32) (*domain.ProjectParticipant, error) {
 	m.ctrl.T.Helper()
@@ -85,18 +129,19 @@ func (mr *MockProjectServiceMockRecorder) GetParticipant(ctx, participantID, pro
 }
 
 // GetParticipants mocks base method.
-func (m *MockProjectService) GetParticipants(ctx context.Context, projectID int32) ([]domain.ProjectParticipant, error) {
+func (m *MockProjectService) GetParticipants(ctx context.Context, projectID int32, params *domain.ListParams, filter *domain.ParticipantFilter) ([]domain.ProjectParticipant, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetParticipants", ctx, projectID)
+	ret := m.ctrl.Call(m, "GetParticipants", ctx, projectID, params, filter)
 	ret0, _ := ret[0].([]domain.ProjectParticipant)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetParticipants indicates an expected call of GetParticipants.
-func (mr *MockProjectServiceMockRecorder) GetParticipants(ctx, projectID any) *gomock.Call {
+func (mr *MockProjectServiceMockRecorder) GetParticipants(ctx, projectID, params, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipants", reflect.TypeOf((*MockProjectService)(nil).GetParticipants), ctx, projectID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipants", reflect.TypeOf((*MockProjectService)(nil).GetParticipants), ctx, projectID, params, filter)
 }
 
 // GetProject mocks base method.
@@ -114,19 +159,66 @@ func (mr *MockProjectServiceMockRecorder) GetProject(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockProjectService)(nil).GetProject), ctx, id)
 }
 
-// GetProjects mocks base method.
-func (m *MockProjectService) GetProjects(ctx context.Context) ([]domain.Project, error) {
+// GetProjectBySlug mocks base method.
+func (m *MockProjectService) GetProjectBySlug(ctx context.Context, slug string) (*domain.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProjects", ctx)
-	ret0, _ := ret[0].([]domain.Project)
+	ret := m.ctrl.Call(m, "GetProjectBySlug", ctx, slug)
+	ret0, _ := ret[0].(*domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectBySlug indicates an expected call of GetProjectBySlug.
+func (mr *MockProjectServiceMockRecorder) GetProjectBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectBySlug", reflect.TypeOf((*MockProjectService)(nil).GetProjectBySlug), ctx, slug)
+}
+
+// GetProjectImage mocks base method.
+func (m *MockProjectService) GetProjectImage(ctx context.Context, projectID int32, size int) (*domain.Project, *domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectImage", ctx, projectID, size)
+	ret0, _ := ret[0].(*domain.Project)
+	ret1, _ := ret[1].(*domain.File)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectImage indicates an expected call of GetProjectImage.
+func (mr *MockProjectServiceMockRecorder) GetProjectImage(ctx, projectID, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectImage", reflect.TypeOf((*MockProjectService)(nil).GetProjectImage), ctx, projectID, size)
+}
+
+// GetProjectTranslations mocks base method.
+func (m *MockProjectService) GetProjectTranslations(ctx context.Context, projectID int32) ([]domain.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectTranslations", ctx, projectID)
+	ret0, _ := ret[0].([]domain.Translation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetProjectTranslations indicates an expected call of GetProjectTranslations.
+func (mr *MockProjectServiceMockRecorder) GetProjectTranslations(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectTranslations", reflect.TypeOf((*MockProjectService)(nil).GetProjectTranslations), ctx, projectID)
+}
+
+// GetProjects mocks base method.
+func (m *MockProjectService) GetProjects(ctx context.Context, params *domain.ListParams, filter *domain.ProjectFilter) ([]domain.Project, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjects", ctx, params, filter)
+	ret0, _ := ret[0].([]domain.Project)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // GetProjects indicates an expected call of GetProjects.
-func (mr *MockProjectServiceMockRecorder) GetProjects(ctx any) *gomock.Call {
+func (mr *MockProjectServiceMockRecorder) GetProjects(ctx, params, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjects", reflect.TypeOf((*MockProjectService)(nil).GetProjects), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjects", reflect.TypeOf((*MockProjectService)(nil).GetProjects), ctx, params, filter)
 }
 
 // RemoveParticipant mocks base method.
@@ -143,6 +235,50 @@ func (mr *MockProjectServiceMockRecorder) RemoveParticipant(ctx, participantID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveParticipant", reflect.TypeOf((*MockProjectService)(nil).RemoveParticipant), ctx, participantID, projectID)
 }
 
+// RestoreProject mocks base method.
+func (m *MockProjectService) RestoreProject(ctx context.Context, projectID int32) (*domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProject", ctx, projectID)
+	ret0, _ := ret[0].(*domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProject indicates an expected call of RestoreProject.
+func (mr *MockProjectServiceMockRecorder) RestoreProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProject", reflect.TypeOf((*MockProjectService)(nil).RestoreProject), ctx, projectID)
+}
+
+// SetProjectImage mocks base method.
+func (m *MockProjectService) SetProjectImage(ctx context.Context, projectID int32, img []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectImage", ctx, projectID, img)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProjectImage indicates an expected call of SetProjectImage.
+func (mr *MockProjectServiceMockRecorder) SetProjectImage(ctx, projectID, img any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectImage", reflect.TypeOf((*MockProjectService)(nil).SetProjectImage), ctx, projectID, img)
+}
+
+// SetProjectTranslation mocks base method.
+func (m *MockProjectService) SetProjectTranslation(ctx context.Context, projectID int32, translation *domain.Translation) (*domain.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectTranslation", ctx, projectID, translation)
+	ret0, _ := ret[0].(*domain.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProjectTranslation indicates an expected call of SetProjectTranslation.
+func (mr *MockProjectServiceMockRecorder) SetProjectTranslation(ctx, projectID, translation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectTranslation", reflect.TypeOf((*MockProjectService)(nil).SetProjectTranslation), ctx, projectID, translation)
+}
+
 // UpdateParticipant mocks base method.
 func (m *MockProjectService) UpdateParticipant(ctx context.Context, participant *domain.ProjectParticipant) (*domain.ProjectParticipant, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,225 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: public.go
+//
+// Generated by this command:
+//
+//	mockgen -source=public.go -destination=./mocks/public.go -package=mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "web-studio-backend/internal/app/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPublicService is a mock of PublicService interface.
+type MockPublicService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublicServiceMockRecorder
+}
+
+// MockPublicServiceMockRecorder is the mock recorder for MockPublicService.
+type MockPublicServiceMockRecorder struct {
+	mock *MockPublicService
+}
+
+// NewMockPublicService creates a new mock instance.
+func NewMockPublicService(ctrl *gomock.Controller) *MockPublicService {
+	mock := &MockPublicService{ctrl: ctrl}
+	mock.recorder = &MockPublicServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublicService) EXPECT() *MockPublicServiceMockRecorder {
+	return m.recorder
+}
+
+// GetCategories mocks base method.
+func (m *MockPublicService) GetCategories(ctx context.Context) ([]domain.ProjectCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategories", ctx)
+	ret0, _ := ret[0].([]domain.ProjectCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategories indicates an expected call of GetCategories.
+func (mr *MockPublicServiceMockRecorder) GetCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockPublicService)(nil).GetCategories), ctx)
+}
+
+// GetProject mocks base method.
+func (m *MockPublicService) GetProject(ctx context.Context, slug string) (*domain.PublicProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", ctx, slug)
+	ret0, _ := ret[0].(*domain.PublicProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockPublicServiceMockRecorder) GetProject(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockPublicService)(nil).GetProject), ctx, slug)
+}
+
+// GetProjectImage mocks base method.
+func (m *MockPublicService) GetProjectImage(ctx context.Context, slug string, size int) (*domain.Project, *domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectImage", ctx, slug, size)
+	ret0, _ := ret[0].(*domain.Project)
+	ret1, _ := ret[1].(*domain.File)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectImage indicates an expected call of GetProjectImage.
+func (mr *MockPublicServiceMockRecorder) GetProjectImage(ctx, slug, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectImage", reflect.TypeOf((*MockPublicService)(nil).GetProjectImage), ctx, slug, size)
+}
+
+// GetProjectMedia mocks base method.
+func (m *MockPublicService) GetProjectMedia(ctx context.Context, slug string) ([]domain.PublicMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectMedia", ctx, slug)
+	ret0, _ := ret[0].([]domain.PublicMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectMedia indicates an expected call of GetProjectMedia.
+func (mr *MockPublicServiceMockRecorder) GetProjectMedia(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectMedia", reflect.TypeOf((*MockPublicService)(nil).GetProjectMedia), ctx, slug)
+}
+
+// GetProjectMediaImage mocks base method.
+func (m *MockPublicService) GetProjectMediaImage(ctx context.Context, slug string, mediaID int32, size int) (*domain.ProjectMedia, *domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectMediaImage", ctx, slug, mediaID, size)
+	ret0, _ := ret[0].(*domain.ProjectMedia)
+	ret1, _ := ret[1].(*domain.File)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectMediaImage indicates an expected call of GetProjectMediaImage.
+func (mr *MockPublicServiceMockRecorder) GetProjectMediaImage(ctx, slug, mediaID, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectMediaImage", reflect.TypeOf((*MockPublicService)(nil).GetProjectMediaImage), ctx, slug, mediaID, size)
+}
+
+// GetProjectParticipants mocks base method.
+func (m *MockPublicService) GetProjectParticipants(ctx context.Context, slug string) ([]domain.PublicMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectParticipants", ctx, slug)
+	ret0, _ := ret[0].([]domain.PublicMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectParticipants indicates an expected call of GetProjectParticipants.
+func (mr *MockPublicServiceMockRecorder) GetProjectParticipants(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectParticipants", reflect.TypeOf((*MockPublicService)(nil).GetProjectParticipants), ctx, slug)
+}
+
+// GetProjects mocks base method.
+func (m *MockPublicService) GetProjects(ctx context.Context, params *domain.ListParams, filter *domain.PublicProjectFilter) ([]domain.PublicProject, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjects", ctx, params, filter)
+	ret0, _ := ret[0].([]domain.PublicProject)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjects indicates an expected call of GetProjects.
+func (mr *MockPublicServiceMockRecorder) GetProjects(ctx, params, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjects", reflect.TypeOf((*MockPublicService)(nil).GetProjects), ctx, params, filter)
+}
+
+// GetTeam mocks base method.
+func (m *MockPublicService) GetTeam(ctx context.Context, slug string) (*domain.PublicTeam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeam", ctx, slug)
+	ret0, _ := ret[0].(*domain.PublicTeam)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeam indicates an expected call of GetTeam.
+func (mr *MockPublicServiceMockRecorder) GetTeam(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockPublicService)(nil).GetTeam), ctx, slug)
+}
+
+// GetTeamImage mocks base method.
+func (m *MockPublicService) GetTeamImage(ctx context.Context, slug string, size int) (*domain.Team, *domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamImage", ctx, slug, size)
+	ret0, _ := ret[0].(*domain.Team)
+	ret1, _ := ret[1].(*domain.File)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTeamImage indicates an expected call of GetTeamImage.
+func (mr *MockPublicServiceMockRecorder) GetTeamImage(ctx, slug, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamImage", reflect.TypeOf((*MockPublicService)(nil).GetTeamImage), ctx, slug, size)
+}
+
+// GetTeamMembers mocks base method.
+func (m *MockPublicService) GetTeamMembers(ctx context.Context, slug string) ([]domain.PublicMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamMembers", ctx, slug)
+	ret0, _ := ret[0].([]domain.PublicMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamMembers indicates an expected call of GetTeamMembers.
+func (mr *MockPublicServiceMockRecorder) GetTeamMembers(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamMembers", reflect.TypeOf((*MockPublicService)(nil).GetTeamMembers), ctx, slug)
+}
+
+// GetTeams mocks base method.
+func (m *MockPublicService) GetTeams(ctx context.Context, params *domain.ListParams) ([]domain.PublicTeam, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeams", ctx, params)
+	ret0, _ := ret[0].([]domain.PublicTeam)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTeams indicates an expected call of GetTeams.
+func (mr *MockPublicServiceMockRecorder) GetTeams(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeams", reflect.TypeOf((*MockPublicService)(nil).GetTeams), ctx, params)
+}
+
+// GetTechnologies mocks base method.
+func (m *MockPublicService) GetTechnologies(ctx context.Context) ([]domain.TechnologyUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTechnologies", ctx)
+	ret0, _ := ret[0].([]domain.TechnologyUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTechnologies indicates an expected call of GetTechnologies.
+func (mr *MockPublicServiceMockRecorder) GetTechnologies(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTechnologies", reflect.TypeOf((*MockPublicService)(nil).GetTechnologies), ctx)
+}
@@ -70,33 +70,35 @@ func (mr *MockUserServiceMockRecorder) GetUser(ctx, id any) *gomock.Call {
 }
 
 // GetUserImage mocks base method.
-func (m *MockUserService) GetUserImage(ctx context.Context, userID int32) (*domain.User, error) {
+func (m *MockUserService) GetUserImage(ctx context.Context, userID int32, size int) (*domain.User, *domain.File, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserImage", ctx, userID)
+	ret := m.ctrl.Call(m, "GetUserImage", ctx, userID, size)
 	ret0, _ := ret[0].(*domain.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*domain.File)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetUserImage indicates an expected call of GetUserImage.
-func (mr *MockUserServiceMockRecorder) GetUserImage(ctx, userID any) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) GetUserImage(ctx, userID, size any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserImage", reflect.TypeOf((*MockUserService)(nil).GetUserImage), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserImage", reflect.TypeOf((*MockUserService)(nil).GetUserImage), ctx, userID, size)
 }
 
 // GetUsers mocks base method.
-func (m *MockUserService) GetUsers(ctx context.Context) ([]domain.User, error) {
+func (m *MockUserService) GetUsers(ctx context.Context, params *domain.ListParams, filter *domain.UserFilter) ([]domain.User, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsers", ctx)
+	ret := m.ctrl.Call(m, "GetUsers", ctx, params, filter)
 	ret0, _ := ret[0].([]domain.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetUsers indicates an expected call of GetUsers.
-func (mr *MockUserServiceMockRecorder) GetUsers(ctx any) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) GetUsers(ctx, params, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockUserService)(nil).GetUsers), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockUserService)(nil).GetUsers), ctx, params, filter)
 }
 
 // RemoveUser mocks base method.
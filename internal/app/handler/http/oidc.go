@@ -0,0 +1,128 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+)
+
+//go:generate mockgen -source=oidc.go -destination=./mocks/oidc.go -package=mocks
+type OIDCService interface {
+	GetProviders(ctx context.Context) []domain.OIDCProvider
+	StartSignIn(ctx context.Context, provider string) (*domain.OIDCAuthURL, error)
+	FinishSignIn(ctx context.Context, provider string, req *domain.OIDCCallbackRequest, boundState string) (*domain.SignInResponse, error)
+}
+
+const (
+	oidcStateCookie     = "oidc_state"
+	oidcStateCookiePath = "/api/v1/auth/oidc"
+	oidcStateCookieTTL  = 10 * time.Minute
+)
+
+type oidcHandler struct {
+	oidcService OIDCService
+}
+
+func newOIDCHandler(srv OIDCService) *oidcHandler {
+	return &oidcHandler{srv}
+}
+
+// getOIDCProviders godoc
+// @Summary      Get SSO providers
+// @Description  Returns identity providers users can sign in with.
+// @Tags         Auth
+// @Produce      json
+// @Success      200  {array}   domain.OIDCProvider
+// @Router       /api/v1/auth/oidc/providers [get]
+func (h *oidcHandler) getOIDCProviders(w http.ResponseWriter, r *http.Request) {
+	httphelp.SendJSON(http.StatusOK, h.oidcService.GetProviders(r.Context()), w)
+}
+
+// startOIDCSignIn godoc
+// @Summary      Start SSO sign in
+// @Description  Starts sign in with identity provider. Returns URL of the provider login page the user must be redirected to
+// @Description  and sets HTTP cookie which binds the sign in to the browser.
+// @Description
+// @Description  After login the provider redirects the user to the frontend with `code` and `state` query parameters,
+// @Description  which must be sent to the callback endpoint.
+// @Tags         Auth
+// @Produce      json
+// @Param        provider path string true "Provider name."
+// @Success      200  {object}  domain.OIDCAuthURL
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/oidc/{provider}/start [post]
+func (h *oidcHandler) startOIDCSignIn(w http.ResponseWriter, r *http.Request) {
+	response, err := h.oidcService.StartSignIn(r.Context(), httphelp.ParseParamString("provider", r))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    response.State,
+		SameSite: http.SameSiteNoneMode,
+		Path:     oidcStateCookiePath,
+		HttpOnly: true,
+		Secure:   true,
+		Expires:  time.Now().Add(oidcStateCookieTTL),
+	})
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// finishOIDCSignIn godoc
+// @Summary      Finish SSO sign in
+// @Description  Finishes sign in with identity provider and starts user session, the response is the same as of sign in.
+// @Description
+// @Description  On the first sign in the provider account is linked to the user with the same email,
+// @Description  the email must be verified by the provider.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        provider path string true "Provider name."
+// @Param        request body domain.OIDCCallbackRequest true "Request body."
+// @Success      200  {object}  domain.SignInResponse
+// @Failure      400  {object}  Error
+// @Failure      401  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/oidc/{provider}/callback [post]
+func (h *oidcHandler) finishOIDCSignIn(w http.ResponseWriter, r *http.Request) {
+	var req domain.OIDCCallbackRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+	req.IP = clientIP(r)
+	req.UserAgent = r.UserAgent()
+
+	var boundState string
+	if cookie, err := r.Cookie(oidcStateCookie); err == nil {
+		boundState = cookie.Value
+	}
+
+	// State is single-use
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		SameSite: http.SameSiteNoneMode,
+		Path:     oidcStateCookiePath,
+		HttpOnly: true,
+		Secure:   true,
+		MaxAge:   -1,
+	})
+
+	response, err := h.oidcService.FinishSignIn(r.Context(), httphelp.ParseParamString("provider", r), &req, boundState)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	setSessionCookie(w, response.SessionID)
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
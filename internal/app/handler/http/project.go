@@ -1,7 +1,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -17,19 +16,26 @@ import (
 //go:generate mockgen -source=project.go -destination=./mocks/project.go -package=mocks
 type ProjectService interface {
 	GetProject(ctx context.Context, id int32) (*domain.Project, error)
-	GetProjects(ctx context.Context) ([]domain.Project, error)
+	GetProjects(ctx context.Context, params *domain.ListParams, filter *domain.ProjectFilter) ([]domain.Project, int, error)
+	GetProjectBySlug(ctx context.Context, slug string) (*domain.Project, error)
 	CreateProject(ctx context.Context, project *domain.Project) (*domain.Project, error)
 	UpdateProject(ctx context.Context, project *domain.Project) (*domain.Project, error)
 	DeleteProject(ctx context.Context, projectID int32) error
+	GetDeletedProjects(ctx context.Context, params *domain.ListParams) ([]domain.Project, int, error)
+	RestoreProject(ctx context.Context, projectID int32) (*domain.Project, error)
 
-	GetParticipants(ctx context.Context, projectID int32) ([]domain.ProjectParticipant, error)
+	GetParticipants(ctx context.Context, projectID int32, params *domain.ListParams, filter *domain.ParticipantFilter) ([]domain.ProjectParticipant, int, error)
 	GetParticipant(ctx context.Context, participantID, projectID int32) (*domain.ProjectParticipant, error)
 	AddParticipant(ctx context.Context, participant *domain.ProjectParticipant) (*domain.ProjectParticipant, error)
 	UpdateParticipant(ctx context.Context, participant *domain.ProjectParticipant) (*domain.ProjectParticipant, error)
 	RemoveParticipant(ctx context.Context, participantID, projectID int32) error
 
 	SetProjectImage(ctx context.Context, projectID int32, img []byte) error
-	GetProjectImage(ctx context.Context, projectID int32) (*domain.Project, error)
+	GetProjectImage(ctx context.Context, projectID int32, size int) (*domain.Project, *domain.File, error)
+
+	GetProjectTranslations(ctx context.Context, projectID int32) ([]domain.Translation, error)
+	SetProjectTranslation(ctx context.Context, projectID int32, translation *domain.Translation) (*domain.Translation, error)
+	DeleteProjectTranslation(ctx context.Context, projectID int32, locale string) error
 }
 
 type projectHandler struct {
@@ -43,6 +49,7 @@ func newProjectHandler(ps ProjectService) *projectHandler {
 // getProject godoc
 // @Summary      Get project by identifier
 // @Description  Returns information about single user.
+// @Description  Title and description are in the default language, only the public portfolio API is localized.
 // @Tags         Projects
 // @Produce      json
 // @Param        project_id path int true "Project identifier."
@@ -62,28 +69,94 @@ func (h *projectHandler) getProject(w http.ResponseWriter, r *http.Request) {
 	httphelp.SendJSON(http.StatusOK, response, w)
 }
 
+// getProjectBySlug godoc
+// @Summary      Get project by slug
+// @Description  Returns information about single project.
+// @Description  Former slugs are redirected to the current one with 301 Moved Permanently.
+// @Description  Title and description are in the default language, only the public portfolio API is localized.
+// @Tags         Projects
+// @Produce      json
+// @Param        slug path string true "Project slug."
+// @Success      200  {object}  domain.Project
+// @Success      301
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/by-slug/{slug} [get]
+func (h *projectHandler) getProjectBySlug(w http.ResponseWriter, r *http.Request) {
+	response, err := h.projectService.GetProjectBySlug(r.Context(), httphelp.ParseParamString("slug", r))
+	if err != nil {
+		sendSlugError(err, w, r)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
 // getProjects godoc
 // @Summary      Get projects
-// @Description  Returns list of projects.
+// @Description  Returns a page of projects.
+// @Description  Total number of projects is returned in `X-Total-Count` header, links to other pages in `Link` header.
+// @Description  Title and description are in the default language, only the public portfolio API is localized.
 // @Tags         Projects
 // @Produce      json
+// @Param        limit        query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset       query int    false "Number of projects to skip."
+// @Param        sort         query string false "Comma separated fields, `-` prefix for descending order: id, title, createdAt, startedAt, endedAt."
+// @Param        category_id  query int    false "Project category identifier."
+// @Param        team_id      query int    false "Team identifier."
+// @Param        technologies query string false "Comma separated technologies, projects must use all of them. Names and aliases of the catalog are accepted."
+// @Param        isactive     query bool   false "Whether projects are active. Default is true."
 // @Success      200  {array}  domain.Project
-// @Failure      404  {object}  Error
+// @Failure      400  {object}  Error
 // @Failure      500  {object}  Error
 // @Router       /api/v1/projects [get]
 func (h *projectHandler) getProjects(w http.ResponseWriter, r *http.Request) {
-	response, err := h.projectService.GetProjects(r.Context())
+	params, err := httphelp.ParseListParams(r, "id", "title", "createdAt", "startedAt", "endedAt")
 	if err != nil {
 		httphelp.SendError(err, w)
 		return
 	}
 
+	filter := domain.ProjectFilter{
+		Technologies: httphelp.QueryStrings("technologies", r),
+	}
+
+	filter.CategoryID, err = httphelp.QueryInt32("category_id", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	filter.TeamID, err = httphelp.QueryInt32("team_id", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	filter.IsActive, err = httphelp.QueryBool("isactive", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+	if filter.IsActive == nil {
+		isActive := true
+		filter.IsActive = &isActive
+	}
+
+	response, total, err := h.projectService.GetProjects(r.Context(), params, &filter)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
 	httphelp.SendJSON(http.StatusOK, response, w)
 }
 
 // createProject godoc
 // @Summary      Create project
 // @Description  Creates a new project. Returns an object with information about created project.
+// @Description  Slug is generated from the title if it is not set, Cyrillic letters are transliterated.
 // @Tags         Projects
 // @Accept       json
 // @Produce      json
@@ -111,6 +184,7 @@ func (h *projectHandler) createProject(w http.ResponseWriter, r *http.Request) {
 // updateProject godoc
 // @Summary      Update project
 // @Description  Updates a project.
+// @Description  The former slug keeps working, requests by it are redirected to the new one.
 // @Tags         Projects
 // @Accept       json
 // @Produce      json
@@ -138,9 +212,10 @@ func (h *projectHandler) updateProject(w http.ResponseWriter, r *http.Request) {
 	httphelp.SendJSON(http.StatusOK, response, w)
 }
 
-// removeDocumentFromProject godoc
+// deleteProject godoc
 // @Summary      Delete project
-// @Description  Deletes project.
+// @Description  Moves project to the trash. It can be restored until the retention period ends,
+// @Description  then it is deleted forever with its documents and images.
 // @Tags         Projects
 // @Param        project_id path int true "Project identifier."
 // @Success      200
@@ -160,25 +235,114 @@ func (h *projectHandler) deleteProject(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// getDeletedProjects godoc
+// @Summary      Get projects in the trash
+// @Description  Returns a page of deleted projects which are not purged yet.
+// @Description  Total number of projects is returned in `X-Total-Count` header, links to other pages in `Link` header.
+// @Tags         Trash
+// @Produce      json
+// @Param        limit  query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset query int    false "Number of projects to skip."
+// @Param        sort   query string false "Comma separated fields, `-` prefix for descending order: id, title, deletedAt."
+// @Success      200  {array}   domain.Project
+// @Failure      400  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/trash/projects [get]
+func (h *projectHandler) getDeletedProjects(w http.ResponseWriter, r *http.Request) {
+	params, err := httphelp.ParseListParams(r, "id", "title", "deletedAt")
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, total, err := h.projectService.GetDeletedProjects(r.Context(), params)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("getting deleted projects: %w", err), w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// restoreProject godoc
+// @Security     CSRF
+// @Summary      Restore project
+// @Description  Moves project out of the trash.
+// @Tags         Trash
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Success      200  {object}  domain.Project
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/trash/projects/{project_id}/restore [post]
+func (h *projectHandler) restoreProject(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+
+	response, err := h.projectService.RestoreProject(r.Context(), pid)
+	if err != nil {
+		httphelp.SendError(fmt.Errorf("restoring project: %w", err), w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
 // getParticipants godoc
 // @Summary      Get project participants
-// @Description  Returns a list of project participants.
+// @Description  Returns a page of project participants.
+// @Description  Total number of participants is returned in `X-Total-Count` header, links to other pages in `Link` header.
 // @Tags         Projects
 // @Produce      json
-// @Param        project_id path int true "Project identifier."
+// @Param        project_id path  int    true  "Project identifier."
+// @Param        limit      query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset     query int    false "Number of participants to skip."
+// @Param        sort       query string false "Comma separated fields, `-` prefix for descending order: name, surname, username, role, position, createdAt."
+// @Param        role       query int    false "Role of participants."
+// @Param        position   query int    false "Position of participants."
 // @Success      200  {array}   domain.ProjectParticipant
+// @Failure      400  {object}  Error
 // @Failure      404  {object}  Error
 // @Failure      500  {object}  Error
 // @Router       /api/v1/projects/{project_id}/participants [get]
 func (h *projectHandler) getParticipants(w http.ResponseWriter, r *http.Request) {
 	projectID := httphelp.ParseParamInt32("project_id", r)
 
-	response, err := h.projectService.GetParticipants(r.Context(), projectID)
+	params, err := httphelp.ParseListParams(r, "name", "surname", "username", "role", "position", "createdAt")
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	var filter domain.ParticipantFilter
+
+	role, err := httphelp.QueryInt32("role", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+	if role != nil {
+		userRole := domain.UserRole(*role)
+		filter.Role = &userRole
+	}
+
+	position, err := httphelp.QueryInt32("position", r)
 	if err != nil {
 		httphelp.SendError(err, w)
 		return
 	}
+	if position != nil {
+		userPosition := domain.UserPosition(*position)
+		filter.Position = &userPosition
+	}
 
+	response, total, err := h.projectService.GetParticipants(r.Context(), projectID, params, &filter)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
 	httphelp.SendJSON(http.StatusOK, response, w)
 }
 
@@ -340,22 +504,109 @@ func (h *projectHandler) setProjectImage(w http.ResponseWriter, r *http.Request)
 // getProjectImage godoc
 // @Summary      Get project image content
 // @Description  Returns project image.
+// @Description  Images are stored in several sizes, the largest one (1024px) is returned by default.
 // @Tags         Projects
 // @Produce      octet-stream
 // @Param        project_id path int true "Project identifier."
+// @Param        size query int false "Maximum width and height of the image: 64, 256 or 1024."
 // @Success      200
+// @Failure      400  {object}  Error
 // @Failure      404  {object}  Error
 // @Failure      500  {object}  Error
 // @Router       /api/v1/projects/{project_id}/image [get]
 func (h *projectHandler) getProjectImage(w http.ResponseWriter, r *http.Request) {
 	tid := httphelp.ParseParamInt32("project_id", r)
 
-	response, err := h.projectService.GetProjectImage(r.Context(), tid)
+	size, err := httphelp.QueryImageSize(r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, file, err := h.projectService.GetProjectImage(r.Context(), tid, size)
 	if err != nil {
 		httphelp.SendError(fmt.Errorf("getting project image: %w", err), w)
 		return
 	}
+	defer file.Close()
 
 	fileName := fmt.Sprintf("%s.%s", response.Title, filepath.Ext(response.ImageId))
-	http.ServeContent(w, r, fileName, response.UpdatedAt, bytes.NewReader(response.ImageContent))
+	httphelp.ServeFile(w, r, fileName, file)
+}
+
+// getProjectTranslations godoc
+// @Summary      Get project translations
+// @Description  Returns translations of the project title and description to languages other than the default one.
+// @Tags         Projects
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Success      200  {array}   domain.Translation
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/translations [get]
+func (h *projectHandler) getProjectTranslations(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+
+	response, err := h.projectService.GetProjectTranslations(r.Context(), pid)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// setProjectTranslation godoc
+// @Summary      Set project translation
+// @Description  Creates or replaces translation of the project to the locale.
+// @Description  The public portfolio API returns it to clients preferring the locale.
+// @Tags         Projects
+// @Accept       json
+// @Produce      json
+// @Param        project_id path int true "Project identifier."
+// @Param        locale path string true "Locale, one of the supported ones except the default."
+// @Param        request body dto.SetTranslationRequest true "Request body."
+// @Success      200  {object}  domain.Translation
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/translations/{locale} [put]
+func (h *projectHandler) setProjectTranslation(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+
+	var req dto.SetTranslationRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.projectService.SetProjectTranslation(r.Context(), pid, req.ToDomain(httphelp.ParseParamString("locale", r)))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// deleteProjectTranslation godoc
+// @Summary      Delete project translation
+// @Description  Deletes translation of the project to the locale.
+// @Tags         Projects
+// @Param        project_id path int true "Project identifier."
+// @Param        locale path string true "Locale."
+// @Success      200
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/projects/{project_id}/translations/{locale} [delete]
+func (h *projectHandler) deleteProjectTranslation(w http.ResponseWriter, r *http.Request) {
+	pid := httphelp.ParseParamInt32("project_id", r)
+
+	err := h.projectService.DeleteProjectTranslation(r.Context(), pid, httphelp.ParseParamString("locale", r))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
 }
@@ -0,0 +1,359 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+)
+
+// publicRoutes is the prefix of the read-only portfolio API available without authorization.
+const publicRoutes = `/api/public/v1`
+
+//go:generate mockgen -source=public.go -destination=./mocks/public.go -package=mocks
+type PublicService interface {
+	GetProjects(ctx context.Context, params *domain.ListParams, filter *domain.PublicProjectFilter) ([]domain.PublicProject, int, error)
+	GetProject(ctx context.Context, slug string) (*domain.PublicProject, error)
+	GetProjectParticipants(ctx context.Context, slug string) ([]domain.PublicMember, error)
+	GetProjectImage(ctx context.Context, slug string, size int) (*domain.Project, *domain.File, error)
+	GetProjectMedia(ctx context.Context, slug string) ([]domain.PublicMedia, error)
+	GetProjectMediaImage(ctx context.Context, slug string, mediaID int32, size int) (*domain.ProjectMedia, *domain.File, error)
+	GetTeams(ctx context.Context, params *domain.ListParams) ([]domain.PublicTeam, int, error)
+	GetTeam(ctx context.Context, slug string) (*domain.PublicTeam, error)
+	GetTeamMembers(ctx context.Context, slug string) ([]domain.PublicMember, error)
+	GetTeamImage(ctx context.Context, slug string, size int) (*domain.Team, *domain.File, error)
+	GetCategories(ctx context.Context) ([]domain.ProjectCategory, error)
+	GetTechnologies(ctx context.Context) ([]domain.TechnologyUsage, error)
+}
+
+type publicHandler struct {
+	publicService PublicService
+}
+
+func newPublicHandler(srv PublicService) *publicHandler {
+	return &publicHandler{srv}
+}
+
+// getProjects godoc
+// @Summary      Get portfolio projects
+// @Description  Returns a page of active projects.
+// @Description  Titles and descriptions are translated to the negotiated language if there are translations, it is returned in `Content-Language` header.
+// @Description  Total number of projects is returned in `X-Total-Count` header, links to other pages in `Link` header.
+// @Tags         Public
+// @Produce      json
+// @Param        limit        query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset       query int    false "Number of projects to skip."
+// @Param        sort         query string false "Comma separated fields, `-` prefix for descending order: title, createdAt, startedAt, endedAt."
+// @Param        category_id  query int    false "Project category identifier."
+// @Param        team         query string false "Team slug."
+// @Param        technologies query string false "Comma separated technologies, projects must use all of them. Names and aliases of the catalog are accepted."
+// @Param        lang            query  string false "Content language, takes precedence over Accept-Language header."
+// @Param        Accept-Language header string false "Preferred content languages."
+// @Success      200  {array}   domain.PublicProject
+// @Failure      400  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/projects [get]
+func (h *publicHandler) getProjects(w http.ResponseWriter, r *http.Request) {
+	params, err := httphelp.ParseListParams(r, "title", "createdAt", "startedAt", "endedAt")
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	filter := domain.PublicProjectFilter{
+		Team:         r.URL.Query().Get("team"),
+		Technologies: httphelp.QueryStrings("technologies", r),
+	}
+
+	filter.CategoryID, err = httphelp.QueryInt32("category_id", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, total, err := h.publicService.GetProjects(r.Context(), params, &filter)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getProject godoc
+// @Summary      Get portfolio project by slug
+// @Description  Returns information about single active project.
+// @Description  Titles and descriptions are translated to the negotiated language if there are translations, it is returned in `Content-Language` header.
+// @Description  Former slugs are redirected to the current one with 301 Moved Permanently.
+// @Tags         Public
+// @Produce      json
+// @Param        slug path string true "Project slug."
+// @Param        lang            query  string false "Content language, takes precedence over Accept-Language header."
+// @Param        Accept-Language header string false "Preferred content languages."
+// @Success      200  {object}  domain.PublicProject
+// @Success      301
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/projects/{slug} [get]
+func (h *publicHandler) getProject(w http.ResponseWriter, r *http.Request) {
+	response, err := h.publicService.GetProject(r.Context(), httphelp.ParseParamString("slug", r))
+	if err != nil {
+		sendSlugError(err, w, r)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getProjectParticipants godoc
+// @Summary      Get portfolio project participants
+// @Description  Returns names and positions of the project participants.
+// @Tags         Public
+// @Produce      json
+// @Param        slug path string true "Project slug."
+// @Success      200  {array}   domain.PublicMember
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/projects/{slug}/participants [get]
+func (h *publicHandler) getProjectParticipants(w http.ResponseWriter, r *http.Request) {
+	response, err := h.publicService.GetProjectParticipants(r.Context(), httphelp.ParseParamString("slug", r))
+	if err != nil {
+		sendSlugError(err, w, r)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getProjectImage godoc
+// @Summary      Get portfolio project image
+// @Description  Returns project image.
+// @Description  Images are stored in several sizes, the largest one (1024px) is returned by default.
+// @Tags         Public
+// @Produce      octet-stream
+// @Param        slug path string true "Project slug."
+// @Param        size query int false "Maximum width and height of the image: 64, 256 or 1024."
+// @Success      200
+// @Success      304
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/projects/{slug}/image [get]
+func (h *publicHandler) getProjectImage(w http.ResponseWriter, r *http.Request) {
+	size, err := httphelp.QueryImageSize(r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	project, file, err := h.publicService.GetProjectImage(r.Context(), httphelp.ParseParamString("slug", r), size)
+	if err != nil {
+		sendSlugError(fmt.Errorf("getting project image: %w", err), w, r)
+		return
+	}
+	defer file.Close()
+
+	httphelp.ServeFile(w, r, project.Slug+filepath.Ext(project.ImageId), file)
+}
+
+// getProjectMedia godoc
+// @Summary      Get portfolio project gallery
+// @Description  Returns images and video links of the project gallery in display order, captions are rendered to HTML.
+// @Tags         Public
+// @Produce      json
+// @Param        slug path string true "Project slug."
+// @Success      200  {array}   domain.PublicMedia
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/projects/{slug}/media [get]
+func (h *publicHandler) getProjectMedia(w http.ResponseWriter, r *http.Request) {
+	response, err := h.publicService.GetProjectMedia(r.Context(), httphelp.ParseParamString("slug", r))
+	if err != nil {
+		sendSlugError(err, w, r)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getProjectMediaImage godoc
+// @Summary      Get portfolio project gallery image
+// @Description  Returns image of the gallery item.
+// @Description  Images are stored in several sizes, the largest one (1024px) is returned by default.
+// @Tags         Public
+// @Produce      octet-stream
+// @Param        slug     path  string true  "Project slug."
+// @Param        media_id path  int    true  "Gallery item identifier."
+// @Param        size     query int    false "Maximum width and height of the image: 64, 256 or 1024."
+// @Success      200
+// @Success      304
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/projects/{slug}/media/{media_id}/image [get]
+func (h *publicHandler) getProjectMediaImage(w http.ResponseWriter, r *http.Request) {
+	size, err := httphelp.QueryImageSize(r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	slug := httphelp.ParseParamString("slug", r)
+	media, file, err := h.publicService.GetProjectMediaImage(r.Context(), slug, httphelp.ParseParamInt32("media_id", r), size)
+	if err != nil {
+		sendSlugError(fmt.Errorf("getting project media image: %w", err), w, r)
+		return
+	}
+	defer file.Close()
+
+	httphelp.ServeFile(w, r, fmt.Sprintf("%s-%d%s", slug, media.ID, filepath.Ext(media.ImageID)), file)
+}
+
+// getTeams godoc
+// @Summary      Get portfolio teams
+// @Description  Returns a page of teams which are not disabled.
+// @Description  Titles and descriptions are translated to the negotiated language if there are translations, it is returned in `Content-Language` header.
+// @Description  Total number of teams is returned in `X-Total-Count` header, links to other pages in `Link` header.
+// @Tags         Public
+// @Produce      json
+// @Param        limit    query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset   query int    false "Number of teams to skip."
+// @Param        sort     query string false "Comma separated fields, `-` prefix for descending order: title, createdAt."
+// @Param        lang            query  string false "Content language, takes precedence over Accept-Language header."
+// @Param        Accept-Language header string false "Preferred content languages."
+// @Success      200  {array}   domain.PublicTeam
+// @Failure      400  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/teams [get]
+func (h *publicHandler) getTeams(w http.ResponseWriter, r *http.Request) {
+	params, err := httphelp.ParseListParams(r, "title", "createdAt")
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, total, err := h.publicService.GetTeams(r.Context(), params)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getTeam godoc
+// @Summary      Get portfolio team by slug
+// @Description  Returns information about single team.
+// @Description  Titles and descriptions are translated to the negotiated language if there are translations, it is returned in `Content-Language` header.
+// @Description  Former slugs are redirected to the current one with 301 Moved Permanently.
+// @Tags         Public
+// @Produce      json
+// @Param        slug path string true "Team slug."
+// @Param        lang            query  string false "Content language, takes precedence over Accept-Language header."
+// @Param        Accept-Language header string false "Preferred content languages."
+// @Success      200  {object}  domain.PublicTeam
+// @Success      301
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/teams/{slug} [get]
+func (h *publicHandler) getTeam(w http.ResponseWriter, r *http.Request) {
+	response, err := h.publicService.GetTeam(r.Context(), httphelp.ParseParamString("slug", r))
+	if err != nil {
+		sendSlugError(err, w, r)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getTeamMembers godoc
+// @Summary      Get portfolio team members
+// @Description  Returns names and positions of the team members.
+// @Tags         Public
+// @Produce      json
+// @Param        slug path string true "Team slug."
+// @Success      200  {array}   domain.PublicMember
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/teams/{slug}/members [get]
+func (h *publicHandler) getTeamMembers(w http.ResponseWriter, r *http.Request) {
+	response, err := h.publicService.GetTeamMembers(r.Context(), httphelp.ParseParamString("slug", r))
+	if err != nil {
+		sendSlugError(err, w, r)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getTeamImage godoc
+// @Summary      Get portfolio team image
+// @Description  Returns team image.
+// @Description  Images are stored in several sizes, the largest one (1024px) is returned by default.
+// @Tags         Public
+// @Produce      octet-stream
+// @Param        slug path string true "Team slug."
+// @Param        size query int false "Maximum width and height of the image: 64, 256 or 1024."
+// @Success      200
+// @Success      304
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/teams/{slug}/image [get]
+func (h *publicHandler) getTeamImage(w http.ResponseWriter, r *http.Request) {
+	size, err := httphelp.QueryImageSize(r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	team, file, err := h.publicService.GetTeamImage(r.Context(), httphelp.ParseParamString("slug", r), size)
+	if err != nil {
+		sendSlugError(fmt.Errorf("getting team image: %w", err), w, r)
+		return
+	}
+	defer file.Close()
+
+	httphelp.ServeFile(w, r, team.Slug+filepath.Ext(team.ImageID), file)
+}
+
+// getCategories godoc
+// @Summary      Get project categories
+// @Description  Returns all project categories.
+// @Tags         Public
+// @Produce      json
+// @Success      200  {array}   domain.ProjectCategory
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/categories [get]
+func (h *publicHandler) getCategories(w http.ResponseWriter, r *http.Request) {
+	response, err := h.publicService.GetCategories(r.Context())
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getTechnologies godoc
+// @Summary      Get technologies of the portfolio
+// @Description  Returns technologies used by active projects with the number of such projects, most used first.
+// @Tags         Public
+// @Produce      json
+// @Success      200  {array}   domain.TechnologyUsage
+// @Failure      500  {object}  Error
+// @Router       /api/public/v1/technologies [get]
+func (h *publicHandler) getTechnologies(w http.ResponseWriter, r *http.Request) {
+	response, err := h.publicService.GetTechnologies(r.Context())
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// trustedRealIP sets RemoteAddr to the client address from X-Forwarded-For or X-Real-IP headers,
+// but only if the request came from one of the trusted proxies. Other clients can put anything in these headers,
+// so their own address is kept, otherwise sign in throttling and rate limits could be bypassed.
+func trustedRealIP(proxies []netip.Prefix) func(http.Handler) http.Handler {
+	trusted := func(addr netip.Addr) bool {
+		for _, p := range proxies {
+			if p.Contains(addr.Unmap()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			peer, err := netip.ParseAddr(clientIP(r))
+			if err != nil || !trusted(peer) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if ip, ok := forwardedFor(r.Header.Values("X-Forwarded-For"), trusted); ok {
+				r.RemoteAddr = ip.String()
+			} else if ip, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get("X-Real-IP"))); err == nil {
+				r.RemoteAddr = ip.String()
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedFor returns the last address of X-Forwarded-For which doesn't belong to a trusted proxy.
+// Addresses are appended by each proxy, so the ones before it may be forged by the client.
+func forwardedFor(headers []string, trusted func(netip.Addr) bool) (netip.Addr, bool) {
+	var addrs []string
+	for _, h := range headers {
+		addrs = append(addrs, strings.Split(h, ",")...)
+	}
+
+	var last netip.Addr
+	for i := len(addrs) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(addrs[i]))
+		if err != nil {
+			break
+		}
+		last = addr
+		if !trusted(addr) {
+			return addr, true
+		}
+	}
+
+	// All addresses are proxies, the first one is the closest to the client
+	return last, last.IsValid()
+}
+
+// trustedProxies parses addresses and CIDR ranges of the proxies, invalid ones are rejected when config is read.
+func trustedProxies(list []string) []netip.Prefix {
+	var proxies []netip.Prefix
+	for _, s := range list {
+		if p, err := netip.ParsePrefix(s); err == nil {
+			proxies = append(proxies, p.Masked())
+		} else if addr, err := netip.ParseAddr(s); err == nil {
+			proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+		}
+	}
+	return proxies
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustedRealIP(t *testing.T) {
+	var got string
+	h := trustedRealIP(trustedProxies([]string{"10.0.0.0/8", "192.168.1.1"}))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = clientIP(r)
+	}))
+
+	tests := []struct {
+		name     string
+		peer     string
+		header   map[string]string
+		expected string
+	}{
+		{
+			name:     "should ignore headers of untrusted peer",
+			peer:     "203.0.113.7:5000",
+			header:   map[string]string{"X-Forwarded-For": "198.51.100.1", "X-Real-IP": "198.51.100.2"},
+			expected: "203.0.113.7",
+		},
+		{
+			name:     "should take client from trusted proxy",
+			peer:     "10.0.0.2:5000",
+			header:   map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			expected: "198.51.100.1",
+		},
+		{
+			name:     "should skip forged addresses before the last untrusted one",
+			peer:     "192.168.1.1:5000",
+			header:   map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.1, 10.1.1.1"},
+			expected: "198.51.100.1",
+		},
+		{
+			name:     "should use X-Real-IP of trusted proxy",
+			peer:     "10.0.0.2:5000",
+			header:   map[string]string{"X-Real-IP": "198.51.100.3"},
+			expected: "198.51.100.3",
+		},
+		{
+			name:     "should keep proxy address without headers",
+			peer:     "10.0.0.2:5000",
+			expected: "10.0.0.2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tc.peer
+			for k, v := range tc.header {
+				r.Header.Set(k, v)
+			}
+
+			h.ServeHTTP(httptest.NewRecorder(), r)
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-chi/httprate"
 	"github.com/rs/cors"
 
+	"web-studio-backend/internal/app/domain"
 	"web-studio-backend/internal/pkg/config"
 )
 
@@ -19,18 +20,37 @@ func NewHandler(
 	documentService DocumentService,
 	teamService TeamService,
 	projectCategoryService ProjectCategoryService,
+	boardService BoardService,
+	searchService SearchService,
+	auditService AuditService,
+	apiTokenService APITokenService,
+	oidcService OIDCService,
+	twoFactorService TwoFactorService,
+	publicService PublicService,
+	mediaService MediaService,
+	technologyService TechnologyService,
 ) http.Handler {
 	uh := newUserHandler(userService)
 	ph := newProjectHandler(projectService)
-	ah := newAuthHandler(authService, userService)
+	ah := newAuthHandler(authService, userService, apiTokenService, twoFactorService)
+	ath := newAPITokenHandler(apiTokenService)
+	oh := newOIDCHandler(oidcService)
+	tfh := newTwoFactorHandler(twoFactorService)
 	dh := newDocumentHandler(documentService)
 	th := newTeamHandler(teamService)
 	pch := newProjectCategoryHandler(projectCategoryService)
+	bh := newBoardHandler(boardService)
+	sh := newSearchHandler(searchService)
+	adh := newAuditHandler(auditService)
+	pubh := newPublicHandler(publicService)
+	mh := newMediaHandler(mediaService)
+	tch := newTechnologyHandler(technologyService)
+	az := newAuthorizer(projectService, teamService)
 
 	r := chi.NewRouter()
 
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(trustedRealIP(trustedProxies(config.Get().Http.TrustedProxies)))
 	r.Use(middleware.Logger)
 	r.Use(httprate.LimitByIP(69, time.Minute))
 	r.Use(middleware.Recoverer)
@@ -38,7 +58,7 @@ func NewHandler(
 		AllowedOrigins:   config.Get().Http.AllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Session-Id"},
-		ExposedHeaders:   []string{"Link"},
+		ExposedHeaders:   []string{"Link", "X-Total-Count"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}).Handler)
@@ -46,7 +66,7 @@ func NewHandler(
 		middleware.SetHeader("X-Content-Type-Options", "nosniff"),
 		middleware.SetHeader("X-Frame-Options", "deny"),
 	)
-	r.Use(middleware.NoCache)
+	r.Use(exceptPrefix(publicRoutes, middleware.NoCache))
 
 	r.Get("/static/*", getStatic)
 
@@ -55,60 +75,175 @@ func NewHandler(
 	r.Get(`/api/v1/docs/swagger.json`, getApiDocsSwagger)
 
 	r.Post(`/api/v1/auth/sign-in`, ah.signIn)
+	r.Post(`/api/v1/auth/sign-in/2fa`, tfh.completeSignIn)
 	r.Post(`/api/v1/auth/sign-out`, ah.signOut)
+	r.Post(`/api/v1/auth/password/forgot`, ah.forgotPassword)
+	r.Post(`/api/v1/auth/password/reset`, ah.resetPassword)
+	r.Post(`/api/v1/auth/email/verify`, ah.verifyEmail)
+	r.Get(`/api/v1/auth/oidc/providers`, oh.getOIDCProviders)
+	r.Post(`/api/v1/auth/oidc/{provider}/start`, oh.startOIDCSignIn)
+	r.Post(`/api/v1/auth/oidc/{provider}/callback`, oh.finishOIDCSignIn)
+
+	// Access policies
+	var (
+		admin              = az.allow(roleAtLeast(domain.UserRoleAdmin))
+		moderator          = az.allow(roleAtLeast(domain.UserRoleModerator))
+		adminOrSelf        = az.allow(roleAtLeast(domain.UserRoleAdmin), self("user_id"))
+		projectLead        = az.allow(roleAtLeast(domain.UserRoleAdmin), az.projectLead("project_id"))
+		projectParticipant = az.allow(roleAtLeast(domain.UserRoleAdmin), az.projectParticipant("project_id"))
+		teamLead           = az.allow(roleAtLeast(domain.UserRoleAdmin), az.teamLead("team_id"))
+	)
+
+	// Public portfolio API
+	r.Route(publicRoutes, func(r chi.Router) {
+		r.Use(publicCache(config.Get().Public.CacheMaxAge))
+		r.Use(negotiateLocale(config.Get().Locale.Supported, config.Get().Locale.Default))
+
+		r.Get(`/projects`, pubh.getProjects)
+		r.Get(`/projects/{slug}`, pubh.getProject)
+		r.Get(`/projects/{slug}/participants`, pubh.getProjectParticipants)
+		r.Get(`/projects/{slug}/image`, pubh.getProjectImage)
+		r.Get(`/projects/{slug}/media`, pubh.getProjectMedia)
+		r.Get(`/projects/{slug}/media/{media_id}/image`, pubh.getProjectMediaImage)
+		r.Get(`/teams`, pubh.getTeams)
+		r.Get(`/teams/{slug}`, pubh.getTeam)
+		r.Get(`/teams/{slug}/members`, pubh.getTeamMembers)
+		r.Get(`/teams/{slug}/image`, pubh.getTeamImage)
+		r.Get(`/categories`, pubh.getCategories)
+		r.Get(`/technologies`, pubh.getTechnologies)
+	})
 
+	// Private routes
 	r.Group(func(r chi.Router) {
-		//r.Use(ah.authMiddleware)
-		// TODO: role middlewares
+		r.Use(ah.authMiddleware)
+
+		// Auth
+		r.Post(`/api/v1/auth/password/change`, ah.changePassword)
+		r.Post(`/api/v1/auth/email/verification`, ah.sendEmailVerification)
+		r.Get(sessionRoutes, ah.getSessions)
+		r.Delete(sessionRoutes, ah.revokeOtherSessions)
+		r.Delete(sessionRoutes+`/{session_id}`, ah.revokeSession)
+		r.Get(`/api/v1/auth/tokens`, ath.getAPITokens)
+		r.Post(`/api/v1/auth/tokens`, ath.createAPIToken)
+		r.Delete(`/api/v1/auth/tokens/{token_id}`, ath.revokeAPIToken)
+		r.Get(twoFactorRoutes, tfh.getStatus)
+		r.Post(twoFactorRoutes+`/setup`, tfh.setup)
+		r.Post(twoFactorRoutes+`/enable`, tfh.enable)
+		r.Post(twoFactorRoutes+`/disable`, tfh.disable)
+		r.Post(twoFactorRoutes+`/recovery-codes`, tfh.regenerateRecoveryCodes)
 
 		// Users
 		r.Get(`/api/v1/users/{user_id}`, uh.getUser)
 		r.Get(`/api/v1/users`, uh.getUsers)
-		r.Post(`/api/v1/users`, uh.createUser)
-		r.Put(`/api/v1/users/{user_id}`, uh.updateUser)
-		r.Delete(`/api/v1/users/{user_id}`, uh.removeUser)
-		r.Post(`/api/v1/users/{user_id}/image`, uh.setUserImage)
 		r.Get(`/api/v1/users/{user_id}/image`, uh.getUserImage)
+		r.With(admin).Post(`/api/v1/users`, uh.createUser)
+		r.With(adminOrSelf).Put(`/api/v1/users/{user_id}`, uh.updateUser)
+		r.With(admin).Delete(`/api/v1/users/{user_id}`, uh.removeUser)
+		r.With(adminOrSelf).Post(`/api/v1/users/{user_id}/image`, uh.setUserImage)
+		r.With(admin).Post(`/api/v1/users/{user_id}/unlock`, ah.unlockUser)
 
 		// Projects
 		r.Get(`/api/v1/projects/{project_id}`, ph.getProject)
 		r.Get(`/api/v1/projects`, ph.getProjects)
-		r.Group(func(r chi.Router) {
-			// TODO: remove
-			r.Use(ah.authMiddleware)
-			r.Post(`/api/v1/projects`, ph.createProject)
-		})
-		r.Put(`/api/v1/projects/{project_id}`, ph.updateProject)
-		r.Delete(`/api/v1/projects/{project_id}`, ph.deleteProject)
-		r.Post(`/api/v1/projects/{project_id}/image`, ph.setProjectImage)
+		r.Get(`/api/v1/projects/by-slug/{slug}`, ph.getProjectBySlug)
 		r.Get(`/api/v1/projects/{project_id}/image`, ph.getProjectImage)
 		r.Get(`/api/v1/projects/{project_id}/participants`, ph.getParticipants)
-		r.Post(`/api/v1/projects/{project_id}/participants`, ph.addParticipant)
 		r.Get(`/api/v1/projects/{project_id}/participants/{user_id}`, ph.getParticipant)
-		r.Put(`/api/v1/projects/{project_id}/participants/{user_id}`, ph.updateParticipant)
-		r.Delete(`/api/v1/projects/{project_id}/participants/{user_id}`, ph.removeParticipant)
+		r.With(moderator).Post(`/api/v1/projects`, ph.createProject)
+		r.With(projectLead).Put(`/api/v1/projects/{project_id}`, ph.updateProject)
+		r.With(admin).Delete(`/api/v1/projects/{project_id}`, ph.deleteProject)
+		r.With(projectLead).Post(`/api/v1/projects/{project_id}/image`, ph.setProjectImage)
+		r.With(projectLead).Post(`/api/v1/projects/{project_id}/participants`, ph.addParticipant)
+		r.With(projectLead).Put(`/api/v1/projects/{project_id}/participants/{user_id}`, ph.updateParticipant)
+		r.With(projectLead).Delete(`/api/v1/projects/{project_id}/participants/{user_id}`, ph.removeParticipant)
+		r.Get(`/api/v1/projects/{project_id}/translations`, ph.getProjectTranslations)
+		r.With(projectLead).Put(`/api/v1/projects/{project_id}/translations/{locale}`, ph.setProjectTranslation)
+		r.With(projectLead).Delete(`/api/v1/projects/{project_id}/translations/{locale}`, ph.deleteProjectTranslation)
 
 		// Project categories
 		r.Get(`/api/v1/projects/categories`, pch.getProjectCategories)
-		r.Post(`/api/v1/projects/categories`, pch.createProjectCategory)
-		r.Put(`/api/v1/projects/categories/{category_id}`, pch.updateProjectCategory)
-		r.Delete(`/api/v1/projects/categories/{category_id}`, pch.deleteProjectCategory)
+		r.With(admin).Post(`/api/v1/projects/categories`, pch.createProjectCategory)
+		r.With(admin).Put(`/api/v1/projects/categories/{category_id}`, pch.updateProjectCategory)
+		r.With(admin).Delete(`/api/v1/projects/categories/{category_id}`, pch.deleteProjectCategory)
+
+		// Technologies
+		r.Get(`/api/v1/technologies`, tch.getTechnologies)
+		r.Get(`/api/v1/technologies/popular`, tch.getPopularTechnologies)
+		r.Get(`/api/v1/technologies/{technology_id}`, tch.getTechnology)
+		r.Get(`/api/v1/technologies/{technology_id}/projects`, tch.getTechnologyProjects)
+		r.With(admin).Post(`/api/v1/technologies`, tch.createTechnology)
+		r.With(admin).Put(`/api/v1/technologies/{technology_id}`, tch.updateTechnology)
+		r.With(admin).Delete(`/api/v1/technologies/{technology_id}`, tch.deleteTechnology)
+
+		// Project media
+		r.Get(`/api/v1/projects/{project_id}/media`, mh.getProjectMedia)
+		r.Get(`/api/v1/projects/{project_id}/media/{media_id}`, mh.getMedia)
+		r.Get(`/api/v1/projects/{project_id}/media/{media_id}/image`, mh.getMediaImage)
+		r.With(projectLead).Post(`/api/v1/projects/{project_id}/media/images`, mh.addImage)
+		r.With(projectLead).Post(`/api/v1/projects/{project_id}/media/videos`, mh.addVideo)
+		r.With(projectLead).Put(`/api/v1/projects/{project_id}/media/order`, mh.reorderMedia)
+		r.With(projectLead).Put(`/api/v1/projects/{project_id}/media/{media_id}`, mh.updateMedia)
+		r.With(projectLead).Delete(`/api/v1/projects/{project_id}/media/{media_id}`, mh.deleteMedia)
 
 		// Documents
 		r.Get(`/api/v1/projects/{project_id}/documents`, dh.getProjectDocuments)
-		r.Post(`/api/v1/projects/{project_id}/documents`, dh.addDocumentToProject)
-		r.Delete(`/api/v1/projects/{project_id}/documents/{document_id}`, dh.removeDocumentFromProject)
 		r.Get(`/api/v1/documents/{document_id}`, dh.downloadDocument)
+		r.Get(`/api/v1/documents/{document_id}/versions`, dh.getDocumentVersions)
+		r.Get(`/api/v1/documents/{document_id}/versions/{version}`, dh.downloadDocumentVersion)
+		r.With(projectLead).Post(`/api/v1/projects/{project_id}/documents`, dh.addDocumentToProject)
+		r.With(projectLead).Delete(`/api/v1/projects/{project_id}/documents/{document_id}`, dh.removeDocumentFromProject)
+		r.With(projectLead).Post(`/api/v1/projects/{project_id}/documents/{document_id}/versions`, dh.addDocumentVersion)
+		r.With(projectLead).Post(`/api/v1/projects/{project_id}/documents/{document_id}/versions/{version}/restore`, dh.restoreDocumentVersion)
+
+		// Trash
+		r.With(admin).Get(`/api/v1/trash/projects`, ph.getDeletedProjects)
+		r.With(admin).Post(`/api/v1/trash/projects/{project_id}/restore`, ph.restoreProject)
+		r.With(projectLead).Get(`/api/v1/projects/{project_id}/trash/documents`, dh.getDeletedProjectDocuments)
+		r.With(projectLead).Post(`/api/v1/projects/{project_id}/trash/documents/{document_id}/restore`, dh.restoreDocument)
+
+		// Boards
+		r.With(projectParticipant).Get(`/api/v1/projects/{project_id}/boards`, bh.getBoards)
+		r.With(projectLead).Post(`/api/v1/projects/{project_id}/boards`, bh.createBoard)
+		r.With(projectParticipant).Get(`/api/v1/projects/{project_id}/boards/{board_id}`, bh.getBoard)
+		r.With(projectLead).Put(`/api/v1/projects/{project_id}/boards/{board_id}`, bh.updateBoard)
+		r.With(projectLead).Delete(`/api/v1/projects/{project_id}/boards/{board_id}`, bh.deleteBoard)
+		r.With(projectParticipant).Get(`/api/v1/projects/{project_id}/boards/{board_id}/columns`, bh.getColumns)
+		r.With(projectLead).Post(`/api/v1/projects/{project_id}/boards/{board_id}/columns`, bh.createColumn)
+		r.With(projectLead).Put(`/api/v1/projects/{project_id}/boards/{board_id}/columns/{column_id}`, bh.updateColumn)
+		r.With(projectLead).Delete(`/api/v1/projects/{project_id}/boards/{board_id}/columns/{column_id}`, bh.deleteColumn)
+		r.With(projectParticipant).Get(`/api/v1/projects/{project_id}/boards/{board_id}/tasks`, bh.getTasks)
+		r.With(projectParticipant).Post(`/api/v1/projects/{project_id}/boards/{board_id}/tasks`, bh.createTask)
+		r.With(projectParticipant).Get(`/api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id}`, bh.getTask)
+		r.With(projectParticipant).Put(`/api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id}`, bh.updateTask)
+		r.With(projectLead).Delete(`/api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id}`, bh.deleteTask)
+		r.With(projectParticipant).Get(`/api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id}/members`, bh.getTaskMembers)
+		r.With(projectParticipant).Post(`/api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id}/members`, bh.addTaskMember)
+		r.With(projectParticipant).Delete(`/api/v1/projects/{project_id}/boards/{board_id}/tasks/{task_id}/members/{user_id}`, bh.removeTaskMember)
 
 		// Teams
 		r.Get(`/api/v1/teams/{team_id}`, th.getTeam)
 		r.Get(`/api/v1/teams`, th.getTeams)
-		r.Post(`/api/v1/teams`, th.createTeam)
-		r.Put(`/api/v1/teams/{team_id}`, th.updateTeam)
-		r.Post(`/api/v1/teams/{team_id}/image`, th.setTeamImage)
+		r.Get(`/api/v1/teams/by-slug/{slug}`, th.getTeamBySlug)
 		r.Get(`/api/v1/teams/{team_id}/image`, th.getTeamImage)
-		r.Post(`/api/v1/teams/{team_id}/disable`, th.disableTeam)
-		r.Post(`/api/v1/teams/{team_id}/enable`, th.enableTeam)
+		r.Get(`/api/v1/teams/{team_id}/members`, th.getMembers)
+		r.Get(`/api/v1/teams/{team_id}/members/{user_id}`, th.getMember)
+		r.With(admin).Post(`/api/v1/teams`, th.createTeam)
+		r.With(teamLead).Put(`/api/v1/teams/{team_id}`, th.updateTeam)
+		r.With(teamLead).Post(`/api/v1/teams/{team_id}/image`, th.setTeamImage)
+		r.With(admin).Post(`/api/v1/teams/{team_id}/disable`, th.disableTeam)
+		r.With(admin).Post(`/api/v1/teams/{team_id}/enable`, th.enableTeam)
+		r.With(teamLead).Post(`/api/v1/teams/{team_id}/members`, th.addMember)
+		r.With(teamLead).Put(`/api/v1/teams/{team_id}/members/{user_id}`, th.updateMember)
+		r.With(teamLead).Delete(`/api/v1/teams/{team_id}/members/{user_id}`, th.removeMember)
+		r.Get(`/api/v1/teams/{team_id}/translations`, th.getTeamTranslations)
+		r.With(teamLead).Put(`/api/v1/teams/{team_id}/translations/{locale}`, th.setTeamTranslation)
+		r.With(teamLead).Delete(`/api/v1/teams/{team_id}/translations/{locale}`, th.deleteTeamTranslation)
+
+		// Search
+		r.Get(`/api/v1/search`, sh.search)
+
+		// Audit
+		r.With(admin).Get(`/api/v1/audit`, adh.getRecords)
 	})
 
 	return r
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+)
+
+//go:generate mockgen -source=search.go -destination=./mocks/search.go -package=mocks
+type SearchService interface {
+	Search(ctx context.Context, req *domain.SearchRequest) ([]domain.SearchResult, error)
+}
+
+type searchHandler struct {
+	searchService SearchService
+}
+
+func newSearchHandler(searchService SearchService) *searchHandler {
+	return &searchHandler{searchService: searchService}
+}
+
+// search godoc
+// @Summary      Search
+// @Description  Searches projects, users, teams and documents. Returns results ordered by relevance.
+// @Description
+// @Description  Query supports web search syntax: `"quoted phrase"`, `or`, `-excluded`.
+// @Description  Snippets are HTML escaped, matched words are wrapped in `<mark>` tags.
+// @Tags         Search
+// @Produce      json
+// @Param        q     query string true  "Search query."
+// @Param        types query string false "Comma separated types of results: project, user, team, document. All types by default."
+// @Param        limit query int    false "Maximum number of results, from 1 to 100. Default is 50."
+// @Success      200  {array}   domain.SearchResult
+// @Failure      400  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/search [get]
+func (h *searchHandler) search(w http.ResponseWriter, r *http.Request) {
+	req := domain.SearchRequest{
+		Query: r.URL.Query().Get("q"),
+		Limit: domain.DefaultListLimit,
+	}
+
+	for _, t := range httphelp.QueryStrings("types", r) {
+		req.Types = append(req.Types, domain.SearchResultType(t))
+	}
+
+	limit, err := httphelp.QueryInt32("limit", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+	if limit != nil {
+		req.Limit = int(*limit)
+	}
+
+	response, err := h.searchService.Search(r.Context(), &req)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+)
+
+// sendSlugError redirects to the same route with the current slug if an object was requested by its former slug,
+// other errors are sent as usual.
+func sendSlugError(err error, w http.ResponseWriter, r *http.Request) {
+	var moved *domain.SlugMovedError
+	if !errors.As(err, &moved) {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	http.Redirect(w, r, slugURL(r, moved.Slug), http.StatusMovedPermanently)
+}
+
+// slugURL returns URL of the matched route with the slug parameter replaced, query is kept.
+func slugURL(r *http.Request, slug string) string {
+	rctx := chi.RouteContext(r.Context())
+
+	path := rctx.RoutePattern()
+	for i, key := range rctx.URLParams.Keys {
+		value := rctx.URLParams.Values[i]
+		if key == "slug" {
+			value = slug
+		}
+		path = strings.Replace(path, "{"+key+"}", url.PathEscape(value), 1)
+	}
+
+	if r.URL.RawQuery != "" {
+		path += "?" + r.URL.RawQuery
+	}
+
+	return path
+}
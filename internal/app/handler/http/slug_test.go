@@ -0,0 +1,28 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/domain"
+)
+
+func TestSendSlugError(t *testing.T) {
+	r := chi.NewRouter()
+	r.Route(`/api/public/v1`, func(r chi.Router) {
+		r.Get(`/projects/{slug}/image`, func(w http.ResponseWriter, r *http.Request) {
+			sendSlugError(fmt.Errorf("getting image: %w", &domain.SlugMovedError{Slug: "new-shop"}), w, r)
+		})
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/public/v1/projects/shop/image?size=256", nil))
+
+	require.Equal(t, http.StatusMovedPermanently, rec.Code)
+	require.Equal(t, "/api/public/v1/projects/new-shop/image?size=256", rec.Header().Get("Location"))
+}
@@ -1,7 +1,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -16,13 +15,24 @@ import (
 
 type TeamService interface {
 	GetTeam(ctx context.Context, id int32) (*domain.Team, error)
-	GetTeams(ctx context.Context) ([]domain.Team, error)
+	GetTeams(ctx context.Context, params *domain.ListParams, filter *domain.TeamFilter) ([]domain.Team, int, error)
+	GetTeamBySlug(ctx context.Context, slug string) (*domain.Team, error)
 	CreateTeam(ctx context.Context, team *domain.Team) (*domain.Team, error)
 	UpdateTeam(ctx context.Context, team *domain.Team) (*domain.Team, error)
 	SetTeamImage(ctx context.Context, teamID int32, img []byte) error
-	GetTeamImage(ctx context.Context, teamID int32) (*domain.Team, error)
+	GetTeamImage(ctx context.Context, teamID int32, size int) (*domain.Team, *domain.File, error)
 	DisableTeam(ctx context.Context, teamID int32) error
 	EnableTeam(ctx context.Context, teamID int32) error
+
+	GetMembers(ctx context.Context, teamID int32) ([]domain.TeamMember, error)
+	GetMember(ctx context.Context, memberID, teamID int32) (*domain.TeamMember, error)
+	AddMember(ctx context.Context, member *domain.TeamMember) (*domain.TeamMember, error)
+	UpdateMember(ctx context.Context, member *domain.TeamMember) (*domain.TeamMember, error)
+	RemoveMember(ctx context.Context, memberID, teamID int32) error
+
+	GetTeamTranslations(ctx context.Context, teamID int32) ([]domain.Translation, error)
+	SetTeamTranslation(ctx context.Context, teamID int32, translation *domain.Translation) (*domain.Translation, error)
+	DeleteTeamTranslation(ctx context.Context, teamID int32, locale string) error
 }
 
 type teamHandler struct {
@@ -36,6 +46,7 @@ func newTeamHandler(service TeamService) *teamHandler {
 // getTeam godoc
 // @Summary      Get team by identifier
 // @Description  Returns information about single team.
+// @Description  Title and description are in the default language, only the public portfolio API is localized.
 // @Tags         Teams
 // @Produce      json
 // @Param        team_id path int true "Team identifier."
@@ -55,28 +66,73 @@ func (h *teamHandler) getTeam(w http.ResponseWriter, r *http.Request) {
 	httphelp.SendJSON(http.StatusOK, response, w)
 }
 
+// getTeamBySlug godoc
+// @Summary      Get team by slug
+// @Description  Returns information about single team.
+// @Description  Former slugs are redirected to the current one with 301 Moved Permanently.
+// @Description  Title and description are in the default language, only the public portfolio API is localized.
+// @Tags         Teams
+// @Produce      json
+// @Param        slug path string true "Team slug."
+// @Success      200  {object}  domain.Team
+// @Success      301
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/teams/by-slug/{slug} [get]
+func (h *teamHandler) getTeamBySlug(w http.ResponseWriter, r *http.Request) {
+	response, err := h.teamService.GetTeamBySlug(r.Context(), httphelp.ParseParamString("slug", r))
+	if err != nil {
+		sendSlugError(err, w, r)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
 // getTeams godoc
 // @Summary      Get teams
-// @Description  Returns list of teams.
+// @Description  Returns a page of teams.
+// @Description  Total number of teams is returned in `X-Total-Count` header, links to other pages in `Link` header.
+// @Description  Title and description are in the default language, only the public portfolio API is localized.
 // @Tags         Teams
 // @Produce      json
+// @Param        limit    query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset   query int    false "Number of teams to skip."
+// @Param        sort     query string false "Comma separated fields, `-` prefix for descending order: id, title, createdAt."
+// @Param        disabled query bool   false "Whether teams are disabled."
 // @Success      200  {array}  domain.Team
-// @Failure      404  {object}  Error
+// @Failure      400  {object}  Error
 // @Failure      500  {object}  Error
 // @Router       /api/v1/teams [get]
 func (h *teamHandler) getTeams(w http.ResponseWriter, r *http.Request) {
-	response, err := h.teamService.GetTeams(r.Context())
+	params, err := httphelp.ParseListParams(r, "id", "title", "createdAt")
 	if err != nil {
 		httphelp.SendError(err, w)
 		return
 	}
 
+	var filter domain.TeamFilter
+
+	filter.Disabled, err = httphelp.QueryBool("disabled", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, total, err := h.teamService.GetTeams(r.Context(), params, &filter)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
 	httphelp.SendJSON(http.StatusOK, response, w)
 }
 
 // createTeam godoc
 // @Summary      Create team
 // @Description  Creates a new team. Returns an object with information about created team.
+// @Description  Slug is generated from the title if it is not set, Cyrillic letters are transliterated.
 // @Tags         Teams
 // @Accept       json
 // @Produce      json
@@ -104,6 +160,7 @@ func (h *teamHandler) createTeam(w http.ResponseWriter, r *http.Request) {
 // updateTeam godoc
 // @Summary      Update team
 // @Description  Updates a team.
+// @Description  The former slug keeps working, requests by it are redirected to the new one.
 // @Tags         Teams
 // @Accept       json
 // @Produce      json
@@ -178,25 +235,35 @@ func (h *teamHandler) setTeamImage(w http.ResponseWriter, r *http.Request) {
 // getTeamImage godoc
 // @Summary      Get team image content
 // @Description  Returns team image.
+// @Description  Images are stored in several sizes, the largest one (1024px) is returned by default.
 // @Tags         Teams
 // @Produce      octet-stream
 // @Param        team_id path int true "Team identifier."
+// @Param        size query int false "Maximum width and height of the image: 64, 256 or 1024."
 // @Success      200
+// @Failure      400  {object}  Error
 // @Failure      404  {object}  Error
 // @Failure      500  {object}  Error
 // @Router       /api/v1/teams/{team_id}/image [get]
 func (h *teamHandler) getTeamImage(w http.ResponseWriter, r *http.Request) {
 	tid := httphelp.ParseParamInt32("team_id", r)
 
-	response, err := h.teamService.GetTeamImage(r.Context(), tid)
+	size, err := httphelp.QueryImageSize(r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, file, err := h.teamService.GetTeamImage(r.Context(), tid, size)
 	if err != nil {
 		httphelp.SendError(fmt.Errorf("getting team image: %w", err), w)
 		return
 	}
+	defer file.Close()
 
 	fileName := fmt.Sprintf("%s.%s", response.Title, filepath.Ext(response.ImageID))
 
-	http.ServeContent(w, r, fileName, response.UpdatedAt, bytes.NewReader(response.ImageContent))
+	httphelp.ServeFile(w, r, fileName, file)
 }
 
 // disableTeam godoc
@@ -240,3 +307,216 @@ func (h *teamHandler) enableTeam(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusOK)
 }
+
+// getMembers godoc
+// @Summary      Get team members
+// @Description  Returns a list of team members.
+// @Tags         Teams
+// @Produce      json
+// @Param        team_id path int true "Team identifier."
+// @Success      200  {array}   domain.TeamMember
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/teams/{team_id}/members [get]
+func (h *teamHandler) getMembers(w http.ResponseWriter, r *http.Request) {
+	teamID := httphelp.ParseParamInt32("team_id", r)
+
+	response, err := h.teamService.GetMembers(r.Context(), teamID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getMember godoc
+// @Summary      Get team member
+// @Description  Returns information about team member.
+// @Tags         Teams
+// @Produce      json
+// @Param        team_id path int true "Team identifier."
+// @Param        user_id path int true "Member identifier."
+// @Success      200  {object}  domain.TeamMember
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/teams/{team_id}/members/{user_id} [get]
+func (h *teamHandler) getMember(w http.ResponseWriter, r *http.Request) {
+	teamID := httphelp.ParseParamInt32("team_id", r)
+	userID := httphelp.ParseParamInt32("user_id", r)
+
+	response, err := h.teamService.GetMember(r.Context(), userID, teamID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// addMember godoc
+// @Summary      Add member to team
+// @Description  Adds user to team members list.
+// @Description
+// @Description  On success returns information about added member.
+// @Tags         Teams
+// @Produce      json
+// @Param        team_id path int true "Team identifier."
+// @Param        request body dto.AddTeamMemberRequest true "Request body."
+// @Success      200  {object}  domain.TeamMember
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      409  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/teams/{team_id}/members [post]
+func (h *teamHandler) addMember(w http.ResponseWriter, r *http.Request) {
+	teamID := httphelp.ParseParamInt32("team_id", r)
+
+	var req dto.AddTeamMemberRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.teamService.AddMember(r.Context(), req.ToDomain(teamID))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// updateMember godoc
+// @Summary      Update team member
+// @Description  Updates member role and position.
+// @Description
+// @Description  On success returns information about updated member.
+// @Tags         Teams
+// @Produce      json
+// @Param        team_id path int true "Team identifier."
+// @Param        user_id path int true "Member identifier."
+// @Param        request body dto.UpdateTeamMemberRequest true "Request body."
+// @Success      200  {object}  domain.TeamMember
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/teams/{team_id}/members/{user_id} [put]
+func (h *teamHandler) updateMember(w http.ResponseWriter, r *http.Request) {
+	teamID := httphelp.ParseParamInt32("team_id", r)
+	userID := httphelp.ParseParamInt32("user_id", r)
+
+	var req dto.UpdateTeamMemberRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.teamService.UpdateMember(r.Context(), req.ToDomain(teamID, userID))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// removeMember godoc
+// @Summary      Remove team member
+// @Description  Deletes the user from team members list.
+// @Tags         Teams
+// @Param        team_id path int true "Team identifier."
+// @Param        user_id path int true "Member identifier."
+// @Success      200
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/teams/{team_id}/members/{user_id} [delete]
+func (h *teamHandler) removeMember(w http.ResponseWriter, r *http.Request) {
+	teamID := httphelp.ParseParamInt32("team_id", r)
+	userID := httphelp.ParseParamInt32("user_id", r)
+
+	err := h.teamService.RemoveMember(r.Context(), userID, teamID)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// getTeamTranslations godoc
+// @Summary      Get team translations
+// @Description  Returns translations of the team title and description to languages other than the default one.
+// @Tags         Teams
+// @Produce      json
+// @Param        team_id path int true "Team identifier."
+// @Success      200  {array}   domain.Translation
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/teams/{team_id}/translations [get]
+func (h *teamHandler) getTeamTranslations(w http.ResponseWriter, r *http.Request) {
+	tid := httphelp.ParseParamInt32("team_id", r)
+
+	response, err := h.teamService.GetTeamTranslations(r.Context(), tid)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// setTeamTranslation godoc
+// @Summary      Set team translation
+// @Description  Creates or replaces translation of the team to the locale.
+// @Description  The public portfolio API returns it to clients preferring the locale.
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Param        team_id path int true "Team identifier."
+// @Param        locale path string true "Locale, one of the supported ones except the default."
+// @Param        request body dto.SetTranslationRequest true "Request body."
+// @Success      200  {object}  domain.Translation
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/teams/{team_id}/translations/{locale} [put]
+func (h *teamHandler) setTeamTranslation(w http.ResponseWriter, r *http.Request) {
+	tid := httphelp.ParseParamInt32("team_id", r)
+
+	var req dto.SetTranslationRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.teamService.SetTeamTranslation(r.Context(), tid, req.ToDomain(httphelp.ParseParamString("locale", r)))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// deleteTeamTranslation godoc
+// @Summary      Delete team translation
+// @Description  Deletes translation of the team to the locale.
+// @Tags         Teams
+// @Param        team_id path int true "Team identifier."
+// @Param        locale path string true "Locale."
+// @Success      200
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/teams/{team_id}/translations/{locale} [delete]
+func (h *teamHandler) deleteTeamTranslation(w http.ResponseWriter, r *http.Request) {
+	tid := httphelp.ParseParamInt32("team_id", r)
+
+	err := h.teamService.DeleteTeamTranslation(r.Context(), tid, httphelp.ParseParamString("locale", r))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,232 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/handler/http/dto"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+)
+
+type TechnologyService interface {
+	GetTechnologies(ctx context.Context, filter *domain.TechnologyFilter) ([]domain.Technology, error)
+	GetTechnology(ctx context.Context, id int32) (*domain.Technology, error)
+	CreateTechnology(ctx context.Context, technology *domain.Technology) (*domain.Technology, error)
+	UpdateTechnology(ctx context.Context, technology *domain.Technology) (*domain.Technology, error)
+	DeleteTechnology(ctx context.Context, id int32) error
+	GetTechnologyProjects(ctx context.Context, id int32, params *domain.ListParams) ([]domain.Project, int, error)
+	GetPopularTechnologies(ctx context.Context, limit int) ([]domain.TechnologyUsage, error)
+}
+
+type technologyHandler struct {
+	technologyService TechnologyService
+}
+
+func newTechnologyHandler(srv TechnologyService) *technologyHandler {
+	return &technologyHandler{srv}
+}
+
+// getTechnologies godoc
+// @Summary      Get technologies
+// @Description  Returns the catalog of technologies projects can use, ordered by name.
+// @Tags         Technologies
+// @Produce      json
+// @Param        category query string false "Technology category: language, framework, library, database, platform, tool or other."
+// @Success      200  {array}   domain.Technology
+// @Failure      400  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/technologies [get]
+func (h *technologyHandler) getTechnologies(w http.ResponseWriter, r *http.Request) {
+	var filter domain.TechnologyFilter
+
+	if param := r.URL.Query().Get("category"); param != "" {
+		category := domain.TechnologyCategory(param)
+		if !category.IsValid() {
+			httphelp.SendError(apperr.NewInvalidRequest("Unknown technology category.", "category"), w)
+			return
+		}
+		filter.Category = &category
+	}
+
+	response, err := h.technologyService.GetTechnologies(r.Context(), &filter)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getTechnology godoc
+// @Summary      Get technology
+// @Tags         Technologies
+// @Produce      json
+// @Param        technology_id path int true "Technology identifier."
+// @Success      200  {object}  domain.Technology
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/technologies/{technology_id} [get]
+func (h *technologyHandler) getTechnology(w http.ResponseWriter, r *http.Request) {
+	id := httphelp.ParseParamInt32("technology_id", r)
+
+	response, err := h.technologyService.GetTechnology(r.Context(), id)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// createTechnology godoc
+// @Summary      Create technology
+// @Description  Adds a technology to the catalog. Name and aliases must not be used by other technologies, case is ignored.
+// @Tags         Technologies
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.TechnologyRequest true "Request body."
+// @Success      201  {object}  domain.Technology
+// @Failure      400  {object}  Error
+// @Failure      409  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/technologies [post]
+func (h *technologyHandler) createTechnology(w http.ResponseWriter, r *http.Request) {
+	var req dto.TechnologyRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.technologyService.CreateTechnology(r.Context(), req.ToDomain(0))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusCreated, response, w)
+}
+
+// updateTechnology godoc
+// @Summary      Update technology
+// @Description  Updates the catalog entry. A new name is applied to all projects using the technology.
+// @Tags         Technologies
+// @Accept       json
+// @Produce      json
+// @Param        technology_id path int true "Technology identifier."
+// @Param        request body dto.TechnologyRequest true "Request body."
+// @Success      200  {object}  domain.Technology
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      409  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/technologies/{technology_id} [put]
+func (h *technologyHandler) updateTechnology(w http.ResponseWriter, r *http.Request) {
+	id := httphelp.ParseParamInt32("technology_id", r)
+
+	var req dto.TechnologyRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.technologyService.UpdateTechnology(r.Context(), req.ToDomain(id))
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// deleteTechnology godoc
+// @Summary      Delete technology
+// @Description  Removes the technology from the catalog and from all projects using it.
+// @Tags         Technologies
+// @Param        technology_id path int true "Technology identifier."
+// @Success      204
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/technologies/{technology_id} [delete]
+func (h *technologyHandler) deleteTechnology(w http.ResponseWriter, r *http.Request) {
+	id := httphelp.ParseParamInt32("technology_id", r)
+
+	err := h.technologyService.DeleteTechnology(r.Context(), id)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getTechnologyProjects godoc
+// @Summary      Get projects using technology
+// @Description  Returns a page of projects using the technology, projects in the trash are not included.
+// @Description  Total number of projects is returned in `X-Total-Count` header, links to other pages in `Link` header.
+// @Tags         Technologies
+// @Produce      json
+// @Param        technology_id path  int    true  "Technology identifier."
+// @Param        limit         query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset        query int    false "Number of projects to skip."
+// @Param        sort          query string false "Comma separated fields, `-` prefix for descending order: id, title, createdAt, startedAt, endedAt."
+// @Success      200  {array}   domain.Project
+// @Failure      400  {object}  Error
+// @Failure      404  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/technologies/{technology_id}/projects [get]
+func (h *technologyHandler) getTechnologyProjects(w http.ResponseWriter, r *http.Request) {
+	id := httphelp.ParseParamInt32("technology_id", r)
+
+	params, err := httphelp.ParseListParams(r, "id", "title", "createdAt", "startedAt", "endedAt")
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, total, err := h.technologyService.GetTechnologyProjects(r.Context(), id, params)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getPopularTechnologies godoc
+// @Summary      Get most used technologies
+// @Description  Returns technologies with the number of projects using them, most used first.
+// @Description  Projects in the trash are not counted, unused technologies are not returned.
+// @Tags         Technologies
+// @Produce      json
+// @Param        limit query int false "Maximum number of technologies. All used technologies are returned by default."
+// @Success      200  {array}   domain.TechnologyUsage
+// @Failure      400  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/technologies/popular [get]
+func (h *technologyHandler) getPopularTechnologies(w http.ResponseWriter, r *http.Request) {
+	limit, err := httphelp.QueryInt32("limit", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	var n int
+	if limit != nil {
+		if *limit < 1 {
+			httphelp.SendError(apperr.NewInvalidRequest("Parameter limit must be positive.", "limit"), w)
+			return
+		}
+		n = int(*limit)
+	}
+
+	response, err := h.technologyService.GetPopularTechnologies(r.Context(), n)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
@@ -0,0 +1,200 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/handler/http/httphelp"
+)
+
+// twoFactorRoutes is the prefix of routes available, besides sessionRoutes, until required two-factor authentication is enabled.
+const twoFactorRoutes = `/api/v1/auth/2fa`
+
+//go:generate mockgen -source=two_factor.go -destination=./mocks/two_factor.go -package=mocks
+type TwoFactorService interface {
+	GetStatus(ctx context.Context) (*domain.TwoFactorStatus, error)
+	Setup(ctx context.Context) (*domain.TwoFactorSetup, error)
+	Enable(ctx context.Context, req *domain.TwoFactorCodeRequest) (*domain.RecoveryCodes, error)
+	Disable(ctx context.Context, req *domain.TwoFactorCodeRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, req *domain.TwoFactorCodeRequest) (*domain.RecoveryCodes, error)
+	SetupRequired(ctx context.Context, user *domain.User) (bool, error)
+	CompleteSignIn(ctx context.Context, req *domain.TwoFactorSignInRequest) (*domain.SignInResponse, error)
+}
+
+type twoFactorHandler struct {
+	twoFactorService TwoFactorService
+}
+
+func newTwoFactorHandler(srv TwoFactorService) *twoFactorHandler {
+	return &twoFactorHandler{srv}
+}
+
+// completeSignIn godoc
+// @Summary      Complete sign in with the second factor
+// @Description  Finishes sign in started with `/api/v1/auth/sign-in` when it returned `twoFactorRequired`.
+// @Description  Accepts either a code from authenticator app or one of the recovery codes.
+// @Description
+// @Description  After several failed attempts the token becomes invalid and sign in must be started again.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        request body domain.TwoFactorSignInRequest true "Request body."
+// @Success      200  {object}  domain.SignInResponse
+// @Failure      400  {object}  Error
+// @Failure      401  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/sign-in/2fa [post]
+func (h *twoFactorHandler) completeSignIn(w http.ResponseWriter, r *http.Request) {
+	var req domain.TwoFactorSignInRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+	req.IP = clientIP(r)
+	req.UserAgent = r.UserAgent()
+
+	response, err := h.twoFactorService.CompleteSignIn(r.Context(), &req)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	setSessionCookie(w, response.SessionID)
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// getStatus godoc
+// @Summary      Get two-factor authentication status
+// @Description  Returns whether two-factor authentication is enabled or required for the authorized user.
+// @Tags         Auth
+// @Produce      json
+// @Success      200  {object}  domain.TwoFactorStatus
+// @Failure      401  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/2fa [get]
+func (h *twoFactorHandler) getStatus(w http.ResponseWriter, r *http.Request) {
+	response, err := h.twoFactorService.GetStatus(r.Context())
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// setup godoc
+// @Security     CSRF
+// @Summary      Set up two-factor authentication
+// @Description  Generates a new TOTP secret. Two-factor authentication is enabled only after confirming
+// @Description  a code from authenticator app with `/api/v1/auth/2fa/enable`.
+// @Tags         Auth
+// @Produce      json
+// @Success      200  {object}  domain.TwoFactorSetup
+// @Failure      400  {object}  Error
+// @Failure      401  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/2fa/setup [post]
+func (h *twoFactorHandler) setup(w http.ResponseWriter, r *http.Request) {
+	response, err := h.twoFactorService.Setup(r.Context())
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// enable godoc
+// @Security     CSRF
+// @Summary      Enable two-factor authentication
+// @Description  Confirms the setup with a code from authenticator app and returns recovery codes.
+// @Description  Recovery codes are shown only once.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        request body domain.TwoFactorCodeRequest true "Request body."
+// @Success      200  {object}  domain.RecoveryCodes
+// @Failure      400  {object}  Error
+// @Failure      401  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/2fa/enable [post]
+func (h *twoFactorHandler) enable(w http.ResponseWriter, r *http.Request) {
+	var req domain.TwoFactorCodeRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.twoFactorService.Enable(r.Context(), &req)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
+
+// disable godoc
+// @Security     CSRF
+// @Summary      Disable two-factor authentication
+// @Description  Disables two-factor authentication, requires a code from authenticator app or a recovery code.
+// @Description  Not available if two-factor authentication is required for the user role.
+// @Description  After 5 invalid codes the following ones are rejected with `429 Too Many Requests` for 15 minutes.
+// @Tags         Auth
+// @Accept       json
+// @Param        request body domain.TwoFactorCodeRequest true "Request body."
+// @Success      200
+// @Failure      400  {object}  Error
+// @Failure      401  {object}  Error
+// @Failure      403  {object}  Error
+// @Failure      429  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/2fa/disable [post]
+func (h *twoFactorHandler) disable(w http.ResponseWriter, r *http.Request) {
+	var req domain.TwoFactorCodeRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	err := h.twoFactorService.Disable(r.Context(), &req)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// regenerateRecoveryCodes godoc
+// @Security     CSRF
+// @Summary      Regenerate recovery codes
+// @Description  Replaces all recovery codes with new ones, requires a code from authenticator app.
+// @Description  After 5 invalid codes the following ones are rejected with `429 Too Many Requests` for 15 minutes.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        request body domain.TwoFactorCodeRequest true "Request body."
+// @Success      200  {object}  domain.RecoveryCodes
+// @Failure      400  {object}  Error
+// @Failure      401  {object}  Error
+// @Failure      429  {object}  Error
+// @Failure      500  {object}  Error
+// @Router       /api/v1/auth/2fa/recovery-codes [post]
+func (h *twoFactorHandler) regenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	var req domain.TwoFactorCodeRequest
+	if err := httphelp.ReadJSON(&req, r); err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, err := h.twoFactorService.RegenerateRecoveryCodes(r.Context(), &req)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SendJSON(http.StatusOK, response, w)
+}
@@ -1,7 +1,6 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -17,13 +16,13 @@ import (
 //go:generate mockgen -source=user.go -destination=./mocks/user.go -package=mocks
 type UserService interface {
 	GetUser(ctx context.Context, id int32) (*domain.User, error)
-	GetUsers(ctx context.Context) ([]domain.User, error)
+	GetUsers(ctx context.Context, params *domain.ListParams, filter *domain.UserFilter) ([]domain.User, int, error)
 	CreateUser(ctx context.Context, user *domain.User) (*domain.User, error)
 	UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error)
 	RemoveUser(ctx context.Context, id int32) error
 
 	SetUserImage(ctx context.Context, userID int32, img []byte) error
-	GetUserImage(ctx context.Context, userID int32) (*domain.User, error)
+	GetUserImage(ctx context.Context, userID int32, size int) (*domain.User, *domain.File, error)
 }
 
 type userHandler struct {
@@ -58,20 +57,51 @@ func (h *userHandler) getUser(w http.ResponseWriter, r *http.Request) {
 
 // getUsers godoc
 // @Summary      Get users
-// @Description  Returns a list of users.
+// @Description  Returns a page of users.
+// @Description  Total number of users is returned in `X-Total-Count` header, links to other pages in `Link` header.
 // @Tags         Users
 // @Produce      json
+// @Param        limit    query int    false "Page size, from 1 to 100. Default is 50."
+// @Param        offset   query int    false "Number of users to skip."
+// @Param        sort     query string false "Comma separated fields, `-` prefix for descending order: id, name, surname, username, createdAt."
+// @Param        role     query int    false "Role of users."
+// @Param        disabled query bool   false "Whether users are disabled."
 // @Success      200  {array}  domain.User
 // @Failure      400  {object}  Error
 // @Failure      500  {object}  Error
 // @Router       /api/v1/users [get]
 func (h *userHandler) getUsers(w http.ResponseWriter, r *http.Request) {
-	response, err := h.userService.GetUsers(r.Context())
+	params, err := httphelp.ParseListParams(r, "id", "name", "surname", "username", "createdAt")
 	if err != nil {
 		httphelp.SendError(err, w)
 		return
 	}
 
+	var filter domain.UserFilter
+
+	role, err := httphelp.QueryInt32("role", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+	if role != nil {
+		userRole := domain.UserRole(*role)
+		filter.Role = &userRole
+	}
+
+	filter.Disabled, err = httphelp.QueryBool("disabled", r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, total, err := h.userService.GetUsers(r.Context(), params, &filter)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	httphelp.SetListHeaders(w, r, params, total)
 	httphelp.SendJSON(http.StatusOK, response, w)
 }
 
@@ -202,23 +232,33 @@ func (h *userHandler) setUserImage(w http.ResponseWriter, r *http.Request) {
 // getUserImage godoc
 // @Summary      Get user image content
 // @Description  Returns user image.
+// @Description  Images are stored in several sizes, the largest one (1024px) is returned by default.
 // @Tags         Users
 // @Produce      octet-stream
 // @Param        user_id path int true "User identifier."
+// @Param        size query int false "Maximum width and height of the image: 64, 256 or 1024."
 // @Success      200
+// @Failure      400  {object}  Error
 // @Failure      404  {object}  Error
 // @Failure      500  {object}  Error
 // @Router       /api/v1/users/{user_id}/image [get]
 func (h *userHandler) getUserImage(w http.ResponseWriter, r *http.Request) {
 	tid := httphelp.ParseParamInt32("user_id", r)
 
-	response, err := h.userService.GetUserImage(r.Context(), tid)
+	size, err := httphelp.QueryImageSize(r)
+	if err != nil {
+		httphelp.SendError(err, w)
+		return
+	}
+
+	response, file, err := h.userService.GetUserImage(r.Context(), tid, size)
 	if err != nil {
 		httphelp.SendError(fmt.Errorf("getting team image: %w", err), w)
 		return
 	}
+	defer file.Close()
 
 	fileName := fmt.Sprintf("%s.%s", response.Username, filepath.Ext(response.ImageID))
 
-	http.ServeContent(w, r, fileName, response.UpdatedAt, bytes.NewReader(response.ImageContent))
+	httphelp.ServeFile(w, r, fileName, file)
 }
@@ -4,4 +4,5 @@ import "errors"
 
 var (
 	ErrObjectNotFound = errors.New("object not found")
+	ErrDuplicate      = errors.New("object already exists")
 )
@@ -3,10 +3,12 @@ package filesystem
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
 
+	"web-studio-backend/internal/app/domain"
 	"web-studio-backend/internal/app/infrastructure/repository"
 )
 
@@ -25,11 +27,7 @@ func New(dirPath string) (*FileSystem, error) {
 	return &FileSystem{dir: dirPath}, nil
 }
 
-func (fs *FileSystem) Save(_ context.Context, data []byte, fileName string) error {
-	if len(data) == 0 {
-		return fmt.Errorf("data is empty")
-	}
-
+func (fs *FileSystem) Save(_ context.Context, r io.Reader, _ int64, fileName string) error {
 	dir := filepath.Dir(fileName)
 	fp := filepath.Join(fs.dir, dir)
 	err := os.MkdirAll(fp, os.ModePerm)
@@ -37,34 +35,47 @@ func (fs *FileSystem) Save(_ context.Context, data []byte, fileName string) erro
 		slog.Error("error creating all dir", slog.String("error", err.Error()), slog.String("path", fp))
 	}
 
-	file, err := os.Create(filepath.Join(fs.dir, fileName))
+	filePath := filepath.Join(fs.dir, fileName)
+	file, err := os.Create(filePath)
 	if err != nil {
 		return fmt.Errorf("creating file: %w", err)
 	}
 	defer file.Close()
 
-	_, err = file.Write(data)
+	n, err := io.Copy(file, r)
+	if err == nil && n == 0 {
+		err = fmt.Errorf("data is empty")
+	}
 	if err != nil {
+		_ = os.Remove(filePath) // Don't leave partially written files
 		return fmt.Errorf("writing data to file: %w", err)
 	}
 
 	return nil
 }
 
-func (fs *FileSystem) Read(_ context.Context, fileName string) ([]byte, error) {
-	filePath := filepath.Join(fs.dir, fileName)
-
-	_, err := os.Stat(filePath)
-	if os.IsNotExist(err) {
-		return nil, repository.ErrObjectNotFound
+func (fs *FileSystem) Open(_ context.Context, fileName string) (*domain.File, error) {
+	file, err := os.Open(filepath.Join(fs.dir, fileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("opening file: %w", err)
 	}
 
-	data, err := os.ReadFile(filePath)
+	fi, err := file.Stat()
 	if err != nil {
-		return nil, fmt.Errorf("reading file: %w", err)
+		file.Close()
+		return nil, fmt.Errorf("getting file info: %w", err)
 	}
 
-	return data, nil
+	return &domain.File{
+		ReadSeekCloser: file,
+		Size:           fi.Size(),
+		ModTime:        fi.ModTime(),
+		// Stored files are never rewritten in place, so modification time and size identify the content.
+		ETag: fmt.Sprintf(`"%x-%x"`, fi.ModTime().UnixNano(), fi.Size()),
+	}, nil
 }
 
 func (fs *FileSystem) Delete(_ context.Context, fileName string) error {
@@ -82,3 +93,25 @@ func (fs *FileSystem) Delete(_ context.Context, fileName string) error {
 
 	return nil
 }
+
+// Walk calls fn for every stored file with its name relative to the storage directory.
+func (fs *FileSystem) Walk(ctx context.Context, fn func(fileName string) error) error {
+	return filepath.WalkDir(fs.dir, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		fileName, err := filepath.Rel(fs.dir, p)
+		if err != nil {
+			return err
+		}
+
+		return fn(fileName)
+	})
+}
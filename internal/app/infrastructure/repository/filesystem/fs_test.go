@@ -2,11 +2,15 @@ package filesystem
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/infrastructure/repository"
 )
 
 func TestNew(t *testing.T) {
@@ -51,7 +55,7 @@ func TestNew(t *testing.T) {
 	}
 }
 
-func TestFileSystem_Read(t *testing.T) {
+func TestFileSystem_Open(t *testing.T) {
 	tempDir := "temp_dir"
 	tempFile := "temp_file.txt"
 	fileContent := "hello, world!\n"
@@ -69,10 +73,20 @@ func TestFileSystem_Read(t *testing.T) {
 	fs, err := New(tempDir)
 	require.NoError(t, err)
 
-	content, err := fs.Read(context.Background(), tempFile)
+	file, err := fs.Open(context.Background(), tempFile)
+	require.NoError(t, err)
+	defer file.Close()
+
+	require.Equal(t, int64(len(fileContent)), file.Size)
+	require.NotEmpty(t, file.ETag)
+
+	content, err := io.ReadAll(file)
 	require.NoError(t, err)
 
 	require.Equal(t, fileContent, string(content))
+
+	_, err = fs.Open(context.Background(), "missing.txt")
+	require.ErrorIs(t, err, repository.ErrObjectNotFound)
 }
 
 func TestFileSystem_Save(t *testing.T) {
@@ -87,13 +101,19 @@ func TestFileSystem_Save(t *testing.T) {
 
 	fileName := "test.txt"
 
-	err = fs.Save(context.Background(), []byte(fileContent), fileName)
+	err = fs.Save(context.Background(), strings.NewReader(fileContent), -1, fileName)
 	require.NoError(t, err)
 
-	content, err := fs.Read(context.Background(), fileName)
+	content, err := os.ReadFile(filepath.Join(tempDir, fileName))
 	require.NoError(t, err)
 
 	require.Equal(t, fileContent, string(content))
+
+	err = fs.Save(context.Background(), strings.NewReader(""), -1, "empty.txt")
+	require.Error(t, err)
+
+	_, err = os.Stat(filepath.Join(tempDir, "empty.txt"))
+	require.ErrorIs(t, err, os.ErrNotExist)
 }
 
 func TestFileSystem_Delete(t *testing.T) {
@@ -107,7 +127,7 @@ func TestFileSystem_Delete(t *testing.T) {
 
 	fileName := "test.txt"
 
-	err = fs.Save(context.Background(), []byte("123"), fileName)
+	err = fs.Save(context.Background(), strings.NewReader("123"), 3, fileName)
 	require.NoError(t, err)
 
 	err = fs.Delete(context.Background(), fileName)
@@ -117,3 +137,27 @@ func TestFileSystem_Delete(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorIs(t, err, os.ErrNotExist)
 }
+
+func TestFileSystem_Walk(t *testing.T) {
+	tempDir := "temp_dir"
+
+	_ = os.Mkdir(tempDir, 0744)
+	defer os.RemoveAll(tempDir)
+
+	fs, err := New(tempDir)
+	require.NoError(t, err)
+
+	err = fs.Save(context.Background(), strings.NewReader("123"), 3, filepath.Join("users", "1.png"))
+	require.NoError(t, err)
+	err = fs.Save(context.Background(), strings.NewReader("456"), 3, "test.txt")
+	require.NoError(t, err)
+
+	var fileNames []string
+	err = fs.Walk(context.Background(), func(fileName string) error {
+		fileNames = append(fileNames, fileName)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, []string{filepath.Join("users", "1.png"), "test.txt"}, fileNames)
+}
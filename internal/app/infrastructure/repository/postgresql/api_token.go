@@ -0,0 +1,162 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+type APITokenRepository struct {
+	pool Driver
+}
+
+func NewAPITokenRepository(pool Driver) *APITokenRepository {
+	return &APITokenRepository{pool}
+}
+
+func (r *APITokenRepository) CreateAPIToken(ctx context.Context, token *domain.APIToken) (int32, error) {
+	scopes := make([]string, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	var id int32
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO api_tokens(user_id, name, token_hash, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`,
+		token.UserID,
+		token.Name,
+		token.Hash,
+		scopes,
+		token.ExpiresAt,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("inserting api token: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *APITokenRepository) GetAPIToken(ctx context.Context, id int32) (*domain.APIToken, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, user_id, name, scopes, created_at, expires_at, last_used_at
+		FROM api_tokens
+		WHERE id=$1`, id)
+
+	token, err := scanAPIToken(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning api token: %w", err)
+	}
+
+	return token, nil
+}
+
+// GetAPITokenByHash returns token which is not expired.
+func (r *APITokenRepository) GetAPITokenByHash(ctx context.Context, hash string) (*domain.APIToken, error) {
+	row := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, user_id, name, scopes, created_at, expires_at, last_used_at
+		FROM api_tokens
+		WHERE token_hash=$1 AND (expires_at IS NULL OR expires_at > now())`, hash)
+
+	token, err := scanAPIToken(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning api token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (r *APITokenRepository) GetUserAPITokens(ctx context.Context, userID int32) ([]domain.APIToken, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, user_id, name, scopes, created_at, expires_at, last_used_at
+		FROM api_tokens
+		WHERE user_id=$1
+		ORDER BY id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting api tokens: %w", err)
+	}
+	defer rows.Close()
+
+	tokens := make([]domain.APIToken, 0)
+	for rows.Next() {
+		token, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning api token: %w", err)
+		}
+		tokens = append(tokens, *token)
+	}
+
+	return tokens, nil
+}
+
+func (r *APITokenRepository) TouchAPIToken(ctx context.Context, id int32, at time.Time) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `UPDATE api_tokens SET last_used_at=$2 WHERE id=$1`, id, at)
+	if err != nil {
+		return fmt.Errorf("updating api token last used: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteAPIToken deletes token of the given user.
+func (r *APITokenRepository) DeleteAPIToken(ctx context.Context, id, userID int32) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM api_tokens WHERE id=$1 AND user_id=$2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("deleting api token: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// DeleteUserAPITokens deletes all tokens of the given user.
+func (r *APITokenRepository) DeleteUserAPITokens(ctx context.Context, userID int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM api_tokens WHERE user_id=$1`, userID)
+	if err != nil {
+		return fmt.Errorf("deleting user api tokens: %w", err)
+	}
+
+	return nil
+}
+
+func scanAPIToken(row pgx.Row) (*domain.APIToken, error) {
+	var (
+		token  domain.APIToken
+		scopes []string
+	)
+
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&scopes,
+		&token.CreatedAt,
+		&token.ExpiresAt,
+		&token.LastUsedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	token.Scopes = make([]domain.APITokenScope, 0, len(scopes))
+	for _, scope := range scopes {
+		token.Scopes = append(token.Scopes, domain.APITokenScope(scope))
+	}
+
+	return &token, nil
+}
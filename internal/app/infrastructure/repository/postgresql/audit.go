@@ -0,0 +1,101 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"web-studio-backend/internal/app/domain"
+)
+
+type AuditRepository struct {
+	pool Driver
+}
+
+func NewAuditRepository(pool Driver) *AuditRepository {
+	return &AuditRepository{pool}
+}
+
+func (r *AuditRepository) CreateRecord(ctx context.Context, rec *domain.AuditRecord) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO audit_log(actor_id, action, entity_type, entity_id, diff)
+		VALUES($1, $2, $3, $4, $5)`,
+		rec.ActorID,
+		rec.Action,
+		rec.EntityType,
+		rec.EntityID,
+		rec.Diff,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting audit record: %w", err)
+	}
+
+	return nil
+}
+
+var auditSortColumns = map[string]string{
+	"createdAt": "created_at",
+}
+
+// GetRecords returns the requested page of audit records and total number of records matching the filter.
+// Records are ordered from newest to oldest by default.
+func (r *AuditRepository) GetRecords(ctx context.Context, params *domain.ListParams, filter *domain.AuditFilter) ([]domain.AuditRecord, int, error) {
+	q := newListQuery()
+	if filter != nil {
+		if filter.ActorID != nil {
+			q.where("actor_id = ?", *filter.ActorID)
+		}
+		if filter.EntityType != nil {
+			q.where("entity_type = ?", *filter.EntityType)
+		}
+		if filter.EntityID != nil {
+			q.where("entity_id = ?", *filter.EntityID)
+		}
+		if filter.From != nil {
+			q.where("created_at >= ?", *filter.From)
+		}
+		if filter.To != nil {
+			q.where("created_at < ?", *filter.To)
+		}
+	}
+	where := q.whereSQL()
+
+	var total int
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT count(*) FROM audit_log `+where, q.args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting audit records: %w", err)
+	}
+
+	page := q.pageSQL(params, auditSortColumns, "created_at DESC, id DESC")
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, actor_id, action, entity_type, entity_id, diff, created_at
+		FROM audit_log
+		`+where+`
+		`+page, q.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("selecting audit records: %w", err)
+	}
+	defer rows.Close()
+
+	var records []domain.AuditRecord
+	for rows.Next() {
+		var rec domain.AuditRecord
+
+		err = rows.Scan(
+			&rec.ID,
+			&rec.ActorID,
+			&rec.Action,
+			&rec.EntityType,
+			&rec.EntityID,
+			&rec.Diff,
+			&rec.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("scanning audit record: %w", err)
+		}
+
+		records = append(records, rec)
+	}
+
+	return records, total, nil
+}
@@ -0,0 +1,390 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+type BoardRepository struct {
+	pool Driver
+}
+
+func NewBoardRepository(pool Driver) *BoardRepository {
+	return &BoardRepository{pool}
+}
+
+func (r *BoardRepository) GetBoard(ctx context.Context, id int32) (*domain.Board, error) {
+	var board domain.Board
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, title, project_id
+		FROM boards
+		WHERE id=$1`, id).Scan(
+		&board.ID,
+		&board.Title,
+		&board.ProjectID,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning board: %w", err)
+	}
+
+	return &board, nil
+}
+
+func (r *BoardRepository) GetProjectBoards(ctx context.Context, projectID int32) ([]domain.Board, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, title, project_id
+		FROM boards
+		WHERE project_id=$1
+		ORDER BY id`, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting project %d boards: %w", projectID, err)
+	}
+	defer rows.Close()
+
+	var boards []domain.Board
+	for rows.Next() {
+		var board domain.Board
+
+		err = rows.Scan(
+			&board.ID,
+			&board.Title,
+			&board.ProjectID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning board: %w", err)
+		}
+
+		boards = append(boards, board)
+	}
+
+	return boards, nil
+}
+
+func (r *BoardRepository) CreateBoard(ctx context.Context, board *domain.Board) (int32, error) {
+	var id int32
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO boards(title, project_id)
+		VALUES ($1, $2)
+		RETURNING id`,
+		board.Title,
+		board.ProjectID,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("inserting board: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *BoardRepository) UpdateBoard(ctx context.Context, board *domain.Board) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE boards
+		SET title=$2
+		WHERE id=$1`,
+		board.ID,
+		board.Title,
+	)
+	if err != nil {
+		return fmt.Errorf("updating board: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BoardRepository) DeleteBoard(ctx context.Context, id int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM boards WHERE id=$1`, id)
+	if err != nil {
+		return fmt.Errorf("deleting board: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BoardRepository) GetColumn(ctx context.Context, id int32) (*domain.BoardColumn, error) {
+	var column domain.BoardColumn
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, board_id, title
+		FROM board_columns
+		WHERE id=$1`, id).Scan(
+		&column.ID,
+		&column.BoardID,
+		&column.Title,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning board column: %w", err)
+	}
+
+	return &column, nil
+}
+
+func (r *BoardRepository) GetColumns(ctx context.Context, boardID int32) ([]domain.BoardColumn, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, board_id, title
+		FROM board_columns
+		WHERE board_id=$1
+		ORDER BY id`, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting board %d columns: %w", boardID, err)
+	}
+	defer rows.Close()
+
+	var columns []domain.BoardColumn
+	for rows.Next() {
+		var column domain.BoardColumn
+
+		err = rows.Scan(
+			&column.ID,
+			&column.BoardID,
+			&column.Title,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning board column: %w", err)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+func (r *BoardRepository) CreateColumn(ctx context.Context, column *domain.BoardColumn) (int32, error) {
+	var id int32
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO board_columns(board_id, title)
+		VALUES ($1, $2)
+		RETURNING id`,
+		column.BoardID,
+		column.Title,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("inserting board column: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *BoardRepository) UpdateColumn(ctx context.Context, column *domain.BoardColumn) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE board_columns
+		SET title=$2
+		WHERE id=$1`,
+		column.ID,
+		column.Title,
+	)
+	if err != nil {
+		return fmt.Errorf("updating board column: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BoardRepository) DeleteColumn(ctx context.Context, id int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM board_columns WHERE id=$1`, id)
+	if err != nil {
+		return fmt.Errorf("deleting board column: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BoardRepository) GetTask(ctx context.Context, id int32) (*domain.BoardTask, error) {
+	var task domain.BoardTask
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, board_id, column_id, title, description, status
+		FROM board_tasks
+		WHERE id=$1`, id).Scan(
+		&task.ID,
+		&task.BoardID,
+		&task.ColumnID,
+		&task.Title,
+		&task.Description,
+		&task.Status,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning board task: %w", err)
+	}
+
+	return &task, nil
+}
+
+func (r *BoardRepository) GetTasks(ctx context.Context, boardID int32) ([]domain.BoardTask, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, board_id, column_id, title, description, status
+		FROM board_tasks
+		WHERE board_id=$1
+		ORDER BY id`, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting board %d tasks: %w", boardID, err)
+	}
+	defer rows.Close()
+
+	var tasks []domain.BoardTask
+	for rows.Next() {
+		var task domain.BoardTask
+
+		err = rows.Scan(
+			&task.ID,
+			&task.BoardID,
+			&task.ColumnID,
+			&task.Title,
+			&task.Description,
+			&task.Status,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning board task: %w", err)
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+func (r *BoardRepository) CreateTask(ctx context.Context, task *domain.BoardTask) (int32, error) {
+	var id int32
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO board_tasks(board_id, column_id, title, description, status)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`,
+		task.BoardID,
+		task.ColumnID,
+		task.Title,
+		task.Description,
+		task.Status,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("inserting board task: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *BoardRepository) UpdateTask(ctx context.Context, task *domain.BoardTask) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE board_tasks
+		SET column_id=$2, title=$3, description=$4, status=$5
+		WHERE id=$1`,
+		task.ID,
+		task.ColumnID,
+		task.Title,
+		task.Description,
+		task.Status,
+	)
+	if err != nil {
+		return fmt.Errorf("updating board task: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BoardRepository) DeleteTask(ctx context.Context, id int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM board_tasks WHERE id=$1`, id)
+	if err != nil {
+		return fmt.Errorf("deleting board task: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BoardRepository) GetTaskMembers(ctx context.Context, taskID int32) ([]domain.BoardTaskMember, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT tm.task_id, tm.user_id, u.name, u.surname, u.username
+		FROM board_task_members tm
+			JOIN users u ON u.id=tm.user_id
+		WHERE tm.task_id=$1`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting task %d members: %w", taskID, err)
+	}
+	defer rows.Close()
+
+	var members []domain.BoardTaskMember
+	for rows.Next() {
+		var m domain.BoardTaskMember
+
+		err = rows.Scan(
+			&m.TaskID,
+			&m.UserID,
+			&m.Name,
+			&m.Surname,
+			&m.Username,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning task member: %w", err)
+		}
+
+		members = append(members, m)
+	}
+
+	return members, nil
+}
+
+func (r *BoardRepository) GetTaskMember(ctx context.Context, taskID, userID int32) (*domain.BoardTaskMember, error) {
+	var m domain.BoardTaskMember
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT tm.task_id, tm.user_id, u.name, u.surname, u.username
+		FROM board_task_members tm
+			JOIN users u ON u.id=tm.user_id
+		WHERE tm.task_id=$1 AND tm.user_id=$2`, taskID, userID).Scan(
+		&m.TaskID,
+		&m.UserID,
+		&m.Name,
+		&m.Surname,
+		&m.Username,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning task member: %w", err)
+	}
+
+	return &m, nil
+}
+
+// AddTaskMember returns repository.ErrDuplicate if the user is already assigned to the task.
+func (r *BoardRepository) AddTaskMember(ctx context.Context, taskID, userID int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO board_task_members(task_id, user_id)
+		VALUES ($1, $2)`, taskID, userID)
+	if err != nil {
+		if uniqueViolation(err, "board_task_members_pkey") {
+			return repository.ErrDuplicate
+		}
+		return fmt.Errorf("inserting task member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BoardRepository) RemoveTaskMember(ctx context.Context, taskID, userID int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		DELETE FROM board_task_members
+		WHERE task_id=$1 AND user_id=$2`, taskID, userID)
+	if err != nil {
+		return fmt.Errorf("deleting task member: %w", err)
+	}
+
+	return nil
+}
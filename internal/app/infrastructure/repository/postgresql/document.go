@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -22,10 +23,10 @@ func NewDocumentRepository(pool Driver) *DocumentRepository {
 func (r *DocumentRepository) GetDocument(ctx context.Context, id int32) (*domain.Document, error) {
 	var doc domain.Document
 
-	err := r.pool.QueryRow(ctx, `
-		SELECT id, filename, file_id, mime, size, user_id, created_at
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, filename, file_id, mime, size, user_id, created_at, version
 		FROM documents
-		WHERE id=$1`, id).Scan(
+		WHERE id=$1 AND deleted_at IS NULL`, id).Scan(
 		&doc.ID,
 		&doc.OriginalFilename,
 		&doc.FileID,
@@ -33,6 +34,7 @@ func (r *DocumentRepository) GetDocument(ctx context.Context, id int32) (*domain
 		&doc.SizeBytes,
 		&doc.UserID,
 		&doc.CreatedAt,
+		&doc.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -44,13 +46,47 @@ func (r *DocumentRepository) GetDocument(ctx context.Context, id int32) (*domain
 	return &doc, nil
 }
 
+// GetProjectDocument returns document if it belongs to the project.
+func (r *DocumentRepository) GetProjectDocument(ctx context.Context, docID, projectID int32) (*domain.Document, error) {
+	var doc domain.Document
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT d.id, d.filename, d.file_id, d.mime, d.size, d.user_id, d.created_at, d.version
+		FROM documents d
+		JOIN project_documents pd ON pd.document_id=d.id
+		WHERE d.id=$1 AND pd.project_id=$2 AND d.deleted_at IS NULL`, docID, projectID).Scan(
+		&doc.ID,
+		&doc.OriginalFilename,
+		&doc.FileID,
+		&doc.MimeType,
+		&doc.SizeBytes,
+		&doc.UserID,
+		&doc.CreatedAt,
+		&doc.Version,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning document: %w", err)
+	}
+
+	return &doc, nil
+}
+
+// CreateDocument creates document with its first version.
 func (r *DocumentRepository) CreateDocument(ctx context.Context, doc *domain.Document) (int32, error) {
 	var id int32
 
-	err := r.pool.QueryRow(ctx, `
-		INSERT INTO documents(filename, file_id, mime, size, user_id)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id`,
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		WITH d AS (
+			INSERT INTO documents(filename, file_id, mime, size, user_id)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id, version, filename, file_id, user_id, mime, size, created_at
+		)
+		INSERT INTO document_versions(document_id, version, filename, file_id, user_id, mime, size, created_at)
+		SELECT id, version, filename, file_id, user_id, mime, size, created_at FROM d
+		RETURNING document_id`,
 		doc.OriginalFilename,
 		doc.FileID,
 		doc.MimeType,
@@ -64,8 +100,9 @@ func (r *DocumentRepository) CreateDocument(ctx context.Context, doc *domain.Doc
 	return id, nil
 }
 
+// DeleteDocument moves document to the trash, its versions and files are kept.
 func (r *DocumentRepository) DeleteDocument(ctx context.Context, id int32) error {
-	_, err := r.pool.Exec(ctx, `DELETE FROM documents WHERE id=$1`, id)
+	_, err := conn(ctx, r.pool).Exec(ctx, `UPDATE documents SET deleted_at=now() WHERE id=$1 AND deleted_at IS NULL`, id)
 	if err != nil {
 		return fmt.Errorf("deleting document: %w", err)
 	}
@@ -73,14 +110,105 @@ func (r *DocumentRepository) DeleteDocument(ctx context.Context, id int32) error
 	return nil
 }
 
-func (r *DocumentRepository) GetProjectDocuments(ctx context.Context, projectID int32) ([]domain.Document, error) {
-	rows, err := r.pool.Query(ctx, `
-		SELECT d.id, d.filename, d.file_id, d.mime, d.size, d.user_id, d.created_at
+// RestoreDocument moves project document out of the trash, returns repository.ErrObjectNotFound if it is not there.
+func (r *DocumentRepository) RestoreDocument(ctx context.Context, docID, projectID int32) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE documents d
+		SET deleted_at=NULL
+		FROM project_documents pd
+		WHERE d.id=$1 AND pd.document_id=d.id AND pd.project_id=$2 AND d.deleted_at IS NOT NULL`, docID, projectID)
+	if err != nil {
+		return fmt.Errorf("restoring document: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// PurgeDocument deletes document forever with its versions, files must be deleted by the caller.
+func (r *DocumentRepository) PurgeDocument(ctx context.Context, id int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM documents WHERE id=$1`, id)
+	if err != nil {
+		return fmt.Errorf("purging document: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeletedDocumentIDs returns documents which were moved to the trash before the given moment.
+func (r *DocumentRepository) GetDeletedDocumentIDs(ctx context.Context, deletedBefore time.Time) ([]int32, error) {
+	return r.getIDs(ctx, `SELECT id FROM documents WHERE deleted_at < $1`, deletedBefore)
+}
+
+// GetProjectDocumentIDs returns all project documents including ones in the trash.
+func (r *DocumentRepository) GetProjectDocumentIDs(ctx context.Context, projectID int32) ([]int32, error) {
+	return r.getIDs(ctx, `SELECT document_id FROM project_documents WHERE project_id=$1`, projectID)
+}
+
+func (r *DocumentRepository) getIDs(ctx context.Context, query string, args ...any) ([]int32, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("selecting documents: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int32
+	for rows.Next() {
+		var id int32
+
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("scanning document id: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+var documentSortColumns = map[string]string{
+	"id":               "d.id",
+	"originalFilename": "d.filename",
+	"sizeBytes":        "d.size",
+	"createdAt":        "d.created_at",
+	"deletedAt":        "d.deleted_at",
+}
+
+// GetProjectDocuments returns the requested page of project documents and total number of project documents.
+// Documents in the trash are returned instead of the others if deleted is true.
+func (r *DocumentRepository) GetProjectDocuments(ctx context.Context, projectID int32, params *domain.ListParams, deleted bool) ([]domain.Document, int, error) {
+	q := newListQuery(projectID)
+	q.whereRaw("pd.project_id=$1")
+	if deleted {
+		q.whereRaw("d.deleted_at IS NOT NULL")
+	} else {
+		q.whereRaw("d.deleted_at IS NULL")
+	}
+	where := q.whereSQL()
+
+	var total int
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT count(*)
 		FROM documents d
 		JOIN project_documents pd ON pd.document_id=d.id
-		WHERE pd.project_id=$1`, projectID)
+		`+where, q.args...).Scan(&total)
 	if err != nil {
-		return nil, fmt.Errorf("getting project documents: %w", err)
+		return nil, 0, fmt.Errorf("counting project documents: %w", err)
+	}
+
+	page := q.pageSQL(params, documentSortColumns, "d.created_at, d.id")
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT d.id, d.filename, d.file_id, d.mime, d.size, d.user_id, d.created_at, d.version, d.deleted_at
+		FROM documents d
+		JOIN project_documents pd ON pd.document_id=d.id
+		`+where+`
+		`+page, q.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting project documents: %w", err)
 	}
 	defer rows.Close()
 
@@ -96,19 +224,21 @@ func (r *DocumentRepository) GetProjectDocuments(ctx context.Context, projectID
 			&doc.SizeBytes,
 			&doc.UserID,
 			&doc.CreatedAt,
+			&doc.Version,
+			&doc.DeletedAt,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("scanning document: %w", err)
+			return nil, 0, fmt.Errorf("scanning document: %w", err)
 		}
 
 		docs = append(docs, doc)
 	}
 
-	return docs, nil
+	return docs, total, nil
 }
 
 func (r *DocumentRepository) AddDocumentToProject(ctx context.Context, docID, projectID int32) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		INSERT INTO project_documents(document_id, project_id)
 		VALUES ($1, $2)`, docID, projectID)
 	if err != nil {
@@ -119,7 +249,7 @@ func (r *DocumentRepository) AddDocumentToProject(ctx context.Context, docID, pr
 }
 
 func (r *DocumentRepository) RemoveDocumentFromProject(ctx context.Context, docID, projectID int32) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		DELETE FROM project_documents
 		WHERE document_id=$1 AND project_id=$2`, docID, projectID)
 	if err != nil {
@@ -128,3 +258,140 @@ func (r *DocumentRepository) RemoveDocumentFromProject(ctx context.Context, docI
 
 	return nil
 }
+
+// CreateDocumentVersion adds a new version of the document and makes it current, returns the version number.
+func (r *DocumentRepository) CreateDocumentVersion(ctx context.Context, v *domain.DocumentVersion) (int32, error) {
+	var version int32
+
+	// Updating the document row locks it, so concurrent uploads get sequential version numbers.
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		WITH d AS (
+			UPDATE documents
+			SET version=version+1, filename=$2, file_id=$3, user_id=$4, mime=$5, size=$6
+			WHERE id=$1
+			RETURNING id, version, filename, file_id, user_id, mime, size
+		)
+		INSERT INTO document_versions(document_id, version, filename, file_id, user_id, mime, size)
+		SELECT id, version, filename, file_id, user_id, mime, size FROM d
+		RETURNING version`,
+		v.DocumentID,
+		v.OriginalFilename,
+		v.FileID,
+		v.UserID,
+		v.MimeType,
+		v.SizeBytes,
+	).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrObjectNotFound
+		}
+		return 0, fmt.Errorf("inserting document version: %w", err)
+	}
+
+	return version, nil
+}
+
+// GetDocumentVersion returns repository.ErrObjectNotFound for versions of documents in the trash.
+func (r *DocumentRepository) GetDocumentVersion(ctx context.Context, docID, version int32) (*domain.DocumentVersion, error) {
+	var v domain.DocumentVersion
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT v.document_id, v.version, v.filename, v.file_id, v.user_id, v.mime, v.size, v.created_at
+		FROM document_versions v
+		JOIN documents d ON d.id = v.document_id
+		WHERE v.document_id=$1 AND v.version=$2 AND d.deleted_at IS NULL`, docID, version).Scan(
+		&v.DocumentID,
+		&v.Version,
+		&v.OriginalFilename,
+		&v.FileID,
+		&v.UserID,
+		&v.MimeType,
+		&v.SizeBytes,
+		&v.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning document version: %w", err)
+	}
+
+	return &v, nil
+}
+
+var documentVersionSortColumns = map[string]string{
+	"version":   "version",
+	"createdAt": "created_at",
+}
+
+// GetDocumentVersions returns the requested page of document versions and total number of the versions.
+func (r *DocumentRepository) GetDocumentVersions(ctx context.Context, docID int32, params *domain.ListParams) ([]domain.DocumentVersion, int, error) {
+	var total int
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT count(*) FROM document_versions WHERE document_id=$1`, docID).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting document versions: %w", err)
+	}
+
+	q := newListQuery(docID)
+	page := q.pageSQL(params, documentVersionSortColumns, "version DESC")
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT document_id, version, filename, file_id, user_id, mime, size, created_at
+		FROM document_versions
+		WHERE document_id=$1
+		`+page, q.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting document versions: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []domain.DocumentVersion
+	for rows.Next() {
+		var v domain.DocumentVersion
+
+		err = rows.Scan(
+			&v.DocumentID,
+			&v.Version,
+			&v.OriginalFilename,
+			&v.FileID,
+			&v.UserID,
+			&v.MimeType,
+			&v.SizeBytes,
+			&v.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("scanning document version: %w", err)
+		}
+
+		versions = append(versions, v)
+	}
+
+	return versions, total, nil
+}
+
+// GetDocumentFileIDs returns files of all document versions, restored versions share files with the original ones.
+func (r *DocumentRepository) GetDocumentFileIDs(ctx context.Context, docID int32) ([]string, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT DISTINCT file_id
+		FROM document_versions
+		WHERE document_id=$1`, docID)
+	if err != nil {
+		return nil, fmt.Errorf("getting document files: %w", err)
+	}
+
+	defer rows.Close()
+
+	var fileIDs []string
+	for rows.Next() {
+		var fileID string
+
+		err = rows.Scan(&fileID)
+		if err != nil {
+			return nil, fmt.Errorf("scanning document file: %w", err)
+		}
+
+		fileIDs = append(fileIDs, fileID)
+	}
+
+	return fileIDs, nil
+}
@@ -0,0 +1,153 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/infrastructure/repository/postgresql"
+)
+
+func TestDocumentRepository_CreateDocumentVersion(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := postgresql.NewDocumentRepository(mock)
+
+	q := `
+		WITH d AS (
+			UPDATE documents
+			SET version=version+1, filename=$2, file_id=$3, user_id=$4, mime=$5, size=$6
+			WHERE id=$1
+			RETURNING id, version, filename, file_id, user_id, mime, size
+		)
+		INSERT INTO document_versions(document_id, version, filename, file_id, user_id, mime, size)
+		SELECT id, version, filename, file_id, user_id, mime, size FROM d
+		RETURNING version`
+
+	v := &domain.DocumentVersion{
+		DocumentID:       2,
+		OriginalFilename: "report.pdf",
+		FileID:           "file.pdf",
+		UserID:           7,
+		MimeType:         "application/pdf",
+		SizeBytes:        100,
+	}
+
+	tests := []struct {
+		name    string
+		version int32
+		err     error
+		mock    func()
+	}{
+		{
+			name:    "should pass",
+			version: 3,
+			mock: func() {
+				mock.ExpectQuery(q).
+					WithArgs(v.DocumentID, v.OriginalFilename, v.FileID, v.UserID, v.MimeType, v.SizeBytes).
+					WillReturnRows(mock.NewRows([]string{"version"}).AddRow(int32(3)))
+			},
+		},
+		{
+			name: "document not found",
+			err:  repository.ErrObjectNotFound,
+			mock: func() {
+				mock.ExpectQuery(q).
+					WithArgs(v.DocumentID, v.OriginalFilename, v.FileID, v.UserID, v.MimeType, v.SizeBytes).
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock()
+
+			version, err := repo.CreateDocumentVersion(context.Background(), v)
+			if tc.err != nil {
+				require.ErrorIs(tt, err, tc.err)
+				return
+			}
+
+			require.NoError(tt, err)
+			require.Equal(tt, tc.version, version)
+			require.NoError(tt, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDocumentRepository_GetDocumentVersion(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := postgresql.NewDocumentRepository(mock)
+
+	q := `
+		SELECT v.document_id, v.version, v.filename, v.file_id, v.user_id, v.mime, v.size, v.created_at
+		FROM document_versions v
+		JOIN documents d ON d.id = v.document_id
+		WHERE v.document_id=$1 AND v.version=$2 AND d.deleted_at IS NULL`
+
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		expected *domain.DocumentVersion
+		err      error
+		mock     func()
+	}{
+		{
+			name: "should pass",
+			expected: &domain.DocumentVersion{
+				DocumentID:       2,
+				Version:          1,
+				OriginalFilename: "report.pdf",
+				FileID:           "file.pdf",
+				UserID:           7,
+				MimeType:         "application/pdf",
+				SizeBytes:        100,
+				CreatedAt:        createdAt,
+			},
+			mock: func() {
+				rows := mock.NewRows([]string{"document_id", "version", "filename", "file_id", "user_id", "mime", "size", "created_at"}).
+					AddRow(int32(2), int32(1), "report.pdf", "file.pdf", int32(7), "application/pdf", int32(100), createdAt)
+				mock.ExpectQuery(q).WithArgs(int32(2), int32(1)).WillReturnRows(rows)
+			},
+		},
+		{
+			name: "document in trash",
+			err:  repository.ErrObjectNotFound,
+			mock: func() {
+				mock.ExpectQuery(q).WithArgs(int32(2), int32(1)).WillReturnError(pgx.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock()
+
+			v, err := repo.GetDocumentVersion(context.Background(), 2, 1)
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+			if tc.err != nil {
+				require.ErrorIs(tt, err, tc.err)
+				return
+			}
+
+			require.NoError(tt, err)
+			require.Equal(tt, tc.expected, v)
+		})
+	}
+}
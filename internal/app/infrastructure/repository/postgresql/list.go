@@ -0,0 +1,70 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	"web-studio-backend/internal/app/domain"
+)
+
+// listQuery accumulates WHERE conditions and their positional arguments of a list query.
+type listQuery struct {
+	conds []string
+	args  []any
+}
+
+func newListQuery(args ...any) *listQuery {
+	return &listQuery{args: args}
+}
+
+// where appends condition, "?" in the condition is replaced with placeholder of the arg.
+func (q *listQuery) where(cond string, arg any) {
+	q.args = append(q.args, arg)
+	q.conds = append(q.conds, strings.Replace(cond, "?", fmt.Sprintf("$%d", len(q.args)), 1))
+}
+
+// whereRaw appends condition which uses arguments passed to newListQuery.
+func (q *listQuery) whereRaw(cond string) {
+	q.conds = append(q.conds, cond)
+}
+
+// whereSQL returns WHERE clause or empty string if there are no conditions.
+func (q *listQuery) whereSQL() string {
+	if len(q.conds) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(q.conds, " AND ")
+}
+
+// pageSQL returns ORDER BY, LIMIT and OFFSET clauses.
+// Sort fields are mapped to the columns, fields missing in columns are skipped.
+// defaultOrder is always appended to make order stable.
+//
+//	Adds arguments, so count query must be built before calling pageSQL.
+func (q *listQuery) pageSQL(params *domain.ListParams, columns map[string]string, defaultOrder string) string {
+	if params == nil {
+		return "ORDER BY " + defaultOrder
+	}
+
+	var order []string
+	for _, s := range params.Sort {
+		col, ok := columns[s.Name]
+		if !ok {
+			continue
+		}
+		if s.Desc {
+			col += " DESC"
+		}
+		order = append(order, col)
+	}
+	order = append(order, defaultOrder)
+
+	sql := "ORDER BY " + strings.Join(order, ", ")
+
+	if params.Limit > 0 {
+		q.args = append(q.args, params.Limit, params.Offset)
+		sql += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(q.args)-1, len(q.args))
+	}
+
+	return sql
+}
@@ -0,0 +1,158 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+type ProjectMediaRepository struct {
+	pool Driver
+}
+
+func NewProjectMediaRepository(pool Driver) *ProjectMediaRepository {
+	return &ProjectMediaRepository{pool}
+}
+
+// GetProjectMedia returns the project gallery in display order.
+func (r *ProjectMediaRepository) GetProjectMedia(ctx context.Context, projectID int32) ([]domain.ProjectMedia, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, project_id, type, COALESCE(image_id, ''), COALESCE(video_url, ''), caption, position, is_cover, created_at
+		FROM project_media
+		WHERE project_id=$1
+		ORDER BY position, id`, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting project media: %w", err)
+	}
+	defer rows.Close()
+
+	media := make([]domain.ProjectMedia, 0)
+	for rows.Next() {
+		var m domain.ProjectMedia
+		err = rows.Scan(&m.ID, &m.ProjectID, &m.Type, &m.ImageID, &m.VideoURL, &m.Caption, &m.Position, &m.IsCover, &m.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scanning project media: %w", err)
+		}
+		media = append(media, m)
+	}
+
+	return media, nil
+}
+
+func (r *ProjectMediaRepository) GetMedia(ctx context.Context, mediaID, projectID int32) (*domain.ProjectMedia, error) {
+	var m domain.ProjectMedia
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, project_id, type, COALESCE(image_id, ''), COALESCE(video_url, ''), caption, position, is_cover, created_at
+		FROM project_media
+		WHERE id=$1 AND project_id=$2`, mediaID, projectID).
+		Scan(&m.ID, &m.ProjectID, &m.Type, &m.ImageID, &m.VideoURL, &m.Caption, &m.Position, &m.IsCover, &m.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("selecting project media: %w", err)
+	}
+
+	return &m, nil
+}
+
+// LockProjectMedia locks the project row till the end of the transaction, so changes of the gallery
+// made by concurrent requests, e.g. adding items over the limit, run one after another.
+func (r *ProjectMediaRepository) LockProjectMedia(ctx context.Context, projectID int32) error {
+	var id int32
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT id FROM projects WHERE id=$1 FOR UPDATE`, projectID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrObjectNotFound
+		}
+		return fmt.Errorf("locking project: %w", err)
+	}
+
+	return nil
+}
+
+// CreateMedia appends the media to the end of the project gallery.
+// Returns repository.ErrDuplicate if the media is a cover and the gallery already has one.
+func (r *ProjectMediaRepository) CreateMedia(ctx context.Context, m *domain.ProjectMedia) (int32, error) {
+	var id int32
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO project_media(project_id, type, image_id, video_url, caption, position, is_cover)
+		SELECT $1, $2, NULLIF($3, ''), NULLIF($4, ''), $5, COALESCE(MAX(position), 0) + 1, $6
+		FROM project_media
+		WHERE project_id=$1
+		RETURNING id`,
+		m.ProjectID, m.Type, m.ImageID, m.VideoURL, m.Caption, m.IsCover).
+		Scan(&id)
+	if err != nil {
+		if uniqueViolation(err, "project_media_cover_idx") {
+			return 0, repository.ErrDuplicate
+		}
+		return 0, fmt.Errorf("inserting project media: %w", err)
+	}
+
+	return id, nil
+}
+
+// UpdateMedia returns repository.ErrDuplicate if the media becomes a cover and the gallery already has one.
+func (r *ProjectMediaRepository) UpdateMedia(ctx context.Context, m *domain.ProjectMedia) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE project_media
+		SET video_url=NULLIF($3, ''), caption=$4, is_cover=$5
+		WHERE id=$1 AND project_id=$2`,
+		m.ID, m.ProjectID, m.VideoURL, m.Caption, m.IsCover)
+	if err != nil {
+		if uniqueViolation(err, "project_media_cover_idx") {
+			return repository.ErrDuplicate
+		}
+		return fmt.Errorf("updating project media: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// UnsetCover clears the cover flag of the project gallery, so another image can become the cover.
+func (r *ProjectMediaRepository) UnsetCover(ctx context.Context, projectID int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `UPDATE project_media SET is_cover=false WHERE project_id=$1 AND is_cover`, projectID)
+	if err != nil {
+		return fmt.Errorf("unsetting project cover: %w", err)
+	}
+
+	return nil
+}
+
+// ReorderMedia sets positions of the project media according to the order of identifiers.
+func (r *ProjectMediaRepository) ReorderMedia(ctx context.Context, projectID int32, mediaIDs []int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE project_media m
+		SET position=o.position
+		FROM unnest($2::int4[]) WITH ORDINALITY AS o(id, position)
+		WHERE m.id=o.id AND m.project_id=$1`, projectID, mediaIDs)
+	if err != nil {
+		return fmt.Errorf("reordering project media: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ProjectMediaRepository) DeleteMedia(ctx context.Context, mediaID, projectID int32) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM project_media WHERE id=$1 AND project_id=$2`, mediaID, projectID)
+	if err != nil {
+		return fmt.Errorf("deleting project media: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/infrastructure/repository/postgresql"
+)
+
+func prepareMediaMock(t *testing.T) (pgxmock.PgxPoolIface, *postgresql.ProjectMediaRepository) {
+	t.Helper()
+
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mock, postgresql.NewProjectMediaRepository(mock)
+}
+
+func TestProjectMediaRepository_GetMedia(t *testing.T) {
+	mock, repo := prepareMediaMock(t)
+
+	q := `
+		SELECT id, project_id, type, COALESCE(image_id, ''), COALESCE(video_url, ''), caption, position, is_cover, created_at
+		FROM project_media
+		WHERE id=$1 AND project_id=$2`
+
+	createdAt := time.Now()
+
+	tests := []struct {
+		name        string
+		expected    *domain.ProjectMedia
+		expectedErr error
+		mock        func()
+	}{
+		{
+			name: "should pass",
+			expected: &domain.ProjectMedia{
+				ID: 1, ProjectID: 2, Type: domain.MediaTypeImage, ImageID: "img.webp", Caption: "Main page", Position: 1, IsCover: true, CreatedAt: createdAt,
+			},
+			mock: func() {
+				rows := mock.NewRows([]string{"id", "project_id", "type", "image_id", "video_url", "caption", "position", "is_cover", "created_at"}).
+					AddRow(int32(1), int32(2), domain.MediaTypeImage, "img.webp", "", "Main page", int32(1), true, createdAt)
+				mock.ExpectQuery(q).WithArgs(int32(1), int32(2)).WillReturnRows(rows)
+			},
+		},
+		{
+			name:        "no media",
+			expectedErr: repository.ErrObjectNotFound,
+			mock: func() {
+				mock.ExpectQuery(q).WithArgs(int32(1), int32(2)).WillReturnError(pgx.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock()
+
+			media, err := repo.GetMedia(context.Background(), 1, 2)
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+			if tc.expectedErr != nil {
+				require.ErrorIs(tt, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(tt, err)
+			require.Equal(tt, tc.expected, media)
+		})
+	}
+}
+
+func TestProjectMediaRepository_ReorderMedia(t *testing.T) {
+	mock, repo := prepareMediaMock(t)
+
+	mock.ExpectExec(`
+		UPDATE project_media m
+		SET position=o.position
+		FROM unnest($2::int4[]) WITH ORDINALITY AS o(id, position)
+		WHERE m.id=o.id AND m.project_id=$1`).
+		WithArgs(int32(7), []int32{3, 1, 2}).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+
+	err := repo.ReorderMedia(context.Background(), 7, []int32{3, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProjectMediaRepository_UpdateMedia(t *testing.T) {
+	mock, repo := prepareMediaMock(t)
+
+	q := `
+		UPDATE project_media
+		SET video_url=NULLIF($3, ''), caption=$4, is_cover=$5
+		WHERE id=$1 AND project_id=$2`
+
+	media := &domain.ProjectMedia{ID: 1, ProjectID: 2, Type: domain.MediaTypeImage, Caption: "Main page", IsCover: true}
+
+	tests := []struct {
+		name        string
+		expectedErr error
+		mock        func()
+	}{
+		{
+			name: "should pass",
+			mock: func() {
+				mock.ExpectExec(q).WithArgs(int32(1), int32(2), "", "Main page", true).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:        "no media",
+			expectedErr: repository.ErrObjectNotFound,
+			mock: func() {
+				mock.ExpectExec(q).WithArgs(int32(1), int32(2), "", "Main page", true).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+		},
+		{
+			name:        "cover taken",
+			expectedErr: repository.ErrDuplicate,
+			mock: func() {
+				mock.ExpectExec(q).WithArgs(int32(1), int32(2), "", "Main page", true).
+					WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "project_media_cover_idx"})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock()
+
+			err := repo.UpdateMedia(context.Background(), media)
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+			if tc.expectedErr != nil {
+				require.ErrorIs(tt, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(tt, err)
+		})
+	}
+}
+
+func TestProjectMediaRepository_LockProjectMedia(t *testing.T) {
+	mock, repo := prepareMediaMock(t)
+
+	q := `SELECT id FROM projects WHERE id=$1 FOR UPDATE`
+
+	mock.ExpectQuery(q).WithArgs(int32(2)).WillReturnRows(mock.NewRows([]string{"id"}).AddRow(int32(2)))
+	require.NoError(t, repo.LockProjectMedia(context.Background(), 2))
+
+	mock.ExpectQuery(q).WithArgs(int32(3)).WillReturnError(pgx.ErrNoRows)
+	require.ErrorIs(t, repo.LockProjectMedia(context.Background(), 3), repository.ErrObjectNotFound)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
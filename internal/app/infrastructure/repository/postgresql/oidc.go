@@ -0,0 +1,111 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+type OIDCRepository struct {
+	pool Driver
+}
+
+func NewOIDCRepository(pool Driver) *OIDCRepository {
+	return &OIDCRepository{pool}
+}
+
+// CreateOIDCState saves state of the started sign-in, abandoned expired states are deleted along the way.
+func (r *OIDCRepository) CreateOIDCState(ctx context.Context, state *domain.OIDCState) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH d AS (
+			DELETE FROM oidc_states WHERE expires_at <= now()
+		)
+		INSERT INTO oidc_states(state_hash, provider, nonce, code_verifier, expires_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		state.Hash,
+		state.Provider,
+		state.Nonce,
+		state.CodeVerifier,
+		state.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting oidc state: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeOIDCState deletes state and returns it.
+// Returns repository.ErrObjectNotFound if the state does not exist or expired.
+func (r *OIDCRepository) ConsumeOIDCState(ctx context.Context, hash string) (*domain.OIDCState, error) {
+	var (
+		state domain.OIDCState
+		valid bool
+	)
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		DELETE FROM oidc_states
+		WHERE state_hash=$1
+		RETURNING state_hash, provider, nonce, code_verifier, expires_at, expires_at > now()`, hash).Scan(
+		&state.Hash,
+		&state.Provider,
+		&state.Nonce,
+		&state.CodeVerifier,
+		&state.ExpiresAt,
+		&valid,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("deleting oidc state: %w", err)
+	}
+	if !valid {
+		return nil, repository.ErrObjectNotFound
+	}
+
+	return &state, nil
+}
+
+func (r *OIDCRepository) GetUserIdentity(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	var identity domain.UserIdentity
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT provider, subject, user_id, email
+		FROM user_identities
+		WHERE provider=$1 AND subject=$2`, provider, subject).Scan(
+		&identity.Provider,
+		&identity.Subject,
+		&identity.UserID,
+		&identity.Email,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning user identity: %w", err)
+	}
+
+	return &identity, nil
+}
+
+func (r *OIDCRepository) CreateUserIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO user_identities(provider, subject, user_id, email)
+		VALUES ($1, $2, $3, $4)`,
+		identity.Provider,
+		identity.Subject,
+		identity.UserID,
+		identity.Email,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting user identity: %w", err)
+	}
+
+	return nil
+}
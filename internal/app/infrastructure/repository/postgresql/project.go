@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"web-studio-backend/internal/app/domain"
 	"web-studio-backend/internal/app/infrastructure/repository"
@@ -22,13 +23,13 @@ func NewProjectRepository(pool Driver) *ProjectRepository {
 func (r *ProjectRepository) GetProject(ctx context.Context, id int32) (*domain.Project, error) {
 	var project domain.Project
 
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT 
 		   p.id, title, description, image_id, created_at, updated_at, started_at, ended_at,
-		   link, isactive, technologies, team_id, pc.name
+		   link, isactive, technologies, team_id, COALESCE(pc.name, ''), p.slug
        	FROM projects p
-		JOIN project_categories pc ON p.category_id = pc.id
-       	WHERE p.id = $1`, id).Scan(
+		LEFT JOIN project_categories pc ON p.category_id = pc.id
+       	WHERE p.id = $1 AND p.deleted_at IS NULL`, id).Scan(
 		&project.ID,
 		&project.Title,
 		&project.Description,
@@ -42,6 +43,7 @@ func (r *ProjectRepository) GetProject(ctx context.Context, id int32) (*domain.P
 		&project.Technologies,
 		&project.TeamID,
 		&project.Category,
+		&project.Slug,
 	)
 
 	if err != nil {
@@ -54,17 +56,146 @@ func (r *ProjectRepository) GetProject(ctx context.Context, id int32) (*domain.P
 	return &project, nil
 }
 
-func (r *ProjectRepository) GetProjects(ctx context.Context) ([]domain.Project, error) {
-	rows, err := r.pool.Query(ctx, `
+// GetProjectBySlug returns project which is not in the trash.
+func (r *ProjectRepository) GetProjectBySlug(ctx context.Context, slug string) (*domain.Project, error) {
+	var project domain.Project
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT
+		   p.id, title, description, image_id, created_at, updated_at, started_at, ended_at,
+		   link, isactive, technologies, team_id, COALESCE(pc.name, ''), p.slug
+		FROM projects p
+		LEFT JOIN project_categories pc ON p.category_id = pc.id
+		WHERE p.slug = $1 AND p.deleted_at IS NULL`, slug).Scan(
+		&project.ID,
+		&project.Title,
+		&project.Description,
+		&project.ImageId,
+		&project.CreatedAt,
+		&project.UpdatedAt,
+		&project.StartedAt,
+		&project.EndedAt,
+		&project.Link,
+		&project.IsActive,
+		&project.Technologies,
+		&project.TeamID,
+		&project.Category,
+		&project.Slug,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning project: %w", err)
+	}
+
+	return &project, nil
+}
+
+// ProjectSlugExists reports whether the slug is taken by a project, including projects in the trash or is a former slug of one.
+func (r *ProjectRepository) ProjectSlugExists(ctx context.Context, slug string) (bool, error) {
+	var exists bool
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM projects WHERE slug=$1)
+		    OR EXISTS(SELECT 1 FROM project_slug_redirects WHERE slug=$1)`, slug).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("checking project slug: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetProjectSlugRedirect returns identifier of the project which had the slug before it was changed.
+func (r *ProjectRepository) GetProjectSlugRedirect(ctx context.Context, slug string) (int32, error) {
+	var id int32
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT project_id FROM project_slug_redirects WHERE slug=$1`, slug).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrObjectNotFound
+		}
+		return 0, fmt.Errorf("scanning project slug redirect: %w", err)
+	}
+
+	return id, nil
+}
+
+// ChangeProjectSlug sets the new slug and keeps the current one as a redirect.
+// Redirect with the new slug is removed, so a project can get back its former slug.
+// Returns repository.ErrDuplicate if another project has the slug.
+func (r *ProjectRepository) ChangeProjectSlug(ctx context.Context, id int32, slug string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH moved AS (
+			INSERT INTO project_slug_redirects(slug, project_id)
+			SELECT slug, id FROM projects WHERE id=$1 AND slug<>$2
+			ON CONFLICT (slug) DO UPDATE SET project_id=EXCLUDED.project_id, created_at=now()
+		), reused AS (
+			DELETE FROM project_slug_redirects WHERE slug=$2
+		)
+		UPDATE projects SET slug=$2, updated_at=now() WHERE id=$1`, id, slug)
+	if err != nil {
+		if uniqueViolation(err, "projects_slug_key") {
+			return repository.ErrDuplicate
+		}
+		return fmt.Errorf("updating project slug: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+var projectSortColumns = map[string]string{
+	"id":        "p.id",
+	"title":     "p.title",
+	"createdAt": "p.created_at",
+	"startedAt": "p.started_at",
+	"endedAt":   "p.ended_at",
+}
+
+// projectListFrom is shared by page and count queries of project lists, so the total matches the pages.
+// Projects without a category are listed with an empty one.
+const projectListFrom = `FROM projects p LEFT JOIN project_categories pc ON p.category_id = pc.id`
+
+// GetProjects returns the requested page of projects and total number of projects matching the filter.
+func (r *ProjectRepository) GetProjects(ctx context.Context, params *domain.ListParams, filter *domain.ProjectFilter) ([]domain.Project, int, error) {
+	q := newListQuery()
+	q.whereRaw("p.deleted_at IS NULL")
+	if filter != nil {
+		if filter.CategoryID != nil {
+			q.where("p.category_id = ?", *filter.CategoryID)
+		}
+		if filter.TeamID != nil {
+			q.where("p.team_id = ?", *filter.TeamID)
+		}
+		for _, id := range filter.TechnologyIDs {
+			q.where("EXISTS (SELECT 1 FROM project_technologies pt WHERE pt.project_id = p.id AND pt.technology_id = ?)", id)
+		}
+		if filter.IsActive != nil {
+			q.where("p.isactive = ?", *filter.IsActive)
+		}
+	}
+	where := q.whereSQL()
+
+	var total int
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT count(*) `+projectListFrom+` `+where, q.args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting projects: %w", err)
+	}
+
+	page := q.pageSQL(params, projectSortColumns, "p.created_at, p.id")
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
 		SELECT 
 		    p.id, title, description, image_id, created_at, updated_at, started_at, ended_at,
-		    link, isactive, technologies, team_id, pc.name
-        FROM projects p
-		JOIN project_categories pc ON p.category_id = pc.id
-        WHERE isactive
-        ORDER BY created_at`)
+		    link, isactive, technologies, team_id, COALESCE(pc.name, ''), p.slug
+        `+projectListFrom+`
+        `+where+`
+        `+page, q.args...)
 	if err != nil {
-		return nil, fmt.Errorf("selecting projects: %w", err)
+		return nil, 0, fmt.Errorf("selecting projects: %w", err)
 	}
 	defer rows.Close()
 
@@ -86,51 +217,56 @@ func (r *ProjectRepository) GetProjects(ctx context.Context) ([]domain.Project,
 			&project.Technologies,
 			&project.TeamID,
 			&project.Category,
+			&project.Slug,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("scanning project: %w", err)
+			return nil, 0, fmt.Errorf("scanning project: %w", err)
 		}
 
 		projects = append(projects, project)
 	}
 
-	return projects, nil
+	return projects, total, nil
 }
 
+// CreateProject inserts the project, its technologies are linked by TechnologyRepository.SetProjectTechnologies.
 func (r *ProjectRepository) CreateProject(ctx context.Context, project *domain.Project) (int32, error) {
 	var projectId int32
 
-	err := r.pool.QueryRow(ctx, `
-		INSERT INTO projects(title, description, team_id, isactive, link, technologies, image_id, started_at, ended_at, category_id)
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO projects(title, description, team_id, isactive, link, image_id, started_at, ended_at, category_id, slug)
 		VALUES($1, $2, $3, TRUE, $4, $5, $6, $7, $8, $9)
 		RETURNING id`,
 		project.Title,
 		project.Description,
 		project.TeamID,
 		project.Link,
-		project.Technologies,
 		project.ImageId,
 		project.StartedAt,
 		project.EndedAt,
 		project.CategoryID,
+		project.Slug,
 	).Scan(&projectId)
 	if err != nil {
+		if uniqueViolation(err, "projects_slug_key") {
+			return 0, repository.ErrDuplicate
+		}
 		return 0, fmt.Errorf("scanning project id: %w", err)
 	}
 
 	return projectId, nil
 }
 
+// UpdateProject updates the project, its technologies are linked by TechnologyRepository.SetProjectTechnologies.
 func (r *ProjectRepository) UpdateProject(ctx context.Context, project *domain.Project) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE projects
-		SET title=$2, description=$3, link=$4, technologies=$5, started_at=$6, ended_at=$7, updated_at=now(), category_id=$8
+		SET title=$2, description=$3, link=$4, started_at=$5, ended_at=$6, updated_at=now(), category_id=$7
 		WHERE id = $1`,
 		project.ID,
 		project.Title,
 		project.Description,
 		project.Link,
-		project.Technologies,
 		project.StartedAt,
 		project.EndedAt,
 		project.CategoryID,
@@ -142,8 +278,9 @@ func (r *ProjectRepository) UpdateProject(ctx context.Context, project *domain.P
 	return nil
 }
 
+// DeleteProject moves project to the trash.
 func (r *ProjectRepository) DeleteProject(ctx context.Context, id int32) error {
-	_, err := r.pool.Exec(ctx, `DELETE FROM projects WHERE id = $1`, id)
+	_, err := conn(ctx, r.pool).Exec(ctx, `UPDATE projects SET deleted_at=now() WHERE id = $1 AND deleted_at IS NULL`, id)
 	if err != nil {
 		return fmt.Errorf("deleting project: %w", err)
 	}
@@ -151,8 +288,97 @@ func (r *ProjectRepository) DeleteProject(ctx context.Context, id int32) error {
 	return nil
 }
 
+// RestoreProject moves project out of the trash, returns repository.ErrObjectNotFound if it is not there.
+func (r *ProjectRepository) RestoreProject(ctx context.Context, id int32) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `UPDATE projects SET deleted_at=NULL WHERE id = $1 AND deleted_at IS NOT NULL`, id)
+	if err != nil {
+		return fmt.Errorf("restoring project: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// PurgeProject deletes project forever with its participants and boards.
+func (r *ProjectRepository) PurgeProject(ctx context.Context, id int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM projects WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("purging project: %w", err)
+	}
+
+	return nil
+}
+
+var deletedProjectSortColumns = map[string]string{
+	"id":        "p.id",
+	"title":     "p.title",
+	"deletedAt": "p.deleted_at",
+}
+
+// GetDeletedProjects returns the requested page of projects in the trash and total number of them.
+// Projects deleted before the given moment are returned if it is not zero.
+func (r *ProjectRepository) GetDeletedProjects(ctx context.Context, params *domain.ListParams, deletedBefore time.Time) ([]domain.Project, int, error) {
+	q := newListQuery()
+	q.whereRaw("p.deleted_at IS NOT NULL")
+	if !deletedBefore.IsZero() {
+		q.where("p.deleted_at < ?", deletedBefore)
+	}
+	where := q.whereSQL()
+
+	var total int
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT count(*) `+projectListFrom+` `+where, q.args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting deleted projects: %w", err)
+	}
+
+	page := q.pageSQL(params, deletedProjectSortColumns, "p.deleted_at, p.id")
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT 
+		    p.id, title, description, image_id, created_at, updated_at, started_at, ended_at,
+		    link, isactive, technologies, team_id, COALESCE(pc.name, ''), p.deleted_at
+        `+projectListFrom+`
+        `+where+`
+        `+page, q.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("selecting deleted projects: %w", err)
+	}
+	defer rows.Close()
+
+	var projects []domain.Project
+	for rows.Next() {
+		var project domain.Project
+
+		err = rows.Scan(
+			&project.ID,
+			&project.Title,
+			&project.Description,
+			&project.ImageId,
+			&project.CreatedAt,
+			&project.UpdatedAt,
+			&project.StartedAt,
+			&project.EndedAt,
+			&project.Link,
+			&project.IsActive,
+			&project.Technologies,
+			&project.TeamID,
+			&project.Category,
+			&project.DeletedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("scanning project: %w", err)
+		}
+
+		projects = append(projects, project)
+	}
+
+	return projects, total, nil
+}
+
 func (r *ProjectRepository) DisableProject(ctx context.Context, id int32) error {
-	_, err := r.pool.Exec(ctx, `UPDATE projects SET isactive=FALSE WHERE id = $1`, id)
+	_, err := conn(ctx, r.pool).Exec(ctx, `UPDATE projects SET isactive=FALSE WHERE id = $1`, id)
 	if err != nil {
 		return fmt.Errorf("disabling project: %w", err)
 	}
@@ -160,16 +386,47 @@ func (r *ProjectRepository) DisableProject(ctx context.Context, id int32) error
 	return nil
 }
 
-func (r *ProjectRepository) GetParticipants(ctx context.Context, projectID int32) ([]domain.ProjectParticipant, error) {
-	rows, err := r.pool.Query(ctx, `
+var participantSortColumns = map[string]string{
+	"name":      "u.name",
+	"surname":   "u.surname",
+	"username":  "u.username",
+	"role":      "pp.role",
+	"position":  "pp.position",
+	"createdAt": "pp.created_at",
+}
+
+// GetParticipants returns the requested page of project participants and total number of participants matching the filter.
+func (r *ProjectRepository) GetParticipants(ctx context.Context, projectID int32, params *domain.ListParams, filter *domain.ParticipantFilter) ([]domain.ProjectParticipant, int, error) {
+	q := newListQuery(projectID)
+	q.whereRaw("pp.project_id = $1")
+	if filter != nil {
+		if filter.Role != nil {
+			q.where("pp.role = ?", *filter.Role)
+		}
+		if filter.Position != nil {
+			q.where("pp.position = ?", *filter.Position)
+		}
+	}
+	where := q.whereSQL()
+
+	var total int
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT count(*) FROM project_participants pp `+where, q.args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting project %d participants: %w", projectID, err)
+	}
+
+	page := q.pageSQL(params, participantSortColumns, "pp.created_at, pp.user_id")
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
 		SELECT
 		    pp.user_id, pp.project_id, pp.role, pp.position, pp.created_at, pp.updated_at,
 		    u.name, u.surname, u.username
 	 	FROM project_participants pp
 			JOIN users u ON u.id = pp.user_id
-	 	WHERE pp.project_id = $1`, projectID)
+	 	`+where+`
+	 	`+page, q.args...)
 	if err != nil {
-		return nil, fmt.Errorf("selectiong project %d participants: %w", projectID, err)
+		return nil, 0, fmt.Errorf("selectiong project %d participants: %w", projectID, err)
 	}
 	defer rows.Close()
 
@@ -189,19 +446,19 @@ func (r *ProjectRepository) GetParticipants(ctx context.Context, projectID int32
 			&p.Surname,
 			&p.Username,
 		); err != nil {
-			return nil, fmt.Errorf("scanning participant: %w", err)
+			return nil, 0, fmt.Errorf("scanning participant: %w", err)
 		}
 
 		participants = append(participants, p)
 	}
 
-	return participants, nil
+	return participants, total, nil
 }
 
 func (r *ProjectRepository) GetParticipant(ctx context.Context, participantID, projectID int32) (*domain.ProjectParticipant, error) {
 	var p domain.ProjectParticipant
 
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT 
 		    pp.user_id, pp.project_id, pp.role, pp.position, pp.created_at, pp.updated_at,
 		    u.name, u.surname, u.username
@@ -229,7 +486,7 @@ func (r *ProjectRepository) GetParticipant(ctx context.Context, participantID, p
 }
 
 func (r *ProjectRepository) AddParticipant(ctx context.Context, participant *domain.ProjectParticipant) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		INSERT INTO project_participants(project_id, user_id, role, position) 
 		VALUES ($1,$2,$3,$4)`,
 		participant.ProjectID,
@@ -245,7 +502,7 @@ func (r *ProjectRepository) AddParticipant(ctx context.Context, participant *dom
 }
 
 func (r *ProjectRepository) UpdateParticipant(ctx context.Context, participant *domain.ProjectParticipant) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE project_participants
 		SET role=$3, position=$4, updated_at=now()
 		WHERE user_id=$1 AND project_id=$2`,
@@ -262,7 +519,7 @@ func (r *ProjectRepository) UpdateParticipant(ctx context.Context, participant *
 }
 
 func (r *ProjectRepository) RemoveParticipant(ctx context.Context, participantID, projectID int32) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		DELETE FROM project_participants
 		WHERE user_id=$1 AND project_id=$2`, participantID, projectID)
 	if err != nil {
@@ -273,7 +530,7 @@ func (r *ProjectRepository) RemoveParticipant(ctx context.Context, participantID
 }
 
 func (r *ProjectRepository) SetProjectImageID(ctx context.Context, projectID int32, imageID string) error {
-	if _, err := r.pool.Exec(ctx, `
+	if _, err := conn(ctx, r.pool).Exec(ctx, `
 	UPDATE projects
 	SET image_id=$2, updated_at=now()
 	WHERE id=$1`,
@@ -285,3 +542,82 @@ func (r *ProjectRepository) SetProjectImageID(ctx context.Context, projectID int
 
 	return nil
 }
+
+func (r *ProjectRepository) GetProjectTranslations(ctx context.Context, projectID int32) ([]domain.Translation, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT locale, title, description, updated_at
+		FROM project_translations
+		WHERE project_id=$1
+		ORDER BY locale`, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("querying project translations: %w", err)
+	}
+	defer rows.Close()
+
+	translations := make([]domain.Translation, 0)
+	for rows.Next() {
+		var t domain.Translation
+		err = rows.Scan(&t.Locale, &t.Title, &t.Description, &t.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scanning project translation: %w", err)
+		}
+		translations = append(translations, t)
+	}
+
+	return translations, nil
+}
+
+// GetProjectTranslationsByLocale returns translations of the projects to the locale by project identifiers.
+// Projects without translation are missing in the result.
+func (r *ProjectRepository) GetProjectTranslationsByLocale(ctx context.Context, projectIDs []int32, locale string) (map[int32]domain.Translation, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT project_id, locale, title, description, updated_at
+		FROM project_translations
+		WHERE project_id=ANY($1) AND locale=$2`, projectIDs, locale)
+	if err != nil {
+		return nil, fmt.Errorf("querying project translations: %w", err)
+	}
+	defer rows.Close()
+
+	translations := make(map[int32]domain.Translation)
+	for rows.Next() {
+		var (
+			id int32
+			t  domain.Translation
+		)
+		err = rows.Scan(&id, &t.Locale, &t.Title, &t.Description, &t.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scanning project translation: %w", err)
+		}
+		translations[id] = t
+	}
+
+	return translations, nil
+}
+
+// SaveProjectTranslation creates or replaces the translation to its locale.
+func (r *ProjectRepository) SaveProjectTranslation(ctx context.Context, projectID int32, t *domain.Translation) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO project_translations(project_id, locale, title, description)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id, locale) DO UPDATE
+		SET title=EXCLUDED.title, description=EXCLUDED.description, updated_at=now()`,
+		projectID, t.Locale, t.Title, t.Description)
+	if err != nil {
+		return fmt.Errorf("upserting project translation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ProjectRepository) DeleteProjectTranslation(ctx context.Context, projectID int32, locale string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM project_translations WHERE project_id=$1 AND locale=$2`, projectID, locale)
+	if err != nil {
+		return fmt.Errorf("deleting project translation: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
@@ -22,7 +22,7 @@ func NewProjectCategoryRepository(pool Driver) *ProjectCategoryRepository {
 func (r *ProjectCategoryRepository) CreateProjectCategory(ctx context.Context, pc *domain.ProjectCategory) (int16, error) {
 	var id int16
 
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		INSERT INTO project_categories(name)
 		VALUES ($1)
 		RETURNING id`, pc.Name).
@@ -35,7 +35,7 @@ func (r *ProjectCategoryRepository) CreateProjectCategory(ctx context.Context, p
 }
 
 func (r *ProjectCategoryRepository) GetProjectCategories(ctx context.Context) ([]domain.ProjectCategory, error) {
-	rows, err := r.pool.Query(ctx, `
+	rows, err := conn(ctx, r.pool).Query(ctx, `
 		SELECT id, name
 		FROM project_categories
 		ORDER BY name`)
@@ -62,7 +62,7 @@ func (r *ProjectCategoryRepository) GetProjectCategories(ctx context.Context) ([
 func (r *ProjectCategoryRepository) GetProjectCategory(ctx context.Context, id int16) (*domain.ProjectCategory, error) {
 	var pc domain.ProjectCategory
 
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT id, name
 		FROM project_categories
 		WHERE id=$1`, id).Scan(
@@ -82,7 +82,7 @@ func (r *ProjectCategoryRepository) GetProjectCategory(ctx context.Context, id i
 func (r *ProjectCategoryRepository) GetProjectCategoryByName(ctx context.Context, name string) (*domain.ProjectCategory, error) {
 	var pc domain.ProjectCategory
 
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT id, name
 		FROM project_categories
 		WHERE lower(name)=lower($1)`, name).Scan(
@@ -100,7 +100,7 @@ func (r *ProjectCategoryRepository) GetProjectCategoryByName(ctx context.Context
 }
 
 func (r *ProjectCategoryRepository) UpdateProjectCategory(ctx context.Context, pc *domain.ProjectCategory) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE project_categories
 		SET name=$2
 		WHERE id=$1`, pc.ID, pc.Name)
@@ -112,7 +112,7 @@ func (r *ProjectCategoryRepository) UpdateProjectCategory(ctx context.Context, p
 }
 
 func (r *ProjectCategoryRepository) DeleteProjectCategory(ctx context.Context, id int16) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		DELETE FROM project_categories
 		WHERE id=$1`, id)
 	if err != nil {
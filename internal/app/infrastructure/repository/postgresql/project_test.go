@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pashagolub/pgxmock/v3"
 	"github.com/stretchr/testify/require"
 
@@ -35,10 +36,11 @@ func TestProjectRepository_GetProject(t *testing.T) {
 
 	q := `
 		SELECT 
-		    id, title, description, image_id, created_at, updated_at, ended_at,
-		    link, isactive, technologies, team_id
-        FROM projects
-        WHERE id = $1`
+		   p.id, title, description, image_id, created_at, updated_at, started_at, ended_at,
+		   link, isactive, technologies, team_id, COALESCE(pc.name, ''), p.slug
+       	FROM projects p
+		LEFT JOIN project_categories pc ON p.category_id = pc.id
+       	WHERE p.id = $1 AND p.deleted_at IS NULL`
 
 	tempTime := time.Now()
 
@@ -55,6 +57,7 @@ func TestProjectRepository_GetProject(t *testing.T) {
 			in:   1,
 			response: &domain.Project{
 				ID:           1,
+				Slug:         "title",
 				Title:        "title",
 				Description:  "description",
 				IsActive:     true,
@@ -64,13 +67,15 @@ func TestProjectRepository_GetProject(t *testing.T) {
 				ImageId:      "image_id",
 				Link:         "link",
 				TeamID:       ptr.Int32(2),
+				StartedAt:    &tempTime,
 				EndedAt:      &tempTime,
+				Category:     "category",
 			},
 			wantErr: false,
 			mock: func(id int32) {
 				row := mock.NewRows([]string{
-					"id", "title", "description", "image_id", "created_at", "updated_at", "ended_at",
-					"link", "isactive", "technologies", "team_id",
+					"id", "title", "description", "image_id", "created_at", "updated_at", "started_at", "ended_at",
+					"link", "isactive", "technologies", "team_id", "name", "slug",
 				}).
 					AddRow(
 						id,
@@ -80,10 +85,13 @@ func TestProjectRepository_GetProject(t *testing.T) {
 						tempTime,
 						tempTime,
 						&tempTime,
+						&tempTime,
 						"link",
 						true,
 						[]string{"tech1", "tech2"},
 						ptr.Int32(2),
+						"category",
+						"title",
 					)
 
 				mock.ExpectQuery(q).WithArgs(id).WillReturnRows(row)
@@ -135,27 +143,41 @@ func TestProjectRepository_GetProject(t *testing.T) {
 func TestProjectRepository_GetProjects(t *testing.T) {
 	mock, repo := prepareProjectMock(t)
 
+	countQ := `SELECT count(*) FROM projects p LEFT JOIN project_categories pc ON p.category_id = pc.id WHERE p.deleted_at IS NULL AND p.category_id = $1 AND EXISTS (SELECT 1 FROM project_technologies pt WHERE pt.project_id = p.id AND pt.technology_id = $2) AND p.isactive = $3`
+
 	q := `
 		SELECT 
-		    id, title, description, image_id, created_at, updated_at, ended_at,
-		    link, isactive, technologies, team_id
-        FROM projects
-        WHERE isactive
-        ORDER BY created_at`
+		    p.id, title, description, image_id, created_at, updated_at, started_at, ended_at,
+		    link, isactive, technologies, team_id, COALESCE(pc.name, ''), p.slug
+        FROM projects p LEFT JOIN project_categories pc ON p.category_id = pc.id
+        WHERE p.deleted_at IS NULL AND p.category_id = $1 AND EXISTS (SELECT 1 FROM project_technologies pt WHERE pt.project_id = p.id AND pt.technology_id = $2) AND p.isactive = $3
+        ORDER BY p.created_at, p.id LIMIT $4 OFFSET $5`
 
 	tempTime := time.Now()
 
+	isActive := true
+	params := &domain.ListParams{Limit: 10, Offset: 20}
+	filter := &domain.ProjectFilter{
+		CategoryID:    ptr.Int32(1),
+		TechnologyIDs: []int32{5},
+		IsActive:      &isActive,
+	}
+	args := []any{*filter.CategoryID, int32(5), isActive}
+
 	tests := []struct {
 		name     string
 		response []domain.Project
+		total    int
 		wantErr  bool
 		mock     func()
 	}{
 		{
-			name: "should pass",
+			name:  "should pass",
+			total: 22,
 			response: []domain.Project{
 				{
 					ID:           1,
+					Slug:         "title1",
 					Title:        "title1",
 					Description:  "description1",
 					IsActive:     true,
@@ -166,21 +188,24 @@ func TestProjectRepository_GetProjects(t *testing.T) {
 					Link:         "link1",
 					TeamID:       ptr.Int32(2),
 					EndedAt:      &tempTime,
+					Category:     "category1",
 				},
 				{
 					ID:          2,
+					Slug:        "title2",
 					Title:       "title2",
 					Description: "description2",
-					IsActive:    false,
+					IsActive:    true,
 					CreatedAt:   tempTime,
 					UpdatedAt:   tempTime,
+					Category:    "category1",
 				},
 			},
 			wantErr: false,
 			mock: func() {
 				rows := mock.NewRows([]string{
-					"id", "title", "description", "image_id", "created_at", "updated_at", "ended_at",
-					"link", "isactive", "technologies", "team_id",
+					"id", "title", "description", "image_id", "created_at", "updated_at", "started_at", "ended_at",
+					"link", "isactive", "technologies", "team_id", "name", "slug",
 				}).
 					AddRow(
 						int32(1),
@@ -189,11 +214,14 @@ func TestProjectRepository_GetProjects(t *testing.T) {
 						"image1",
 						tempTime,
 						tempTime,
+						nil,
 						&tempTime,
 						"link1",
 						true,
 						[]string{"tech1", "tech2"},
 						ptr.Int32(2),
+						"category1",
+						"title1",
 					).
 					AddRow(
 						int32(2),
@@ -203,13 +231,17 @@ func TestProjectRepository_GetProjects(t *testing.T) {
 						tempTime,
 						tempTime,
 						nil,
+						nil,
 						"",
-						false,
+						true,
 						nil,
 						nil,
+						"category1",
+						"title2",
 					)
 
-				mock.ExpectQuery(q).WillReturnRows(rows).RowsWillBeClosed()
+				mock.ExpectQuery(countQ).WithArgs(args...).WillReturnRows(mock.NewRows([]string{"count"}).AddRow(22))
+				mock.ExpectQuery(q).WithArgs(append(args, 10, 20)...).WillReturnRows(rows).RowsWillBeClosed()
 			},
 		},
 		{
@@ -217,7 +249,8 @@ func TestProjectRepository_GetProjects(t *testing.T) {
 			response: nil,
 			wantErr:  true,
 			mock: func() {
-				mock.ExpectQuery(q).WillReturnError(errors.New("some err"))
+				mock.ExpectQuery(countQ).WithArgs(args...).WillReturnRows(mock.NewRows([]string{"count"}).AddRow(22))
+				mock.ExpectQuery(q).WithArgs(append(args, 10, 20)...).WillReturnError(errors.New("some err"))
 			},
 		},
 	}
@@ -228,7 +261,7 @@ func TestProjectRepository_GetProjects(t *testing.T) {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mock()
 
-			resp, err := repo.GetProjects(context.Background())
+			resp, total, err := repo.GetProjects(context.Background(), params, filter)
 
 			require.NoError(tt, mock.ExpectationsWereMet())
 			if tc.wantErr {
@@ -238,6 +271,7 @@ func TestProjectRepository_GetProjects(t *testing.T) {
 
 			require.NoError(tt, err)
 			require.Equal(tt, tc.response, resp)
+			require.Equal(tt, tc.total, total)
 		})
 	}
 }
@@ -246,8 +280,8 @@ func TestProjectRepository_CreateProject(t *testing.T) {
 	mock, repo := prepareProjectMock(t)
 
 	q := `
-		INSERT INTO projects(title, description, team_id, isactive, link, technologies, image_id)
-		VALUES($1, $2, $3, TRUE, $4, $5, $6)
+		INSERT INTO projects(title, description, team_id, isactive, link, image_id, started_at, ended_at, category_id, slug)
+		VALUES($1, $2, $3, TRUE, $4, $5, $6, $7, $8, $9)
 		RETURNING id`
 
 	tests := []struct {
@@ -271,8 +305,11 @@ func TestProjectRepository_CreateProject(t *testing.T) {
 						project.Description,
 						project.TeamID,
 						project.Link,
-						project.Technologies,
 						project.ImageId,
+						project.StartedAt,
+						project.EndedAt,
+						project.CategoryID,
+						project.Slug,
 					).WillReturnRows(rows)
 			},
 		},
@@ -288,8 +325,11 @@ func TestProjectRepository_CreateProject(t *testing.T) {
 						project.Description,
 						project.TeamID,
 						project.Link,
-						project.Technologies,
 						project.ImageId,
+						project.StartedAt,
+						project.EndedAt,
+						project.CategoryID,
+						project.Slug,
 					).WillReturnError(fmt.Errorf("some error"))
 			},
 		},
@@ -302,6 +342,8 @@ func TestProjectRepository_CreateProject(t *testing.T) {
 		Link:         "test",
 		Technologies: []string{"tech1", "tech2"},
 		ImageId:      "image_id",
+		CategoryID:   1,
+		Slug:         "title",
 	}
 
 	for _, tc := range tests {
@@ -331,7 +373,7 @@ func TestProjectRepository_UpdateProject(t *testing.T) {
 
 	q := `
 		UPDATE projects
-		SET title=$2, description=$3, link=$4, technologies=$5, updated_at=now()
+		SET title=$2, description=$3, link=$4, started_at=$5, ended_at=$6, updated_at=now(), category_id=$7
 		WHERE id = $1`
 
 	tests := []struct {
@@ -345,6 +387,7 @@ func TestProjectRepository_UpdateProject(t *testing.T) {
 			wantErr: false,
 			in: &domain.Project{
 				ID:           1,
+				Slug:         "title",
 				Title:        "title",
 				Description:  "description",
 				Link:         "link",
@@ -356,7 +399,9 @@ func TestProjectRepository_UpdateProject(t *testing.T) {
 					project.Title,
 					project.Description,
 					project.Link,
-					project.Technologies,
+					project.StartedAt,
+					project.EndedAt,
+					project.CategoryID,
 				).WillReturnResult(pgxmock.NewResult("UPDATED", 1))
 			},
 		},
@@ -370,7 +415,9 @@ func TestProjectRepository_UpdateProject(t *testing.T) {
 					project.Title,
 					project.Description,
 					project.Link,
-					project.Technologies,
+					project.StartedAt,
+					project.EndedAt,
+					project.CategoryID,
 				).WillReturnError(errors.New("some err"))
 			},
 		},
@@ -441,6 +488,53 @@ func TestProjectRepository_DisableProject(t *testing.T) {
 	}
 }
 
+func TestProjectRepository_RestoreProject(t *testing.T) {
+	mock, repo := prepareProjectMock(t)
+
+	q := `UPDATE projects SET deleted_at=NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	tests := []struct {
+		name        string
+		id          int32
+		expectedErr error
+		mock        func(id int32)
+	}{
+		{
+			name: "should pass",
+			id:   1,
+			mock: func(id int32) {
+				mock.ExpectExec(q).WithArgs(id).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:        "not in the trash",
+			id:          2,
+			expectedErr: repository.ErrObjectNotFound,
+			mock: func(id int32) {
+				mock.ExpectExec(q).WithArgs(id).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock(tc.id)
+
+			err := repo.RestoreProject(context.Background(), tc.id)
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+			if tc.expectedErr != nil {
+				require.ErrorIs(tt, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(tt, err)
+		})
+	}
+}
+
 func TestProjectRepository_GetParticipant(t *testing.T) {
 	mock, repo := prepareProjectMock(t)
 
@@ -556,7 +650,10 @@ func TestProjectRepository_GetParticipants(t *testing.T) {
 		    u.name, u.surname, u.username
 	 	FROM project_participants pp
 			JOIN users u ON u.id = pp.user_id
-	 	WHERE pp.project_id = $1`
+	 	WHERE pp.project_id = $1
+	 	ORDER BY pp.created_at, pp.user_id`
+
+	countQ := `SELECT count(*) FROM project_participants pp WHERE pp.project_id = $1`
 
 	tempTime := time.Now()
 
@@ -564,12 +661,14 @@ func TestProjectRepository_GetParticipants(t *testing.T) {
 		name     string
 		pid      int32
 		response []domain.ProjectParticipant
+		total    int
 		wantErr  bool
 		mock     func(pid int32)
 	}{
 		{
-			name: "should pass",
-			pid:  1,
+			name:  "should pass",
+			pid:   1,
+			total: 2,
 			response: []domain.ProjectParticipant{
 				{
 					UserID:    1,
@@ -630,6 +729,7 @@ func TestProjectRepository_GetParticipants(t *testing.T) {
 						"username2",
 					)
 
+				mock.ExpectQuery(countQ).WithArgs(pid).WillReturnRows(mock.NewRows([]string{"count"}).AddRow(2))
 				mock.ExpectQuery(q).
 					WithArgs(pid).
 					WillReturnRows(rows).
@@ -641,6 +741,7 @@ func TestProjectRepository_GetParticipants(t *testing.T) {
 			response: nil,
 			wantErr:  true,
 			mock: func(pid int32) {
+				mock.ExpectQuery(countQ).WithArgs(pid).WillReturnRows(mock.NewRows([]string{"count"}).AddRow(2))
 				mock.ExpectQuery(q).WithArgs(pid).WillReturnError(errors.New("some err"))
 			},
 		},
@@ -652,7 +753,7 @@ func TestProjectRepository_GetParticipants(t *testing.T) {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mock(tc.pid)
 
-			resp, err := repo.GetParticipants(context.Background(), tc.pid)
+			resp, total, err := repo.GetParticipants(context.Background(), tc.pid, nil, nil)
 
 			require.NoError(tt, mock.ExpectationsWereMet())
 			if tc.wantErr {
@@ -662,6 +763,7 @@ func TestProjectRepository_GetParticipants(t *testing.T) {
 
 			require.NoError(tt, err)
 			require.Equal(tt, tc.response, resp)
+			require.Equal(tt, tc.total, total)
 		})
 	}
 }
@@ -869,3 +971,67 @@ func TestProjectRepository_UpdateParticipant(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectRepository_ChangeProjectSlug(t *testing.T) {
+	mock, repo := prepareProjectMock(t)
+
+	q := `
+		WITH moved AS (
+			INSERT INTO project_slug_redirects(slug, project_id)
+			SELECT slug, id FROM projects WHERE id=$1 AND slug<>$2
+			ON CONFLICT (slug) DO UPDATE SET project_id=EXCLUDED.project_id, created_at=now()
+		), reused AS (
+			DELETE FROM project_slug_redirects WHERE slug=$2
+		)
+		UPDATE projects SET slug=$2, updated_at=now() WHERE id=$1`
+
+	tests := []struct {
+		name        string
+		id          int32
+		expectedErr error
+		mock        func(id int32)
+	}{
+		{
+			name: "should pass",
+			id:   1,
+			mock: func(id int32) {
+				mock.ExpectExec(q).WithArgs(id, "new").WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:        "no project",
+			id:          2,
+			expectedErr: repository.ErrObjectNotFound,
+			mock: func(id int32) {
+				mock.ExpectExec(q).WithArgs(id, "new").WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+		},
+		{
+			name:        "slug taken",
+			id:          3,
+			expectedErr: repository.ErrDuplicate,
+			mock: func(id int32) {
+				mock.ExpectExec(q).WithArgs(id, "new").
+					WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "projects_slug_key"})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock(tc.id)
+
+			err := repo.ChangeProjectSlug(context.Background(), tc.id, "new")
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+			if tc.expectedErr != nil {
+				require.ErrorIs(tt, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(tt, err)
+		})
+	}
+}
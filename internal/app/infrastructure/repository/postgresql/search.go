@@ -0,0 +1,112 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"web-studio-backend/internal/app/domain"
+)
+
+// Matched words are wrapped in control characters instead of HTML tags,
+// so the rest of the snippet can be escaped before the tags are inserted.
+const (
+	headlineStart   = "\x01"
+	headlineStop    = "\x02"
+	headlineOptions = "StartSel=" + headlineStart + ", StopSel=" + headlineStop +
+		", MaxWords=20, MinWords=5, MaxFragments=2, FragmentDelimiter=\" … \""
+)
+
+// Every query selects type, id, title, snippet and rank.
+// $1 is a search query in web search syntax, $2 is ts_headline options.
+var searchQueries = map[domain.SearchResultType]string{
+	domain.SearchResultProject: `
+		SELECT 'project', id, title,
+		       ts_headline('russian', title || ' ' || coalesce(array_to_string(technologies, ', '), '') || ' ' || description,
+		                   websearch_to_tsquery('russian', $1), $2),
+		       ts_rank(search_vector, websearch_to_tsquery('russian', $1)) AS rank
+		FROM projects
+		WHERE isactive AND deleted_at IS NULL AND search_vector @@ websearch_to_tsquery('russian', $1)`,
+	domain.SearchResultUser: `
+		SELECT 'user', id, name || ' ' || surname,
+		       ts_headline('simple', name || ' ' || surname || ' (' || username || ')',
+		                   websearch_to_tsquery('simple', $1), $2),
+		       ts_rank(search_vector, websearch_to_tsquery('simple', $1)) AS rank
+		FROM users
+		WHERE disabled_at IS NULL AND search_vector @@ websearch_to_tsquery('simple', $1)`,
+	domain.SearchResultTeam: `
+		SELECT 'team', id, title,
+		       ts_headline('russian', title || ' ' || description, websearch_to_tsquery('russian', $1), $2),
+		       ts_rank(search_vector, websearch_to_tsquery('russian', $1)) AS rank
+		FROM teams
+		WHERE disabled_at IS NULL AND search_vector @@ websearch_to_tsquery('russian', $1)`,
+	domain.SearchResultDocument: `
+		SELECT 'document', id, filename,
+		       ts_headline('simple', translate(filename, '._-', '   '), websearch_to_tsquery('simple', $1), $2),
+		       ts_rank(search_vector, websearch_to_tsquery('simple', $1)) AS rank
+		FROM documents
+		WHERE deleted_at IS NULL AND search_vector @@ websearch_to_tsquery('simple', $1)`,
+}
+
+type SearchRepository struct {
+	pool Driver
+}
+
+func NewSearchRepository(pool Driver) *SearchRepository {
+	return &SearchRepository{pool}
+}
+
+// Search returns objects matching the query ordered by rank.
+func (r *SearchRepository) Search(ctx context.Context, req *domain.SearchRequest) ([]domain.SearchResult, error) {
+	var parts []string
+	for _, t := range domain.SearchResultTypes {
+		if len(req.Types) == 0 || slices.Contains(req.Types, t) {
+			parts = append(parts, searchQueries[t])
+		}
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx,
+		strings.Join(parts, "\n\t\tUNION ALL")+`
+		ORDER BY rank DESC
+		LIMIT $3`,
+		req.Query,
+		headlineOptions,
+		req.Limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("searching: %w", err)
+	}
+	defer rows.Close()
+
+	var results []domain.SearchResult
+	for rows.Next() {
+		var res domain.SearchResult
+
+		err = rows.Scan(
+			&res.Type,
+			&res.ID,
+			&res.Title,
+			&res.Snippet,
+			&res.Rank,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning search result: %w", err)
+		}
+
+		res.Snippet = highlight(res.Snippet)
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// highlight escapes the headline and replaces selection markers with <mark> tags.
+func highlight(headline string) string {
+	return strings.NewReplacer(
+		headlineStart, "<mark>",
+		headlineStop, "</mark>",
+	).Replace(html.EscapeString(headline))
+}
@@ -0,0 +1,94 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository/postgresql"
+)
+
+func TestSearchRepository_Search(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := postgresql.NewSearchRepository(mock)
+
+	q := `
+		SELECT 'team', id, title,
+		       ts_headline('russian', title || ' ' || description, websearch_to_tsquery('russian', $1), $2),
+		       ts_rank(search_vector, websearch_to_tsquery('russian', $1)) AS rank
+		FROM teams
+		WHERE disabled_at IS NULL AND search_vector @@ websearch_to_tsquery('russian', $1)
+		ORDER BY rank DESC
+		LIMIT $3`
+
+	req := &domain.SearchRequest{
+		Query: "backend",
+		Types: []domain.SearchResultType{domain.SearchResultTeam},
+		Limit: 10,
+	}
+
+	tests := []struct {
+		name     string
+		response []domain.SearchResult
+		wantErr  bool
+		mock     func()
+	}{
+		{
+			name: "should pass",
+			response: []domain.SearchResult{
+				{
+					Type:    domain.SearchResultTeam,
+					ID:      1,
+					Title:   "<Backend>",
+					Snippet: "&lt;<mark>Backend</mark>&gt; team",
+					Rank:    0.5,
+				},
+			},
+			mock: func() {
+				rows := mock.NewRows([]string{"type", "id", "title", "snippet", "rank"}).
+					AddRow(domain.SearchResultTeam, int32(1), "<Backend>", "<\x01Backend\x02> team", float32(0.5))
+
+				mock.ExpectQuery(q).
+					WithArgs(req.Query, pgxmock.AnyArg(), req.Limit).
+					WillReturnRows(rows).
+					RowsWillBeClosed()
+			},
+		},
+		{
+			name:    "query error",
+			wantErr: true,
+			mock: func() {
+				mock.ExpectQuery(q).
+					WithArgs(req.Query, pgxmock.AnyArg(), req.Limit).
+					WillReturnError(errors.New("some err"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock()
+
+			resp, err := repo.Search(context.Background(), req)
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+			if tc.wantErr {
+				require.Error(tt, err)
+				return
+			}
+
+			require.NoError(tt, err)
+			require.Equal(tt, tc.response, resp)
+		})
+	}
+}
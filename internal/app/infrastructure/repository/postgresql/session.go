@@ -0,0 +1,144 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"web-studio-backend/internal/pkg/auth/session"
+)
+
+type SessionRepository struct {
+	pool Driver
+}
+
+func NewSessionRepository(pool Driver) *SessionRepository {
+	return &SessionRepository{pool}
+}
+
+func (r *SessionRepository) Create(ctx context.Context, sess *session.Session) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO sessions(id, user_id, csrf_token, ip, user_agent, created_at, last_seen_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		sess.ID,
+		sess.UserID,
+		sess.CSRFToken,
+		sess.IP,
+		sess.UserAgent,
+		sess.CreatedAt,
+		sess.LastSeenAt,
+		sess.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) Get(ctx context.Context, sessionID string) (*session.Session, error) {
+	var sess session.Session
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, user_id, csrf_token, ip, user_agent, created_at, last_seen_at, expires_at
+		FROM sessions
+		WHERE id=$1 AND expires_at > now()`, sessionID).Scan(
+		&sess.ID,
+		&sess.UserID,
+		&sess.CSRFToken,
+		&sess.IP,
+		&sess.UserAgent,
+		&sess.CreatedAt,
+		&sess.LastSeenAt,
+		&sess.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, session.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("scanning session: %w", err)
+	}
+
+	return &sess, nil
+}
+
+func (r *SessionRepository) Touch(ctx context.Context, sessionID string, at time.Time) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `UPDATE sessions SET last_seen_at=$2 WHERE id=$1`, sessionID, at)
+	if err != nil {
+		return fmt.Errorf("updating session last seen: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) ListUserSessions(ctx context.Context, userID int32) ([]session.Session, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, user_id, csrf_token, ip, user_agent, created_at, last_seen_at, expires_at
+		FROM sessions
+		WHERE user_id=$1 AND expires_at > now()
+		ORDER BY last_seen_at DESC`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting user sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := make([]session.Session, 0)
+	for rows.Next() {
+		var sess session.Session
+		err = rows.Scan(
+			&sess.ID,
+			&sess.UserID,
+			&sess.CSRFToken,
+			&sess.IP,
+			&sess.UserAgent,
+			&sess.CreatedAt,
+			&sess.LastSeenAt,
+			&sess.ExpiresAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning session: %w", err)
+		}
+		sessions = append(sessions, sess)
+	}
+
+	return sessions, nil
+}
+
+func (r *SessionRepository) Delete(ctx context.Context, sessionID string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM sessions WHERE id=$1`, sessionID)
+	if err != nil {
+		return fmt.Errorf("deleting session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) DeleteUserSessions(ctx context.Context, userID int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM sessions WHERE user_id=$1`, userID)
+	if err != nil {
+		return fmt.Errorf("deleting user sessions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) DeleteOtherUserSessions(ctx context.Context, userID int32, keepSessionID string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM sessions WHERE user_id=$1 AND id<>$2`, userID, keepSessionID)
+	if err != nil {
+		return fmt.Errorf("deleting other user sessions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM sessions WHERE expires_at <= now()`)
+	if err != nil {
+		return 0, fmt.Errorf("deleting expired sessions: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,114 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+type SignInThrottleRepository struct {
+	pool Driver
+}
+
+func NewSignInThrottleRepository(pool Driver) *SignInThrottleRepository {
+	return &SignInThrottleRepository{pool}
+}
+
+func (r *SignInThrottleRepository) GetSignInThrottle(ctx context.Context, kind domain.SignInThrottleKind, subject string) (*domain.SignInThrottle, error) {
+	var t domain.SignInThrottle
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT kind, subject, failures, last_failure_at, blocked_until
+		FROM sign_in_throttles
+		WHERE kind=$1 AND subject=$2`, kind, subject).Scan(
+		&t.Kind,
+		&t.Subject,
+		&t.Failures,
+		&t.LastFailureAt,
+		&t.BlockedUntil,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning sign in throttle: %w", err)
+	}
+
+	return &t, nil
+}
+
+// AddSignInFailure counts an attempt as failed and returns the number of failures, the row stays locked till the end of transaction.
+// Failures are counted from scratch if the previous one happened before windowStart.
+// Returns repository.ErrObjectNotFound without counting if sign in is blocked.
+func (r *SignInThrottleRepository) AddSignInFailure(ctx context.Context, kind domain.SignInThrottleKind, subject string, windowStart time.Time) (int, error) {
+	var failures int
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO sign_in_throttles(kind, subject, failures)
+		VALUES ($1, $2, 1)
+		ON CONFLICT (kind, subject) DO UPDATE
+		SET failures = CASE
+				WHEN sign_in_throttles.last_failure_at < $3 THEN 1
+				ELSE sign_in_throttles.failures + 1
+			END,
+			last_failure_at = now()
+		WHERE sign_in_throttles.blocked_until IS NULL OR sign_in_throttles.blocked_until <= now()
+		RETURNING failures`, kind, subject, windowStart).Scan(&failures)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrObjectNotFound
+		}
+		return 0, fmt.Errorf("upserting sign in throttle: %w", err)
+	}
+
+	return failures, nil
+}
+
+// RemoveSignInFailure takes back an attempt which turned out to be successful, delay of the next attempts is kept.
+func (r *SignInThrottleRepository) RemoveSignInFailure(ctx context.Context, kind domain.SignInThrottleKind, subject string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE sign_in_throttles SET failures=greatest(failures - 1, 0)
+		WHERE kind=$1 AND subject=$2`, kind, subject)
+	if err != nil {
+		return fmt.Errorf("updating sign in throttle: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SignInThrottleRepository) BlockSignIn(ctx context.Context, kind domain.SignInThrottleKind, subject string, until time.Time) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE sign_in_throttles SET blocked_until=$3
+		WHERE kind=$1 AND subject=$2`, kind, subject, until)
+	if err != nil {
+		return fmt.Errorf("updating sign in throttle: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSignInThrottle forgets failed attempts, stale throttles of other subjects are deleted along the way.
+// Returns repository.ErrObjectNotFound if there were no failed attempts.
+func (r *SignInThrottleRepository) DeleteSignInThrottle(ctx context.Context, kind domain.SignInThrottleKind, subject string, staleBefore time.Time) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH d AS (
+			DELETE FROM sign_in_throttles
+			WHERE last_failure_at < $3 AND (blocked_until IS NULL OR blocked_until < now())
+				AND NOT (kind=$1 AND subject=$2)
+		)
+		DELETE FROM sign_in_throttles WHERE kind=$1 AND subject=$2`, kind, subject, staleBefore)
+	if err != nil {
+		return fmt.Errorf("deleting sign in throttle: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
@@ -22,9 +22,9 @@ func NewTeamRepository(pool Driver) *TeamRepository {
 func (r *TeamRepository) GetTeam(ctx context.Context, id int32) (*domain.Team, error) {
 	var team domain.Team
 
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT 
-		    id, title, description, image_id, created_at, updated_at, disabled_at
+		    id, title, description, image_id, created_at, updated_at, disabled_at, slug
 		FROM teams
 		WHERE id=$1`, id).Scan(
 		&team.ID,
@@ -34,6 +34,7 @@ func (r *TeamRepository) GetTeam(ctx context.Context, id int32) (*domain.Team, e
 		&team.CreatedAt,
 		&team.UpdatedAt,
 		&team.DisabledAt,
+		&team.Slug,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -47,14 +48,124 @@ func (r *TeamRepository) GetTeam(ctx context.Context, id int32) (*domain.Team, e
 	return &team, nil
 }
 
-func (r *TeamRepository) GetTeams(ctx context.Context) ([]domain.Team, error) {
-	rows, err := r.pool.Query(ctx, `
+func (r *TeamRepository) GetTeamBySlug(ctx context.Context, slug string) (*domain.Team, error) {
+	var team domain.Team
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT
+		    id, title, description, image_id, created_at, updated_at, disabled_at, slug
+		FROM teams
+		WHERE slug=$1`, slug).Scan(
+		&team.ID,
+		&team.Title,
+		&team.Description,
+		&team.ImageID,
+		&team.CreatedAt,
+		&team.UpdatedAt,
+		&team.DisabledAt,
+		&team.Slug,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning team: %w", err)
+	}
+
+	team.HasImage = team.ImageID != ""
+
+	return &team, nil
+}
+
+// TeamSlugExists reports whether the slug is taken by a team or is a former slug of one.
+func (r *TeamRepository) TeamSlugExists(ctx context.Context, slug string) (bool, error) {
+	var exists bool
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT EXISTS(SELECT 1 FROM teams WHERE slug=$1)
+		    OR EXISTS(SELECT 1 FROM team_slug_redirects WHERE slug=$1)`, slug).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("checking team slug: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetTeamSlugRedirect returns identifier of the team which had the slug before it was changed.
+func (r *TeamRepository) GetTeamSlugRedirect(ctx context.Context, slug string) (int32, error) {
+	var id int32
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT team_id FROM team_slug_redirects WHERE slug=$1`, slug).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrObjectNotFound
+		}
+		return 0, fmt.Errorf("scanning team slug redirect: %w", err)
+	}
+
+	return id, nil
+}
+
+// ChangeTeamSlug sets the new slug and keeps the current one as a redirect.
+// Redirect with the new slug is removed, so a team can get back its former slug.
+// Returns repository.ErrDuplicate if another team has the slug.
+func (r *TeamRepository) ChangeTeamSlug(ctx context.Context, id int32, slug string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH moved AS (
+			INSERT INTO team_slug_redirects(slug, team_id)
+			SELECT slug, id FROM teams WHERE id=$1 AND slug<>$2
+			ON CONFLICT (slug) DO UPDATE SET team_id=EXCLUDED.team_id, created_at=now()
+		), reused AS (
+			DELETE FROM team_slug_redirects WHERE slug=$2
+		)
+		UPDATE teams SET slug=$2, updated_at=now() WHERE id=$1`, id, slug)
+	if err != nil {
+		if uniqueViolation(err, "teams_slug_key") {
+			return repository.ErrDuplicate
+		}
+		return fmt.Errorf("updating team slug: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+var teamSortColumns = map[string]string{
+	"id":        "id",
+	"title":     "title",
+	"createdAt": "created_at",
+}
+
+// GetTeams returns the requested page of teams and total number of teams matching the filter.
+func (r *TeamRepository) GetTeams(ctx context.Context, params *domain.ListParams, filter *domain.TeamFilter) ([]domain.Team, int, error) {
+	q := newListQuery()
+	if filter != nil && filter.Disabled != nil {
+		if *filter.Disabled {
+			q.whereRaw("disabled_at IS NOT NULL")
+		} else {
+			q.whereRaw("disabled_at IS NULL")
+		}
+	}
+	where := q.whereSQL()
+
+	var total int
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT count(*) FROM teams `+where, q.args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting teams: %w", err)
+	}
+
+	page := q.pageSQL(params, teamSortColumns, "created_at, id")
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
 		SELECT 
-		    id, title, description, image_id, created_at, updated_at, disabled_at
+		    id, title, description, image_id, created_at, updated_at, disabled_at, slug
 		FROM teams
-		ORDER BY created_at`)
+		`+where+`
+		`+page, q.args...)
 	if err != nil {
-		return nil, fmt.Errorf("selecting teams: %w", err)
+		return nil, 0, fmt.Errorf("selecting teams: %w", err)
 	}
 	defer rows.Close()
 
@@ -70,9 +181,10 @@ func (r *TeamRepository) GetTeams(ctx context.Context) ([]domain.Team, error) {
 			&team.CreatedAt,
 			&team.UpdatedAt,
 			&team.DisabledAt,
+			&team.Slug,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("scanning team: %w", err)
+			return nil, 0, fmt.Errorf("scanning team: %w", err)
 		}
 
 		team.HasImage = team.ImageID != ""
@@ -80,17 +192,20 @@ func (r *TeamRepository) GetTeams(ctx context.Context) ([]domain.Team, error) {
 		teams = append(teams, team)
 	}
 
-	return teams, nil
+	return teams, total, nil
 }
 
 func (r *TeamRepository) CreateTeam(ctx context.Context, team *domain.Team) (int32, error) {
 	var id int32
 
-	err := r.pool.QueryRow(ctx, `
-		INSERT INTO teams(title, description, image_id)
-		VALUES ($1, $2, '')
-		RETURNING id`, team.Title, team.Description).Scan(&id)
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO teams(title, description, image_id, slug)
+		VALUES ($1, $2, '', $3)
+		RETURNING id`, team.Title, team.Description, team.Slug).Scan(&id)
 	if err != nil {
+		if uniqueViolation(err, "teams_slug_key") {
+			return 0, repository.ErrDuplicate
+		}
 		return 0, fmt.Errorf("inserting team: %w", err)
 	}
 
@@ -98,7 +213,7 @@ func (r *TeamRepository) CreateTeam(ctx context.Context, team *domain.Team) (int
 }
 
 func (r *TeamRepository) UpdateTeam(ctx context.Context, team *domain.Team) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE teams
 		SET title=$2, description=$3, updated_at=now()
 		WHERE id=$1`,
@@ -114,7 +229,7 @@ func (r *TeamRepository) UpdateTeam(ctx context.Context, team *domain.Team) erro
 }
 
 func (r *TeamRepository) SetTeamImageID(ctx context.Context, teamID int32, imageID string) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE teams
 		SET image_id=$2, updated_at=now()
 		WHERE id=$1`,
@@ -129,7 +244,7 @@ func (r *TeamRepository) SetTeamImageID(ctx context.Context, teamID int32, image
 }
 
 func (r *TeamRepository) DisableTeam(ctx context.Context, teamID int32) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE teams
 		SET disabled_at=now(), updated_at=now()
 		WHERE id=$1`,
@@ -143,7 +258,7 @@ func (r *TeamRepository) DisableTeam(ctx context.Context, teamID int32) error {
 }
 
 func (r *TeamRepository) EnableTeam(ctx context.Context, teamID int32) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE teams
 		SET disabled_at=NULL, updated_at=now()
 		WHERE id=$1`,
@@ -159,9 +274,9 @@ func (r *TeamRepository) EnableTeam(ctx context.Context, teamID int32) error {
 func (r *TeamRepository) CheckTeamUniqueness(ctx context.Context, title string) (*domain.Team, error) {
 	var team domain.Team
 
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT 
-		    id, title, description, image_id, created_at, updated_at, disabled_at
+		    id, title, description, image_id, created_at, updated_at, disabled_at, slug
 		FROM teams
 		WHERE lower(title)=lower($1)`, title).Scan(
 		&team.ID,
@@ -171,6 +286,7 @@ func (r *TeamRepository) CheckTeamUniqueness(ctx context.Context, title string)
 		&team.CreatedAt,
 		&team.UpdatedAt,
 		&team.DisabledAt,
+		&team.Slug,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -181,3 +297,198 @@ func (r *TeamRepository) CheckTeamUniqueness(ctx context.Context, title string)
 
 	return &team, nil
 }
+
+func (r *TeamRepository) GetMembers(ctx context.Context, teamID int32) ([]domain.TeamMember, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT
+		    tm.user_id, tm.team_id, tm.role, tm.position, tm.created_at, tm.updated_at,
+		    u.name, u.surname, u.username
+		FROM team_members tm
+			JOIN users u ON u.id = tm.user_id
+		WHERE tm.team_id = $1`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting team %d members: %w", teamID, err)
+	}
+	defer rows.Close()
+
+	var (
+		m       domain.TeamMember
+		members []domain.TeamMember
+	)
+	for rows.Next() {
+		if err = rows.Scan(
+			&m.UserID,
+			&m.TeamID,
+			&m.Role,
+			&m.Position,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+			&m.Name,
+			&m.Surname,
+			&m.Username,
+		); err != nil {
+			return nil, fmt.Errorf("scanning team member: %w", err)
+		}
+
+		members = append(members, m)
+	}
+
+	return members, nil
+}
+
+func (r *TeamRepository) GetMember(ctx context.Context, memberID, teamID int32) (*domain.TeamMember, error) {
+	var m domain.TeamMember
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT
+		    tm.user_id, tm.team_id, tm.role, tm.position, tm.created_at, tm.updated_at,
+		    u.name, u.surname, u.username
+		FROM team_members tm
+			JOIN users u ON u.id=tm.user_id
+		WHERE tm.user_id=$1 AND tm.team_id=$2`, memberID, teamID).Scan(
+		&m.UserID,
+		&m.TeamID,
+		&m.Role,
+		&m.Position,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+		&m.Name,
+		&m.Surname,
+		&m.Username,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning team member: %w", err)
+	}
+
+	return &m, nil
+}
+
+// AddMember returns repository.ErrDuplicate if the user is already a member of the team.
+func (r *TeamRepository) AddMember(ctx context.Context, member *domain.TeamMember) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO team_members(team_id, user_id, role, position)
+		VALUES ($1,$2,$3,$4)`,
+		member.TeamID,
+		member.UserID,
+		member.Role,
+		member.Position,
+	)
+	if err != nil {
+		if uniqueViolation(err, "team_members_pkey") {
+			return repository.ErrDuplicate
+		}
+		return fmt.Errorf("inserting team member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) UpdateMember(ctx context.Context, member *domain.TeamMember) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE team_members
+		SET role=$3, position=$4, updated_at=now()
+		WHERE user_id=$1 AND team_id=$2`,
+		member.UserID,
+		member.TeamID,
+		member.Role,
+		member.Position,
+	)
+	if err != nil {
+		return fmt.Errorf("updating team member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) RemoveMember(ctx context.Context, memberID, teamID int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		DELETE FROM team_members
+		WHERE user_id=$1 AND team_id=$2`, memberID, teamID)
+	if err != nil {
+		return fmt.Errorf("deleting team member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) GetTeamTranslations(ctx context.Context, teamID int32) ([]domain.Translation, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT locale, title, description, updated_at
+		FROM team_translations
+		WHERE team_id=$1
+		ORDER BY locale`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("querying team translations: %w", err)
+	}
+	defer rows.Close()
+
+	translations := make([]domain.Translation, 0)
+	for rows.Next() {
+		var t domain.Translation
+		err = rows.Scan(&t.Locale, &t.Title, &t.Description, &t.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scanning team translation: %w", err)
+		}
+		translations = append(translations, t)
+	}
+
+	return translations, nil
+}
+
+// GetTeamTranslationsByLocale returns translations of the teams to the locale by team identifiers.
+// Teams without translation are missing in the result.
+func (r *TeamRepository) GetTeamTranslationsByLocale(ctx context.Context, teamIDs []int32, locale string) (map[int32]domain.Translation, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT team_id, locale, title, description, updated_at
+		FROM team_translations
+		WHERE team_id=ANY($1) AND locale=$2`, teamIDs, locale)
+	if err != nil {
+		return nil, fmt.Errorf("querying team translations: %w", err)
+	}
+	defer rows.Close()
+
+	translations := make(map[int32]domain.Translation)
+	for rows.Next() {
+		var (
+			id int32
+			t  domain.Translation
+		)
+		err = rows.Scan(&id, &t.Locale, &t.Title, &t.Description, &t.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scanning team translation: %w", err)
+		}
+		translations[id] = t
+	}
+
+	return translations, nil
+}
+
+// SaveTeamTranslation creates or replaces the translation to its locale.
+func (r *TeamRepository) SaveTeamTranslation(ctx context.Context, teamID int32, t *domain.Translation) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO team_translations(team_id, locale, title, description)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (team_id, locale) DO UPDATE
+		SET title=EXCLUDED.title, description=EXCLUDED.description, updated_at=now()`,
+		teamID, t.Locale, t.Title, t.Description)
+	if err != nil {
+		return fmt.Errorf("upserting team translation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) DeleteTeamTranslation(ctx context.Context, teamID int32, locale string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM team_translations WHERE team_id=$1 AND locale=$2`, teamID, locale)
+	if err != nil {
+		return fmt.Errorf("deleting team translation: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+// TechnologyRepository keeps the technology catalog and links projects to it.
+// Links are the source of truth, column projects.technologies is derived from them: it keeps canonical names
+// of the linked technologies for full-text search and is rebuilt whenever links or linked technologies change.
+type TechnologyRepository struct {
+	pool Driver
+}
+
+func NewTechnologyRepository(pool Driver) *TechnologyRepository {
+	return &TechnologyRepository{pool}
+}
+
+func (r *TechnologyRepository) GetTechnologies(ctx context.Context, filter *domain.TechnologyFilter) ([]domain.Technology, error) {
+	q := newListQuery()
+	if filter != nil && filter.Category != nil {
+		q.where("category = ?", *filter.Category)
+	}
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, name, aliases, icon, category
+		FROM technologies
+		`+q.whereSQL()+`
+		ORDER BY lower(name)`, q.args...)
+	if err != nil {
+		return nil, fmt.Errorf("selecting technologies: %w", err)
+	}
+	defer rows.Close()
+
+	technologies := make([]domain.Technology, 0)
+	for rows.Next() {
+		var t domain.Technology
+		err = rows.Scan(&t.ID, &t.Name, &t.Aliases, &t.Icon, &t.Category)
+		if err != nil {
+			return nil, fmt.Errorf("scanning technology: %w", err)
+		}
+		technologies = append(technologies, t)
+	}
+
+	return technologies, nil
+}
+
+func (r *TechnologyRepository) GetTechnology(ctx context.Context, id int32) (*domain.Technology, error) {
+	var t domain.Technology
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, name, aliases, icon, category
+		FROM technologies
+		WHERE id=$1`, id).
+		Scan(&t.ID, &t.Name, &t.Aliases, &t.Icon, &t.Category)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("selecting technology: %w", err)
+	}
+
+	return &t, nil
+}
+
+// FindTechnologies returns technologies with any of the names or aliases, case is ignored.
+func (r *TechnologyRepository) FindTechnologies(ctx context.Context, names []string) ([]domain.Technology, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT id, name, aliases, icon, category
+		FROM technologies
+		WHERE id IN (SELECT technology_id FROM technology_names WHERE lower_name = ANY($1))
+		ORDER BY lower(name)`, lowerNames(names))
+	if err != nil {
+		return nil, fmt.Errorf("selecting technologies: %w", err)
+	}
+	defer rows.Close()
+
+	var technologies []domain.Technology
+	for rows.Next() {
+		var t domain.Technology
+		err = rows.Scan(&t.ID, &t.Name, &t.Aliases, &t.Icon, &t.Category)
+		if err != nil {
+			return nil, fmt.Errorf("scanning technology: %w", err)
+		}
+		technologies = append(technologies, t)
+	}
+
+	return technologies, nil
+}
+
+// CreateTechnology returns repository.ErrDuplicate if the name or an alias belongs to another technology.
+func (r *TechnologyRepository) CreateTechnology(ctx context.Context, t *domain.Technology) (int32, error) {
+	var id int32
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		WITH created AS (
+			INSERT INTO technologies(name, aliases, icon, category)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id
+		), named AS (
+			INSERT INTO technology_names(lower_name, technology_id)
+			SELECT DISTINCT n, created.id FROM created, unnest($5::text[]) n
+		)
+		SELECT id FROM created`, t.Name, t.Aliases, t.Icon, t.Category, lowerNames(t.Names())).
+		Scan(&id)
+	if err != nil {
+		if uniqueViolation(err, "technology_names_pkey") {
+			return 0, repository.ErrDuplicate
+		}
+		return 0, fmt.Errorf("inserting technology: %w", err)
+	}
+
+	return id, nil
+}
+
+// UpdateTechnology updates the catalog entry and names of technologies of projects using it.
+// Returns repository.ErrDuplicate if the name or an alias belongs to another technology.
+func (r *TechnologyRepository) UpdateTechnology(ctx context.Context, t *domain.Technology) error {
+	// Names kept by the technology are neither deleted nor inserted, so the statements don't touch the same rows
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH unnamed AS (
+			DELETE FROM technology_names WHERE technology_id=$1 AND lower_name <> ALL($6)
+		), named AS (
+			INSERT INTO technology_names(lower_name, technology_id)
+			SELECT DISTINCT n, c.id
+			FROM technologies c, unnest($6::text[]) n
+			WHERE c.id=$1
+			  AND NOT EXISTS(SELECT 1 FROM technology_names WHERE lower_name=n AND technology_id=$1)
+		), renamed AS (
+			UPDATE projects p
+			SET technologies=(SELECT array_agg(n.name ORDER BY lower(n.name))
+			                  FROM (SELECT CASE WHEN c.id=$1 THEN $2 ELSE c.name END AS name
+			                        FROM project_technologies pt JOIN technologies c ON c.id = pt.technology_id
+			                        WHERE pt.project_id = p.id) n)
+			WHERE p.id IN (SELECT project_id FROM project_technologies WHERE technology_id=$1)
+		)
+		UPDATE technologies
+		SET name=$2, aliases=$3, icon=$4, category=$5
+		WHERE id=$1`, t.ID, t.Name, t.Aliases, t.Icon, t.Category, lowerNames(t.Names()))
+	if err != nil {
+		if uniqueViolation(err, "technology_names_pkey") {
+			return repository.ErrDuplicate
+		}
+		return fmt.Errorf("updating technology: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// DeleteTechnology removes the technology from the catalog and from projects using it.
+func (r *TechnologyRepository) DeleteTechnology(ctx context.Context, id int32) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH removed AS (
+			UPDATE projects p
+			SET technologies=COALESCE((SELECT array_agg(c.name ORDER BY lower(c.name))
+			                           FROM project_technologies pt JOIN technologies c ON c.id = pt.technology_id
+			                           WHERE pt.project_id = p.id AND c.id<>$1), '{}')
+			WHERE p.id IN (SELECT project_id FROM project_technologies WHERE technology_id=$1)
+		)
+		DELETE FROM technologies WHERE id=$1`, id)
+	if err != nil {
+		return fmt.Errorf("deleting technology: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// SetProjectTechnologies links the project to exactly the given technologies and rebuilds names of its technologies.
+func (r *TechnologyRepository) SetProjectTechnologies(ctx context.Context, projectID int32, technologyIDs []int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH unlinked AS (
+			DELETE FROM project_technologies WHERE project_id=$1 AND technology_id <> ALL($2)
+		), linked AS (
+			INSERT INTO project_technologies(project_id, technology_id)
+			SELECT $1, unnest($2::int4[])
+			ON CONFLICT DO NOTHING
+		)
+		UPDATE projects
+		SET technologies=COALESCE((SELECT array_agg(name ORDER BY lower(name)) FROM technologies WHERE id = ANY($2)), '{}')
+		WHERE id=$1`, projectID, technologyIDs)
+	if err != nil {
+		return fmt.Errorf("setting project technologies: %w", err)
+	}
+
+	return nil
+}
+
+// GetTechnologyUsage returns technologies used by projects which are not in the trash, most used first.
+// Only active projects are counted if activeOnly is set. Zero limit returns all used technologies.
+func (r *TechnologyRepository) GetTechnologyUsage(ctx context.Context, limit int, activeOnly bool) ([]domain.TechnologyUsage, error) {
+	rows, err := conn(ctx, r.pool).Query(ctx, `
+		SELECT t.id, t.name, t.aliases, t.icon, t.category, count(*)
+		FROM technologies t
+		JOIN project_technologies pt ON pt.technology_id = t.id
+		JOIN projects p ON p.id = pt.project_id
+		WHERE p.deleted_at IS NULL AND (p.isactive OR NOT $2)
+		GROUP BY t.id
+		ORDER BY count(*) DESC, lower(t.name)
+		LIMIT NULLIF($1, 0)`, limit, activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("selecting technology usage: %w", err)
+	}
+	defer rows.Close()
+
+	usage := make([]domain.TechnologyUsage, 0)
+	for rows.Next() {
+		var u domain.TechnologyUsage
+		err = rows.Scan(&u.ID, &u.Name, &u.Aliases, &u.Icon, &u.Category, &u.ProjectCount)
+		if err != nil {
+			return nil, fmt.Errorf("scanning technology usage: %w", err)
+		}
+		usage = append(usage, u)
+	}
+
+	return usage, nil
+}
+
+// lowerNames returns names as they are kept in technology_names.
+func lowerNames(names []string) []string {
+	lower := make([]string, 0, len(names))
+	for _, name := range names {
+		lower = append(lower, strings.ToLower(strings.TrimSpace(name)))
+	}
+	return lower
+}
@@ -0,0 +1,312 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/infrastructure/repository/postgresql"
+)
+
+func prepareTechnologyMock(t *testing.T) (pgxmock.PgxPoolIface, *postgresql.TechnologyRepository) {
+	t.Helper()
+
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mock, postgresql.NewTechnologyRepository(mock)
+}
+
+func TestTechnologyRepository_GetTechnology(t *testing.T) {
+	mock, repo := prepareTechnologyMock(t)
+
+	q := `
+		SELECT id, name, aliases, icon, category
+		FROM technologies
+		WHERE id=$1`
+
+	tests := []struct {
+		name        string
+		expected    *domain.Technology
+		expectedErr error
+		mock        func()
+	}{
+		{
+			name: "should pass",
+			expected: &domain.Technology{
+				ID: 1, Name: "Go", Aliases: []string{"golang"}, Category: domain.TechnologyCategoryLanguage,
+			},
+			mock: func() {
+				rows := mock.NewRows([]string{"id", "name", "aliases", "icon", "category"}).
+					AddRow(int32(1), "Go", []string{"golang"}, "", domain.TechnologyCategoryLanguage)
+				mock.ExpectQuery(q).WithArgs(int32(1)).WillReturnRows(rows)
+			},
+		},
+		{
+			name:        "no technology",
+			expectedErr: repository.ErrObjectNotFound,
+			mock: func() {
+				mock.ExpectQuery(q).WithArgs(int32(1)).WillReturnError(pgx.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock()
+
+			technology, err := repo.GetTechnology(context.Background(), 1)
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+			if tc.expectedErr != nil {
+				require.ErrorIs(tt, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(tt, err)
+			require.Equal(tt, tc.expected, technology)
+		})
+	}
+}
+
+func TestTechnologyRepository_FindTechnologies(t *testing.T) {
+	mock, repo := prepareTechnologyMock(t)
+
+	q := `
+		SELECT id, name, aliases, icon, category
+		FROM technologies
+		WHERE id IN (SELECT technology_id FROM technology_names WHERE lower_name = ANY($1))
+		ORDER BY lower(name)`
+
+	rows := mock.NewRows([]string{"id", "name", "aliases", "icon", "category"}).
+		AddRow(int32(2), "PostgreSQL", []string{"postgres", "pg"}, "", domain.TechnologyCategoryDatabase)
+	mock.ExpectQuery(q).WithArgs([]string{"pg", "postgresql"}).WillReturnRows(rows)
+
+	technologies, err := repo.FindTechnologies(context.Background(), []string{" PG", "PostgreSQL"})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.NoError(t, err)
+	require.Equal(t, []domain.Technology{
+		{ID: 2, Name: "PostgreSQL", Aliases: []string{"postgres", "pg"}, Category: domain.TechnologyCategoryDatabase},
+	}, technologies)
+}
+
+func TestTechnologyRepository_CreateTechnology(t *testing.T) {
+	mock, repo := prepareTechnologyMock(t)
+
+	q := `
+		WITH created AS (
+			INSERT INTO technologies(name, aliases, icon, category)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id
+		), named AS (
+			INSERT INTO technology_names(lower_name, technology_id)
+			SELECT DISTINCT n, created.id FROM created, unnest($5::text[]) n
+		)
+		SELECT id FROM created`
+
+	technology := &domain.Technology{Name: "Golang", Aliases: []string{" Go"}, Category: domain.TechnologyCategoryLanguage}
+	args := []any{"Golang", []string{" Go"}, "", domain.TechnologyCategoryLanguage, []string{"golang", "go"}}
+
+	tests := []struct {
+		name        string
+		expectedID  int32
+		expectedErr error
+		mock        func()
+	}{
+		{
+			name:       "should pass",
+			expectedID: 1,
+			mock: func() {
+				rows := mock.NewRows([]string{"id"}).AddRow(int32(1))
+				mock.ExpectQuery(q).WithArgs(args...).WillReturnRows(rows)
+			},
+		},
+		{
+			name:        "name taken",
+			expectedErr: repository.ErrDuplicate,
+			mock: func() {
+				mock.ExpectQuery(q).WithArgs(args...).
+					WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "technology_names_pkey"})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock()
+
+			id, err := repo.CreateTechnology(context.Background(), technology)
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+			if tc.expectedErr != nil {
+				require.ErrorIs(tt, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(tt, err)
+			require.Equal(tt, tc.expectedID, id)
+		})
+	}
+}
+
+func TestTechnologyRepository_UpdateTechnology(t *testing.T) {
+	mock, repo := prepareTechnologyMock(t)
+
+	q := `
+		WITH unnamed AS (
+			DELETE FROM technology_names WHERE technology_id=$1 AND lower_name <> ALL($6)
+		), named AS (
+			INSERT INTO technology_names(lower_name, technology_id)
+			SELECT DISTINCT n, c.id
+			FROM technologies c, unnest($6::text[]) n
+			WHERE c.id=$1
+			  AND NOT EXISTS(SELECT 1 FROM technology_names WHERE lower_name=n AND technology_id=$1)
+		), renamed AS (
+			UPDATE projects p
+			SET technologies=(SELECT array_agg(n.name ORDER BY lower(n.name))
+			                  FROM (SELECT CASE WHEN c.id=$1 THEN $2 ELSE c.name END AS name
+			                        FROM project_technologies pt JOIN technologies c ON c.id = pt.technology_id
+			                        WHERE pt.project_id = p.id) n)
+			WHERE p.id IN (SELECT project_id FROM project_technologies WHERE technology_id=$1)
+		)
+		UPDATE technologies
+		SET name=$2, aliases=$3, icon=$4, category=$5
+		WHERE id=$1`
+
+	technology := &domain.Technology{ID: 1, Name: "Golang", Aliases: []string{"go"}, Category: domain.TechnologyCategoryLanguage}
+	args := []any{int32(1), "Golang", []string{"go"}, "", domain.TechnologyCategoryLanguage, []string{"golang", "go"}}
+
+	tests := []struct {
+		name        string
+		expectedErr error
+		mock        func()
+	}{
+		{
+			name: "should pass",
+			mock: func() {
+				mock.ExpectExec(q).WithArgs(args...).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:        "no technology",
+			expectedErr: repository.ErrObjectNotFound,
+			mock: func() {
+				mock.ExpectExec(q).WithArgs(args...).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+		},
+		{
+			name:        "name taken",
+			expectedErr: repository.ErrDuplicate,
+			mock: func() {
+				mock.ExpectExec(q).WithArgs(args...).
+					WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "technology_names_pkey"})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock()
+
+			err := repo.UpdateTechnology(context.Background(), technology)
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+			if tc.expectedErr != nil {
+				require.ErrorIs(tt, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(tt, err)
+		})
+	}
+}
+
+func TestTechnologyRepository_DeleteTechnology(t *testing.T) {
+	mock, repo := prepareTechnologyMock(t)
+
+	q := `
+		WITH removed AS (
+			UPDATE projects p
+			SET technologies=COALESCE((SELECT array_agg(c.name ORDER BY lower(c.name))
+			                           FROM project_technologies pt JOIN technologies c ON c.id = pt.technology_id
+			                           WHERE pt.project_id = p.id AND c.id<>$1), '{}')
+			WHERE p.id IN (SELECT project_id FROM project_technologies WHERE technology_id=$1)
+		)
+		DELETE FROM technologies WHERE id=$1`
+
+	tests := []struct {
+		name        string
+		expectedErr error
+		mock        func()
+	}{
+		{
+			name: "should pass",
+			mock: func() {
+				mock.ExpectExec(q).WithArgs(int32(1)).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+		},
+		{
+			name:        "no technology",
+			expectedErr: repository.ErrObjectNotFound,
+			mock: func() {
+				mock.ExpectExec(q).WithArgs(int32(1)).WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock()
+
+			err := repo.DeleteTechnology(context.Background(), 1)
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+			if tc.expectedErr != nil {
+				require.ErrorIs(tt, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(tt, err)
+		})
+	}
+}
+
+func TestTechnologyRepository_SetProjectTechnologies(t *testing.T) {
+	mock, repo := prepareTechnologyMock(t)
+
+	q := `
+		WITH unlinked AS (
+			DELETE FROM project_technologies WHERE project_id=$1 AND technology_id <> ALL($2)
+		), linked AS (
+			INSERT INTO project_technologies(project_id, technology_id)
+			SELECT $1, unnest($2::int4[])
+			ON CONFLICT DO NOTHING
+		)
+		UPDATE projects
+		SET technologies=COALESCE((SELECT array_agg(name ORDER BY lower(name)) FROM technologies WHERE id = ANY($2)), '{}')
+		WHERE id=$1`
+
+	mock.ExpectExec(q).WithArgs(int32(7), []int32{1, 2}).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err := repo.SetProjectTechnologies(context.Background(), 7, []int32{1, 2})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.NoError(t, err)
+}
@@ -0,0 +1,247 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+type TwoFactorRepository struct {
+	pool Driver
+}
+
+func NewTwoFactorRepository(pool Driver) *TwoFactorRepository {
+	return &TwoFactorRepository{pool}
+}
+
+func (r *TwoFactorRepository) GetTwoFactor(ctx context.Context, userID int32) (*domain.TwoFactor, error) {
+	var tf domain.TwoFactor
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT user_id, secret, last_step, enabled_at
+		FROM user_totp
+		WHERE user_id=$1`, userID).Scan(
+		&tf.UserID,
+		&tf.Secret,
+		&tf.LastStep,
+		&tf.EnabledAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning user totp: %w", err)
+	}
+
+	return &tf, nil
+}
+
+// SaveTwoFactorSecret starts enrollment replacing the pending one.
+func (r *TwoFactorRepository) SaveTwoFactorSecret(ctx context.Context, userID int32, secret string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		INSERT INTO user_totp(user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret=excluded.secret, last_step=0, created_at=now(), enabled_at=NULL`,
+		userID, secret)
+	if err != nil {
+		return fmt.Errorf("upserting user totp: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TwoFactorRepository) EnableTwoFactor(ctx context.Context, userID int32) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `UPDATE user_totp SET enabled_at=now() WHERE user_id=$1`, userID)
+	if err != nil {
+		return fmt.Errorf("updating user totp: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// UseTwoFactorStep saves the time step of the used code.
+// Returns repository.ErrObjectNotFound if a code of the same or later step has been used already.
+func (r *TwoFactorRepository) UseTwoFactorStep(ctx context.Context, userID int32, step int64) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE user_totp SET last_step=$2
+		WHERE user_id=$1 AND last_step < $2`, userID, step)
+	if err != nil {
+		return fmt.Errorf("updating user totp step: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+// AddTwoFactorCodeAttempt counts an attempt to confirm a code and returns the number of attempts.
+// Attempts are counted from scratch if the previous one happened before windowStart.
+func (r *TwoFactorRepository) AddTwoFactorCodeAttempt(ctx context.Context, userID int32, windowStart time.Time) (int, error) {
+	var attempts int
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		UPDATE user_totp
+		SET code_attempts = CASE
+				WHEN code_attempted_at < $2 THEN 1
+				ELSE code_attempts + 1
+			END,
+			code_attempted_at = now()
+		WHERE user_id=$1
+		RETURNING code_attempts`, userID, windowStart).Scan(&attempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrObjectNotFound
+		}
+		return 0, fmt.Errorf("updating user totp attempts: %w", err)
+	}
+
+	return attempts, nil
+}
+
+func (r *TwoFactorRepository) ResetTwoFactorCodeAttempts(ctx context.Context, userID int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE user_totp SET code_attempts=0, code_attempted_at=NULL
+		WHERE user_id=$1`, userID)
+	if err != nil {
+		return fmt.Errorf("updating user totp attempts: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTwoFactor deletes TOTP settings and recovery codes of the user.
+func (r *TwoFactorRepository) DeleteTwoFactor(ctx context.Context, userID int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH d AS (
+			DELETE FROM recovery_codes WHERE user_id=$1
+		)
+		DELETE FROM user_totp WHERE user_id=$1`, userID)
+	if err != nil {
+		return fmt.Errorf("deleting user totp: %w", err)
+	}
+
+	return nil
+}
+
+// ReplaceRecoveryCodes deletes all recovery codes of the user and saves the new ones.
+func (r *TwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID int32, hashes []string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH d AS (
+			DELETE FROM recovery_codes WHERE user_id=$1
+		)
+		INSERT INTO recovery_codes(user_id, code_hash)
+		SELECT $1, unnest($2::text[])`, userID, hashes)
+	if err != nil {
+		return fmt.Errorf("inserting recovery codes: %w", err)
+	}
+
+	return nil
+}
+
+// UseRecoveryCode marks the code used. Returns repository.ErrObjectNotFound if there is no such unused code.
+func (r *TwoFactorRepository) UseRecoveryCode(ctx context.Context, userID int32, hash string) error {
+	tag, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE recovery_codes SET used_at=now()
+		WHERE user_id=$1 AND code_hash=$2 AND used_at IS NULL`, userID, hash)
+	if err != nil {
+		return fmt.Errorf("updating recovery code: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrObjectNotFound
+	}
+
+	return nil
+}
+
+func (r *TwoFactorRepository) CountRecoveryCodes(ctx context.Context, userID int32) (int, error) {
+	var count int
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT count(*) FROM recovery_codes
+		WHERE user_id=$1 AND used_at IS NULL`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("counting recovery codes: %w", err)
+	}
+
+	return count, nil
+}
+
+// CreateTwoFactorChallenge saves the challenge, expired challenges are deleted along the way.
+func (r *TwoFactorRepository) CreateTwoFactorChallenge(ctx context.Context, challenge *domain.TwoFactorChallenge) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH d AS (
+			DELETE FROM two_factor_challenges WHERE expires_at <= now()
+		)
+		INSERT INTO two_factor_challenges(token_hash, user_id, expires_at)
+		VALUES ($1, $2, $3)`,
+		challenge.Hash,
+		challenge.UserID,
+		challenge.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting two factor challenge: %w", err)
+	}
+
+	return nil
+}
+
+// GetTwoFactorChallenge returns challenge which is not expired.
+func (r *TwoFactorRepository) GetTwoFactorChallenge(ctx context.Context, hash string) (*domain.TwoFactorChallenge, error) {
+	var challenge domain.TwoFactorChallenge
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT token_hash, user_id, attempts, expires_at
+		FROM two_factor_challenges
+		WHERE token_hash=$1 AND expires_at > now()`, hash).Scan(
+		&challenge.Hash,
+		&challenge.UserID,
+		&challenge.Attempts,
+		&challenge.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning two factor challenge: %w", err)
+	}
+
+	return &challenge, nil
+}
+
+// AddTwoFactorChallengeAttempt counts a failed attempt and returns the number of attempts.
+func (r *TwoFactorRepository) AddTwoFactorChallengeAttempt(ctx context.Context, hash string) (int, error) {
+	var attempts int
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		UPDATE two_factor_challenges SET attempts=attempts+1
+		WHERE token_hash=$1
+		RETURNING attempts`, hash).Scan(&attempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrObjectNotFound
+		}
+		return 0, fmt.Errorf("updating two factor challenge: %w", err)
+	}
+
+	return attempts, nil
+}
+
+func (r *TwoFactorRepository) DeleteTwoFactorChallenge(ctx context.Context, hash string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `DELETE FROM two_factor_challenges WHERE token_hash=$1`, hash)
+	if err != nil {
+		return fmt.Errorf("deleting two factor challenge: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// querier is implemented by both connection pool and transaction.
+type querier interface {
+	Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(context.Context, string, ...interface{}) pgx.Row
+	Query(context.Context, string, ...interface{}) (pgx.Rows, error)
+}
+
+type txKey struct{}
+
+// conn returns transaction started by TxManager if the context has one, otherwise the pool.
+// Repositories must use it for all queries, so they take part in the caller's transaction.
+func conn(ctx context.Context, pool Driver) querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return pool
+}
+
+// TxManager runs repository calls in a single database transaction.
+type TxManager struct {
+	pool Driver
+}
+
+func NewTxManager(pool Driver) *TxManager {
+	return &TxManager{pool}
+}
+
+// WithinTx runs fn in a transaction which is committed if fn succeeds and rolled back otherwise.
+// Repositories called with the context passed to fn use the transaction.
+// Nested calls join the outer transaction.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			rollback(ctx, tx)
+			panic(p)
+		}
+	}()
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		rollback(ctx, tx)
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func rollback(ctx context.Context, tx pgx.Tx) {
+	// Rollback must not be skipped because of the canceled request context.
+	err := tx.Rollback(context.WithoutCancel(ctx))
+	if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+		slog.Error("Rolling back transaction", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,144 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/infrastructure/repository/postgresql"
+)
+
+func TestTxManager_WithinTx(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txManager := postgresql.NewTxManager(mock)
+	repo := postgresql.NewDocumentRepository(mock)
+
+	addQuery := `
+		INSERT INTO project_documents(document_id, project_id)
+		VALUES ($1, $2)`
+	removeQuery := `
+		DELETE FROM project_documents
+		WHERE document_id=$1 AND project_id=$2`
+
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name string
+		err  error
+		mock func()
+		fn   func(ctx context.Context) error
+	}{
+		{
+			name: "should commit",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(addQuery).WithArgs(int32(2), int32(1)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(removeQuery).WithArgs(int32(3), int32(1)).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+				mock.ExpectCommit()
+			},
+			fn: func(ctx context.Context) error {
+				err := repo.AddDocumentToProject(ctx, 2, 1)
+				if err != nil {
+					return err
+				}
+				return repo.RemoveDocumentFromProject(ctx, 3, 1)
+			},
+		},
+		{
+			name: "should rollback on repository error",
+			err:  errDB,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(addQuery).WithArgs(int32(2), int32(1)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec(removeQuery).WithArgs(int32(3), int32(1)).WillReturnError(errDB)
+				mock.ExpectRollback()
+			},
+			fn: func(ctx context.Context) error {
+				err := repo.AddDocumentToProject(ctx, 2, 1)
+				if err != nil {
+					return err
+				}
+				return repo.RemoveDocumentFromProject(ctx, 3, 1)
+			},
+		},
+		{
+			name: "should rollback on service error",
+			err:  errDB,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(addQuery).WithArgs(int32(2), int32(1)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectRollback()
+			},
+			fn: func(ctx context.Context) error {
+				err := repo.AddDocumentToProject(ctx, 2, 1)
+				if err != nil {
+					return err
+				}
+				return errDB
+			},
+		},
+		{
+			name: "nested call joins transaction",
+			err:  errDB,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(addQuery).WithArgs(int32(2), int32(1)).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectRollback()
+			},
+			fn: func(ctx context.Context) error {
+				err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+					return repo.AddDocumentToProject(ctx, 2, 1)
+				})
+				if err != nil {
+					return err
+				}
+				return errDB
+			},
+		},
+		{
+			name: "begin error",
+			err:  errDB,
+			mock: func() {
+				mock.ExpectBegin().WillReturnError(errDB)
+			},
+			fn: func(ctx context.Context) error {
+				return repo.AddDocumentToProject(ctx, 2, 1)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mock()
+
+			err := txManager.WithinTx(context.Background(), tc.fn)
+			if tc.err != nil {
+				require.ErrorIs(tt, err, tc.err)
+			} else {
+				require.NoError(tt, err)
+			}
+
+			require.NoError(tt, mock.ExpectationsWereMet())
+		})
+	}
+
+	t.Run("should rollback on panic", func(tt *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		require.Panics(tt, func() {
+			_ = txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+				panic("boom")
+			})
+		})
+
+		require.NoError(tt, mock.ExpectationsWereMet())
+	})
+}
@@ -22,9 +22,10 @@ func NewUserRepository(pool Driver) *UserRepository {
 func (r *UserRepository) GetUser(ctx context.Context, id int32) (*domain.User, error) {
 	var user domain.User
 
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT 
-		    id, name, surname, username, email, created_at, updated_at, disabled_at, role, is_teamlead, image_id
+		    id, name, surname, username, email, created_at, updated_at, disabled_at, role, is_teamlead, image_id,
+		    email_verified_at
         FROM users
         WHERE id = $1`, id).Scan(
 		&user.ID,
@@ -38,6 +39,7 @@ func (r *UserRepository) GetUser(ctx context.Context, id int32) (*domain.User, e
 		&user.Role,
 		&user.IsTeamLead,
 		&user.ImageID,
+		&user.EmailVerifiedAt,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -51,9 +53,10 @@ func (r *UserRepository) GetUser(ctx context.Context, id int32) (*domain.User, e
 
 func (r *UserRepository) GetActiveUser(ctx context.Context, id int32) (*domain.User, error) {
 	var user domain.User
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT
-		    id, name, surname, username, email, created_at, updated_at, disabled_at, role, is_teamlead, image_id
+		    id, name, surname, username, email, created_at, updated_at, disabled_at, role, is_teamlead, image_id,
+		    email_verified_at
         FROM users
         WHERE id = $1 AND disabled_at IS NULL`, id).Scan(
 		&user.ID,
@@ -67,6 +70,7 @@ func (r *UserRepository) GetActiveUser(ctx context.Context, id int32) (*domain.U
 		&user.Role,
 		&user.IsTeamLead,
 		&user.ImageID,
+		&user.EmailVerifiedAt,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -78,14 +82,48 @@ func (r *UserRepository) GetActiveUser(ctx context.Context, id int32) (*domain.U
 	return &user, nil
 }
 
-func (r *UserRepository) GetUsers(ctx context.Context) ([]domain.User, error) {
-	rows, err := r.pool.Query(ctx, `
+var userSortColumns = map[string]string{
+	"id":        "id",
+	"name":      "name",
+	"surname":   "surname",
+	"username":  "username",
+	"createdAt": "created_at",
+}
+
+// GetUsers returns the requested page of users and total number of users matching the filter.
+func (r *UserRepository) GetUsers(ctx context.Context, params *domain.ListParams, filter *domain.UserFilter) ([]domain.User, int, error) {
+	q := newListQuery()
+	if filter != nil {
+		if filter.Role != nil {
+			q.where("role = ?", *filter.Role)
+		}
+		if filter.Disabled != nil {
+			if *filter.Disabled {
+				q.whereRaw("disabled_at IS NOT NULL")
+			} else {
+				q.whereRaw("disabled_at IS NULL")
+			}
+		}
+	}
+	where := q.whereSQL()
+
+	var total int
+	err := conn(ctx, r.pool).QueryRow(ctx, `SELECT count(*) FROM users `+where, q.args...).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting users: %w", err)
+	}
+
+	page := q.pageSQL(params, userSortColumns, "id")
+
+	rows, err := conn(ctx, r.pool).Query(ctx, `
 		SELECT 
 		    id, name, surname, username, email, created_at, updated_at, disabled_at,
-		    role, is_teamlead, image_id
-        FROM users`)
+		    role, is_teamlead, image_id, email_verified_at
+        FROM users
+        `+where+`
+        `+page, q.args...)
 	if err != nil {
-		return nil, fmt.Errorf("selecting users: %w", err)
+		return nil, 0, fmt.Errorf("selecting users: %w", err)
 	}
 	defer rows.Close()
 
@@ -105,21 +143,22 @@ func (r *UserRepository) GetUsers(ctx context.Context) ([]domain.User, error) {
 			&user.Role,
 			&user.IsTeamLead,
 			&user.ImageID,
+			&user.EmailVerifiedAt,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("scanning user: %w", err)
+			return nil, 0, fmt.Errorf("scanning user: %w", err)
 		}
 
 		users = append(users, user)
 	}
 
-	return users, nil
+	return users, total, nil
 }
 
 func (r *UserRepository) CreateUser(ctx context.Context, user *domain.User) (int32, error) {
 	var userId int32
 
-	err := r.pool.QueryRow(ctx,
+	err := conn(ctx, r.pool).QueryRow(ctx,
 		`INSERT INTO users(name, surname, username, email, encoded_password, salt, role, is_teamlead, image_id)
 		 VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		 RETURNING  id`,
@@ -141,7 +180,7 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *domain.User) (int
 }
 
 func (r *UserRepository) UpdateUser(ctx context.Context, user *domain.User) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE users 
 		SET name=$2, surname=$3, role=$4, updated_at=now()
 		WHERE id = $1`,
@@ -158,7 +197,7 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user *domain.User) erro
 }
 
 func (r *UserRepository) DisableUser(ctx context.Context, id int32) error {
-	_, err := r.pool.Exec(ctx, `UPDATE users SET disabled_at=now() WHERE id=$1`, id)
+	_, err := conn(ctx, r.pool).Exec(ctx, `UPDATE users SET disabled_at=now() WHERE id=$1`, id)
 	if err != nil {
 		return fmt.Errorf("deleting user: %w", err)
 	}
@@ -166,10 +205,66 @@ func (r *UserRepository) DisableUser(ctx context.Context, id int32) error {
 	return nil
 }
 
+// UpdateUserPassword replaces user password hash.
+// Salt is stored inside the encoded hash, so the legacy salt column is cleared.
+func (r *UserRepository) UpdateUserPassword(ctx context.Context, id int32, encodedPassword string) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE users
+		SET encoded_password=$2, salt='', updated_at=now()
+		WHERE id=$1`,
+		id,
+		encodedPassword,
+	)
+	if err != nil {
+		return fmt.Errorf("updating user password: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserCredentials returns identifiers and password hash of active user.
+func (r *UserRepository) GetUserCredentials(ctx context.Context, id int32) (*domain.User, error) {
+	var user domain.User
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		SELECT id, username, email, encoded_password, salt
+        FROM users
+        WHERE id=$1 AND disabled_at IS NULL`,
+		id).Scan(
+		&user.ID,
+		&user.Username,
+		&user.Email,
+		&user.EncodedPassword,
+		&user.Salt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("scanning user: %w", err)
+	}
+
+	return &user, nil
+}
+
+func (r *UserRepository) SetUserEmailVerified(ctx context.Context, id int32) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		UPDATE users
+		SET email_verified_at=now(), updated_at=now()
+		WHERE id=$1 AND email_verified_at IS NULL`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("updating user: %w", err)
+	}
+
+	return nil
+}
+
 func (r *UserRepository) GetUserByLogin(ctx context.Context, login string) (*domain.User, error) {
 	var user domain.User
 
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT id, username, email, encoded_password, salt
         FROM users
         WHERE (lower(username)=lower($1) OR lower(email)=lower($1))
@@ -194,7 +289,7 @@ func (r *UserRepository) GetUserByLogin(ctx context.Context, login string) (*dom
 func (r *UserRepository) CheckUserUniqueness(ctx context.Context, username, email string) (*domain.User, error) {
 	var user domain.User
 
-	err := r.pool.QueryRow(ctx, `
+	err := conn(ctx, r.pool).QueryRow(ctx, `
 		SELECT id, username, email
         FROM users
         WHERE (lower(username)=lower($1) OR lower(email)=lower($2))
@@ -215,7 +310,7 @@ func (r *UserRepository) CheckUserUniqueness(ctx context.Context, username, emai
 }
 
 func (r *UserRepository) SetUserImage(ctx context.Context, userID int32, imageID string) error {
-	_, err := r.pool.Exec(ctx, `
+	_, err := conn(ctx, r.pool).Exec(ctx, `
 		UPDATE users 
 		SET image_id=$2, updated_at=now()
 		WHERE id = $1`,
@@ -123,7 +123,8 @@ func TestUserRepository_GetUser(t *testing.T) {
 	mock, repo := prepareUserMock(t)
 
 	q := `SELECT 
-		    id, name, surname, username, email, created_at, updated_at, disabled_at, role, is_teamlead, image_id
+		    id, name, surname, username, email, created_at, updated_at, disabled_at, role, is_teamlead, image_id,
+		    email_verified_at
         FROM users
         WHERE id = $1`
 
@@ -157,7 +158,7 @@ func TestUserRepository_GetUser(t *testing.T) {
 				row := mock.NewRows([]string{
 					"id", "name", "surname", "username", "email",
 					"created_at", "updated_at", "disabled_at", "role",
-					"is_teamlead", "image_id",
+					"is_teamlead", "image_id", "email_verified_at",
 				}).
 					AddRow(
 						id,
@@ -171,6 +172,7 @@ func TestUserRepository_GetUser(t *testing.T) {
 						domain.UserRole(1),
 						true,
 						"test",
+						nil,
 					)
 
 				mock.ExpectQuery(q).WithArgs(id).WillReturnRows(row)
@@ -212,7 +214,7 @@ func TestUserRepository_GetActiveUser(t *testing.T) {
 	q := `
 		SELECT
 		    id, name, surname, username, email, created_at, 
-		    updated_at, disabled_at, role, is_teamlead, image_id
+		    updated_at, disabled_at, role, is_teamlead, image_id, email_verified_at
         FROM users
         WHERE id = $1 AND disabled_at IS NULL`
 
@@ -246,7 +248,7 @@ func TestUserRepository_GetActiveUser(t *testing.T) {
 				row := mock.NewRows([]string{
 					"id", "name", "surname", "username", "email",
 					"created_at", "updated_at", "disabled_at", "role",
-					"is_teamlead", "image_id",
+					"is_teamlead", "image_id", "email_verified_at",
 				}).
 					AddRow(
 						id,
@@ -260,6 +262,7 @@ func TestUserRepository_GetActiveUser(t *testing.T) {
 						domain.UserRole(1),
 						true,
 						"test",
+						nil,
 					)
 
 				mock.ExpectQuery(q).WithArgs(id).WillReturnRows(row)
@@ -368,22 +371,32 @@ func TestUserRepository_CheckUserUniqueness(t *testing.T) {
 func TestUserRepository_GetUsers(t *testing.T) {
 	mock, repo := prepareUserMock(t)
 
+	countQ := `SELECT count(*) FROM users WHERE role = $1`
+
 	q := `
 		SELECT 
 		    id, name, surname, username, email, created_at, updated_at, disabled_at,
-		    role, is_teamlead, image_id
-        FROM users`
+		    role, is_teamlead, image_id, email_verified_at
+        FROM users
+        WHERE role = $1
+        ORDER BY name DESC, id LIMIT $2 OFFSET $3`
+
+	role := domain.UserRole(1)
+	params := &domain.ListParams{Limit: 2, Offset: 0, Sort: []domain.SortField{{Name: "name", Desc: true}}}
+	filter := &domain.UserFilter{Role: &role}
 
 	tempTime := time.Now()
 
 	tests := []struct {
 		name     string
 		response []domain.User
+		total    int
 		wantErr  bool
 		mock     func()
 	}{
 		{
-			name: "should pass",
+			name:  "should pass",
+			total: 2,
 			response: []domain.User{
 				{
 					ID:         1,
@@ -416,7 +429,7 @@ func TestUserRepository_GetUsers(t *testing.T) {
 			mock: func() {
 				rows := mock.NewRows([]string{
 					"id", "name", "surname", "username", "email", "created_at", "updated_at", "disabled_at",
-					"role", "is_teamlead", "image_id",
+					"role", "is_teamlead", "image_id", "email_verified_at",
 				}).
 					AddRow(
 						int32(1),
@@ -430,6 +443,7 @@ func TestUserRepository_GetUsers(t *testing.T) {
 						domain.UserRole(1),
 						true,
 						"image1",
+						nil,
 					).
 					AddRow(
 						int32(2),
@@ -443,9 +457,19 @@ func TestUserRepository_GetUsers(t *testing.T) {
 						domain.UserRole(2),
 						false,
 						"image2",
+						nil,
 					)
 
-				mock.ExpectQuery(q).WillReturnRows(rows).RowsWillBeClosed()
+				mock.ExpectQuery(countQ).WithArgs(role).WillReturnRows(mock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectQuery(q).WithArgs(role, 2, 0).WillReturnRows(rows).RowsWillBeClosed()
+			},
+		},
+		{
+			name:     "count error",
+			response: nil,
+			wantErr:  true,
+			mock: func() {
+				mock.ExpectQuery(countQ).WithArgs(role).WillReturnError(errors.New("some err"))
 			},
 		},
 		{
@@ -453,7 +477,8 @@ func TestUserRepository_GetUsers(t *testing.T) {
 			response: nil,
 			wantErr:  true,
 			mock: func() {
-				mock.ExpectQuery(q).WillReturnError(errors.New("some err"))
+				mock.ExpectQuery(countQ).WithArgs(role).WillReturnRows(mock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectQuery(q).WithArgs(role, 2, 0).WillReturnError(errors.New("some err"))
 			},
 		},
 	}
@@ -464,7 +489,7 @@ func TestUserRepository_GetUsers(t *testing.T) {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mock()
 
-			resp, err := repo.GetUsers(context.Background())
+			resp, total, err := repo.GetUsers(context.Background(), params, filter)
 
 			require.NoError(tt, mock.ExpectationsWereMet())
 			if tc.wantErr {
@@ -474,6 +499,7 @@ func TestUserRepository_GetUsers(t *testing.T) {
 
 			require.NoError(tt, err)
 			require.Equal(tt, tc.response, resp)
+			require.Equal(tt, tc.total, total)
 		})
 	}
 }
@@ -0,0 +1,66 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+type UserTokenRepository struct {
+	pool Driver
+}
+
+func NewUserTokenRepository(pool Driver) *UserTokenRepository {
+	return &UserTokenRepository{pool}
+}
+
+// CreateUserToken saves token replacing the previous tokens of the user with the same purpose,
+// so only the latest sent token is valid.
+func (r *UserTokenRepository) CreateUserToken(ctx context.Context, token *domain.UserToken) error {
+	_, err := conn(ctx, r.pool).Exec(ctx, `
+		WITH d AS (
+			DELETE FROM user_tokens WHERE user_id=$2 AND purpose=$3
+		)
+		INSERT INTO user_tokens(token_hash, user_id, purpose, expires_at)
+		VALUES ($1, $2, $3, $4)`,
+		token.Hash,
+		token.UserID,
+		token.Purpose,
+		token.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting user token: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeUserToken deletes token and returns its user.
+// Returns repository.ErrObjectNotFound if the token does not exist or expired, expired token is deleted too.
+func (r *UserTokenRepository) ConsumeUserToken(ctx context.Context, hash string, purpose domain.UserTokenPurpose) (int32, error) {
+	var (
+		userID int32
+		valid  bool
+	)
+
+	err := conn(ctx, r.pool).QueryRow(ctx, `
+		DELETE FROM user_tokens
+		WHERE token_hash=$1 AND purpose=$2
+		RETURNING user_id, expires_at > now()`, hash, purpose).Scan(&userID, &valid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrObjectNotFound
+		}
+		return 0, fmt.Errorf("deleting user token: %w", err)
+	}
+	if !valid {
+		return 0, repository.ErrObjectNotFound
+	}
+
+	return userID, nil
+}
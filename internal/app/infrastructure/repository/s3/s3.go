@@ -0,0 +1,170 @@
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+const (
+	mimeDetectSize = 3072     // Default read limit of mime type detector
+	partSize       = 16 << 20 // Size of multipart upload parts, also bounds memory used per upload
+)
+
+// Config describes connection to an S3-compatible object storage (AWS S3, MinIO, etc.).
+type Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+}
+
+// Storage keeps files as objects of a single bucket, file names are used as object keys.
+type Storage struct {
+	client *minio.Client
+	bucket string
+}
+
+// New connects to the storage and creates the bucket if it does not exist yet.
+func New(ctx context.Context, cfg Config) (*Storage, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("bucket is not specified")
+	}
+
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating s3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("checking bucket %q: %w", cfg.Bucket, err)
+	}
+	if !exists {
+		err = client.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region})
+		if err != nil {
+			return nil, fmt.Errorf("creating bucket %q: %w", cfg.Bucket, err)
+		}
+	}
+
+	return &Storage{client: client, bucket: cfg.Bucket}, nil
+}
+
+func (s *Storage) Save(ctx context.Context, r io.Reader, size int64, fileName string) error {
+	br := bufio.NewReaderSize(r, mimeDetectSize)
+	head, _ := br.Peek(mimeDetectSize) // Error means the data is shorter, that is fine for detection
+	if len(head) == 0 {
+		return fmt.Errorf("data is empty")
+	}
+	r = br
+
+	opts := minio.PutObjectOptions{ContentType: mimetype.Detect(head).String(), PartSize: partSize}
+
+	if size < 0 {
+		// Unknown size forces multipart upload, so buffer up to one part
+		// to upload small files with a single request.
+		buf := bytes.NewBuffer(make([]byte, 0, len(head)))
+		n, err := io.CopyN(buf, r, partSize+1)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("reading data: %w", err)
+		}
+		if n <= partSize {
+			size = n
+		}
+		r = io.MultiReader(buf, r)
+	}
+
+	_, err := s.client.PutObject(ctx, s.bucket, objectKey(fileName), r, size, opts)
+	if err != nil {
+		return fmt.Errorf("putting object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) Open(ctx context.Context, fileName string) (*domain.File, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, objectKey(fileName), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting object: %w", err)
+	}
+
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		if isNotFound(err) {
+			return nil, repository.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("getting object info: %w", err)
+	}
+
+	return &domain.File{
+		ReadSeekCloser: obj,
+		Size:           info.Size,
+		ModTime:        info.LastModified,
+		ETag:           `"` + info.ETag + `"`,
+	}, nil
+}
+
+func (s *Storage) Delete(ctx context.Context, fileName string) error {
+	key := objectKey(fileName)
+
+	// S3 does not report missing objects on removal, so check it explicitly
+	// to behave the same way as the filesystem storage.
+	_, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		if isNotFound(err) {
+			return repository.ErrObjectNotFound
+		}
+		return fmt.Errorf("getting object info: %w", err)
+	}
+
+	err = s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("removing object: %w", err)
+	}
+
+	return nil
+}
+
+// Exists reports whether the file is already stored.
+func (s *Storage) Exists(ctx context.Context, fileName string) (bool, error) {
+	_, err := s.client.StatObject(ctx, s.bucket, objectKey(fileName), minio.StatObjectOptions{})
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("getting object info: %w", err)
+	}
+
+	return true, nil
+}
+
+// objectKey converts file name used by services to the object key,
+// so "users/1.png" is stored as the same key on every platform.
+func objectKey(fileName string) string {
+	return strings.TrimPrefix(path.Clean(filepath.ToSlash(fileName)), "/")
+}
+
+func isNotFound(err error) bool {
+	code := minio.ToErrorResponse(err).Code
+	return code == "NoSuchKey" || code == "NotFound"
+}
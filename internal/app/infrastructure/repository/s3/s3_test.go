@@ -0,0 +1,227 @@
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+// fakeS3 is a minimal in-memory stand-in for MinIO which understands path-style
+// requests of a single bucket. Request signatures are not verified.
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]map[string][]byte
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, Config) {
+	f := &fakeS3{buckets: make(map[string]map[string][]byte)}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	return f, Config{
+		Endpoint:  strings.TrimPrefix(srv.URL, "http://"),
+		Region:    "us-east-1",
+		Bucket:    "files",
+		AccessKey: "minioadmin",
+		SecretKey: "minioadmin",
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	objects, bucketExists := f.buckets[bucket]
+
+	if key == "" {
+		switch r.Method {
+		case http.MethodHead:
+			if !bucketExists {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case http.MethodPut:
+			f.buckets[bucket] = make(map[string][]byte)
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+		return
+	}
+
+	if !bucketExists {
+		writeError(w, r, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := readBody(r)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		objects[key] = data
+		w.Header().Set("ETag", etag(data))
+	case http.MethodGet, http.MethodHead:
+		data, ok := objects[key]
+		if !ok {
+			writeError(w, r, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("ETag", etag(data))
+		http.ServeContent(w, r, key, time.Now(), bytes.NewReader(data))
+	case http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		_, _ = fmt.Fprintf(w, "<Error><Code>%s</Code><Resource>%s</Resource></Error>", code, r.URL.Path)
+	}
+}
+
+// readBody reads request payload decoding aws-chunked encoding which
+// the client uses for signed uploads over plain HTTP.
+func readBody(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var data []byte
+	br := bufio.NewReader(r.Body)
+	for {
+		header, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(header), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return data, nil
+		}
+
+		chunk := make([]byte, size+2) // Chunk data is followed by CRLF
+		if _, err = io.ReadFull(br, chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:size]...)
+	}
+}
+
+func etag(data []byte) string {
+	sum := md5.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func TestNew(t *testing.T) {
+	f, cfg := newFakeS3(t)
+
+	_, err := New(context.Background(), cfg)
+	require.NoError(t, err)
+	require.Contains(t, f.buckets, cfg.Bucket)
+
+	cfg.Bucket = ""
+	_, err = New(context.Background(), cfg)
+	require.Error(t, err)
+}
+
+func TestStorage_SaveOpen(t *testing.T) {
+	_, cfg := newFakeS3(t)
+
+	s, err := New(context.Background(), cfg)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		size int64
+	}{
+		{name: "known size", size: 14},
+		{name: "unknown size", size: -1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			fileContent := "hello, world!\n"
+
+			err := s.Save(context.Background(), strings.NewReader(fileContent), tc.size, "documents/test.txt")
+			require.NoError(tt, err)
+
+			file, err := s.Open(context.Background(), "documents/test.txt")
+			require.NoError(tt, err)
+			defer file.Close()
+
+			require.Equal(tt, int64(len(fileContent)), file.Size)
+			require.Equal(tt, etag([]byte(fileContent)), file.ETag)
+
+			content, err := io.ReadAll(file)
+			require.NoError(tt, err)
+			require.Equal(tt, fileContent, string(content))
+
+			_, err = file.Seek(7, io.SeekStart)
+			require.NoError(tt, err)
+			content, err = io.ReadAll(file)
+			require.NoError(tt, err)
+			require.Equal(tt, "world!\n", string(content))
+		})
+	}
+
+	_, err = s.Open(context.Background(), "documents/missing.txt")
+	require.ErrorIs(t, err, repository.ErrObjectNotFound)
+
+	err = s.Save(context.Background(), strings.NewReader(""), -1, "documents/empty.txt")
+	require.Error(t, err)
+}
+
+func TestStorage_Delete(t *testing.T) {
+	f, cfg := newFakeS3(t)
+
+	s, err := New(context.Background(), cfg)
+	require.NoError(t, err)
+
+	err = s.Save(context.Background(), strings.NewReader("123"), 3, "test.txt")
+	require.NoError(t, err)
+
+	exists, err := s.Exists(context.Background(), "test.txt")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	err = s.Delete(context.Background(), "test.txt")
+	require.NoError(t, err)
+	require.Empty(t, f.buckets[cfg.Bucket])
+
+	err = s.Delete(context.Background(), "test.txt")
+	require.ErrorIs(t, err, repository.ErrObjectNotFound)
+
+	exists, err = s.Exists(context.Background(), "test.txt")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestObjectKey(t *testing.T) {
+	require.Equal(t, "users/1.png", objectKey("users/1.png"))
+	require.Equal(t, "users/1.png", objectKey("/users/../users/1.png"))
+}
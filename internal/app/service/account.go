@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/pkg/mail"
+)
+
+//go:generate mockgen -source=account.go -destination=./mocks/account.go -package=mocks
+type UserTokenRepository interface {
+	CreateUserToken(ctx context.Context, token *domain.UserToken) error
+	ConsumeUserToken(ctx context.Context, hash string, purpose domain.UserTokenPurpose) (int32, error)
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg *mail.Message) error
+}
+
+const (
+	passwordResetTTL     = time.Hour
+	emailVerificationTTL = 72 * time.Hour
+)
+
+// AccountMailer sends users single-use links for password reset and email verification.
+type AccountMailer struct {
+	tokens  UserTokenRepository
+	mailer  Mailer
+	linkURL string // Frontend URL the links point to
+}
+
+func NewAccountMailer(tokens UserTokenRepository, mailer Mailer, linkURL string) *AccountMailer {
+	return &AccountMailer{tokens, mailer, linkURL}
+}
+
+func (m *AccountMailer) sendPasswordReset(ctx context.Context, user *domain.User) error {
+	link, err := m.issueLink(ctx, user.ID, domain.UserTokenPasswordReset, passwordResetTTL, "/password/reset")
+	if err != nil {
+		return err
+	}
+
+	return m.mailer.Send(ctx, &mail.Message{
+		To:      user.Email,
+		Subject: "Password reset",
+		Body: fmt.Sprintf("Hello, %s!\n\n"+
+			"Someone requested a password reset for your account. To set a new password follow the link:\n\n%s\n\n"+
+			"The link is valid for %s. If you didn't request the reset, just ignore this email.\n",
+			user.Username, link, passwordResetTTL),
+	})
+}
+
+func (m *AccountMailer) sendEmailVerification(ctx context.Context, user *domain.User) error {
+	link, err := m.issueLink(ctx, user.ID, domain.UserTokenEmailVerification, emailVerificationTTL, "/email/verify")
+	if err != nil {
+		return err
+	}
+
+	return m.mailer.Send(ctx, &mail.Message{
+		To:      user.Email,
+		Subject: "Email verification",
+		Body: fmt.Sprintf("Hello, %s!\n\n"+
+			"To verify your email follow the link:\n\n%s\n\n"+
+			"The link is valid for %s.\n",
+			user.Username, link, emailVerificationTTL),
+	})
+}
+
+// consume checks the token sent by email and returns its user, the token can't be used again.
+func (m *AccountMailer) consume(ctx context.Context, token string, purpose domain.UserTokenPurpose) (int32, error) {
+	if token == "" {
+		return 0, apperr.NewInvalidRequest("Token is required.", "token")
+	}
+
+	userID, err := m.tokens.ConsumeUserToken(ctx, hashToken(token), purpose)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return 0, apperr.NewInvalidRequest("Token is invalid or expired.", "token")
+		}
+		return 0, fmt.Errorf("consuming %s token: %w", purpose, err)
+	}
+
+	return userID, nil
+}
+
+func (m *AccountMailer) issueLink(ctx context.Context, userID int32, purpose domain.UserTokenPurpose, ttl time.Duration, path string) (string, error) {
+	token, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = m.tokens.CreateUserToken(ctx, &domain.UserToken{
+		Hash:      hashToken(token),
+		UserID:    userID,
+		Purpose:   purpose,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", fmt.Errorf("saving %s token: %w", purpose, err)
+	}
+
+	link, err := url.JoinPath(m.linkURL, path)
+	if err != nil {
+		return "", fmt.Errorf("building link: %w", err)
+	}
+
+	return link + "?" + url.Values{"token": {token}}.Encode(), nil
+}
+
+// randomToken returns a random URL safe token.
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("generating token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken returns hash which is stored instead of the token, so leaked database doesn't leak valid tokens.
+// Tokens are random, so a fast hash is enough.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/pkg/auth"
+)
+
+//go:generate mockgen -source=api_token.go -destination=./mocks/api_token.go -package=mocks
+type APITokenRepository interface {
+	CreateAPIToken(ctx context.Context, token *domain.APIToken) (int32, error)
+	GetAPIToken(ctx context.Context, id int32) (*domain.APIToken, error)
+	GetAPITokenByHash(ctx context.Context, hash string) (*domain.APIToken, error)
+	GetUserAPITokens(ctx context.Context, userID int32) ([]domain.APIToken, error)
+	TouchAPIToken(ctx context.Context, id int32, at time.Time) error
+	DeleteAPIToken(ctx context.Context, id, userID int32) error
+	DeleteUserAPITokens(ctx context.Context, userID int32) error
+}
+
+// apiTokenPrefix makes tokens recognizable, e.g. by secret scanners.
+const apiTokenPrefix = "wst_"
+
+type APITokenService struct {
+	repo APITokenRepository
+}
+
+func NewAPITokenService(repo APITokenRepository) *APITokenService {
+	return &APITokenService{repo}
+}
+
+// CreateAPIToken issues a new token for the authorized user. The token itself is returned only once.
+// Tokens can be created only within a session, so a leaked token can't be used to issue new ones.
+func (s *APITokenService) CreateAPIToken(ctx context.Context, req *domain.CreateAPITokenRequest) (*domain.APIToken, error) {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperr.NewUnauthorized("Authorization required.")
+	}
+	if ac.SessionID == "" {
+		return nil, apperr.NewForbidden("API tokens can be created only by signed in user.")
+	}
+
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	plain, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	plain = apiTokenPrefix + plain
+
+	id, err := s.repo.CreateAPIToken(ctx, &domain.APIToken{
+		UserID:    ac.UserID,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		Hash:      hashToken(plain),
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating api token: %w", err)
+	}
+
+	token, err := s.repo.GetAPIToken(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting created api token %d: %w", id, err)
+	}
+	token.Token = plain
+
+	return token, nil
+}
+
+// GetAPITokens returns tokens of the authorized user.
+func (s *APITokenService) GetAPITokens(ctx context.Context) ([]domain.APIToken, error) {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperr.NewUnauthorized("Authorization required.")
+	}
+
+	tokens, err := s.repo.GetUserAPITokens(ctx, ac.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("getting user %d api tokens: %w", ac.UserID, err)
+	}
+
+	return tokens, nil
+}
+
+// RevokeAPIToken deletes token of the authorized user.
+func (s *APITokenService) RevokeAPIToken(ctx context.Context, id int32) error {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return apperr.NewUnauthorized("Authorization required.")
+	}
+
+	err := s.repo.DeleteAPIToken(ctx, id, ac.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewNotFound("token_id")
+		}
+		return fmt.Errorf("deleting api token %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// Authenticate returns the valid token and updates its last used time.
+func (s *APITokenService) Authenticate(ctx context.Context, plain string) (*domain.APIToken, error) {
+	if !strings.HasPrefix(plain, apiTokenPrefix) {
+		return nil, apperr.NewUnauthorized("Invalid API token.")
+	}
+
+	token, err := s.repo.GetAPITokenByHash(ctx, hashToken(plain))
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewUnauthorized("Invalid API token.")
+		}
+		return nil, fmt.Errorf("getting api token: %w", err)
+	}
+
+	now := time.Now()
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastSeenPrecision {
+		err = s.repo.TouchAPIToken(ctx, token.ID, now)
+		if err != nil {
+			slog.Error("Updating api token last used", slog.Int("token_id", int(token.ID)), slog.String("error", err.Error()))
+		} else {
+			token.LastUsedAt = &now
+		}
+	}
+
+	return token, nil
+}
@@ -0,0 +1,104 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+	"web-studio-backend/internal/pkg/auth"
+)
+
+func apiToken(t *testing.T) (*service.APITokenService, *mocks.MockAPITokenRepository) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockAPITokenRepository(mockCtl)
+
+	return service.NewAPITokenService(repo), repo
+}
+
+func TestAPITokenService_CreateAPIToken(t *testing.T) {
+	t.Parallel()
+
+	req := &domain.CreateAPITokenRequest{Name: "ci", Scopes: []domain.APITokenScope{domain.APITokenScopeRead}}
+
+	t.Run("should return token once", func(t *testing.T) {
+		serv, repo := apiToken(t)
+		ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 1, SessionID: "sid"})
+
+		var hash string
+		repo.EXPECT().CreateAPIToken(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, token *domain.APIToken) (int32, error) {
+				require.Equal(t, int32(1), token.UserID)
+				require.Empty(t, token.Token)
+				hash = token.Hash
+				return 5, nil
+			})
+		repo.EXPECT().GetAPIToken(ctx, int32(5)).Return(&domain.APIToken{ID: 5, UserID: 1, Name: "ci"}, nil)
+
+		res, err := serv.CreateAPIToken(ctx, req)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(res.Token, "wst_"))
+		require.Equal(t, hashToken(res.Token), hash)
+	})
+
+	t.Run("should forbid token auth", func(t *testing.T) {
+		serv, _ := apiToken(t)
+		ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 1})
+
+		_, err := serv.CreateAPIToken(ctx, req)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.ForbiddenType, appErr.Type)
+	})
+}
+
+func TestAPITokenService_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should touch token", func(t *testing.T) {
+		serv, repo := apiToken(t)
+
+		repo.EXPECT().GetAPITokenByHash(ctx, hashToken("wst_token")).Return(&domain.APIToken{ID: 1, UserID: 2}, nil)
+		repo.EXPECT().TouchAPIToken(ctx, int32(1), gomock.Any()).Return(nil)
+
+		token, err := serv.Authenticate(ctx, "wst_token")
+		require.NoError(t, err)
+		require.Equal(t, int32(2), token.UserID)
+		require.NotNil(t, token.LastUsedAt)
+	})
+
+	t.Run("should not touch recently used token", func(t *testing.T) {
+		serv, repo := apiToken(t)
+
+		now := time.Now()
+		repo.EXPECT().GetAPITokenByHash(ctx, hashToken("wst_token")).Return(&domain.APIToken{ID: 1, LastUsedAt: &now}, nil)
+
+		_, err := serv.Authenticate(ctx, "wst_token")
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail on unknown token", func(t *testing.T) {
+		serv, repo := apiToken(t)
+
+		repo.EXPECT().GetAPITokenByHash(ctx, hashToken("wst_token")).Return(nil, repository.ErrObjectNotFound)
+
+		_, err := serv.Authenticate(ctx, "wst_token")
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.UnauthorizedType, appErr.Type)
+	})
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/pkg/auth"
+)
+
+//go:generate mockgen -source=audit.go -destination=./mocks/audit.go -package=mocks
+type AuditRepository interface {
+	CreateRecord(ctx context.Context, rec *domain.AuditRecord) error
+	GetRecords(ctx context.Context, params *domain.ListParams, filter *domain.AuditFilter) ([]domain.AuditRecord, int, error)
+}
+
+// Auditor records mutating actions.
+// before and after are states of the entity, nil before means creation, nil after means deletion.
+type Auditor interface {
+	Record(ctx context.Context, action domain.AuditAction, entity domain.AuditEntity, entityID int32, before, after any)
+}
+
+type AuditService struct {
+	repo AuditRepository
+}
+
+func NewAuditService(repo AuditRepository) *AuditService {
+	return &AuditService{repo}
+}
+
+// Record saves audit record with the actor from the auth context.
+// Actions done without authenticated user, e.g. by background jobs, are recorded without the actor.
+// Action is already done when it is recorded, so failures are logged instead of being returned.
+func (s *AuditService) Record(ctx context.Context, action domain.AuditAction, entity domain.AuditEntity, entityID int32, before, after any) {
+	var actorID *int32
+	if ac, ok := auth.FromContext(ctx); ok {
+		actorID = &ac.UserID
+	}
+
+	diff, err := domain.NewAuditDiff(before, after)
+	if err != nil {
+		slog.Error("Making audit diff", slog.String("entity", string(entity)), slog.String("error", err.Error()))
+		return
+	}
+
+	err = s.repo.CreateRecord(ctx, &domain.AuditRecord{
+		ActorID:    actorID,
+		Action:     action,
+		EntityType: entity,
+		EntityID:   entityID,
+		Diff:       diff,
+	})
+	if err != nil {
+		slog.Error("Saving audit record",
+			slog.String("action", string(action)),
+			slog.String("entity", string(entity)),
+			slog.Int("entity_id", int(entityID)),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// RecordSystem saves audit record of an event caused by the application itself, e.g. account lockout.
+func (s *AuditService) RecordSystem(ctx context.Context, action domain.AuditAction, entity domain.AuditEntity, entityID int32, details any) {
+	diff, err := domain.NewAuditDiff(nil, details)
+	if err != nil {
+		slog.Error("Making audit diff", slog.String("entity", string(entity)), slog.String("error", err.Error()))
+		return
+	}
+
+	err = s.repo.CreateRecord(ctx, &domain.AuditRecord{
+		Action:     action,
+		EntityType: entity,
+		EntityID:   entityID,
+		Diff:       diff,
+	})
+	if err != nil {
+		slog.Error("Saving audit record",
+			slog.String("action", string(action)),
+			slog.String("entity", string(entity)),
+			slog.Int("entity_id", int(entityID)),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// GetRecords returns the requested page of audit records and total number of records matching the filter.
+func (s *AuditService) GetRecords(ctx context.Context, params *domain.ListParams, filter *domain.AuditFilter) ([]domain.AuditRecord, int, error) {
+	records, total, err := s.repo.GetRecords(ctx, params, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting audit records: %w", err)
+	}
+
+	return records, total, nil
+}
@@ -0,0 +1,63 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+	"web-studio-backend/internal/pkg/auth"
+)
+
+func TestAuditService_Record(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	defer mockCtl.Finish()
+
+	repo := mocks.NewMockAuditRepository(mockCtl)
+	serv := service.NewAuditService(repo)
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 7, Role: domain.UserRoleAdmin})
+
+	repo.EXPECT().CreateRecord(ctx, gomock.Cond(func(x any) bool {
+		rec := x.(*domain.AuditRecord)
+		return rec.ActorID != nil && *rec.ActorID == 7 &&
+			rec.Action == domain.AuditActionUpdate &&
+			rec.EntityType == domain.AuditEntityProjectCategory &&
+			rec.EntityID == 1 &&
+			string(rec.Diff) == `{"name":{"old":"Web","new":"Mobile"}}`
+	})).Return(nil)
+
+	serv.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityProjectCategory, 1,
+		&domain.ProjectCategory{ID: 1, Name: "Web"},
+		&domain.ProjectCategory{ID: 1, Name: "Mobile"},
+	)
+
+	// Failure is only logged, because the action is already done
+	repo.EXPECT().CreateRecord(ctx, gomock.Any()).Return(errors.New("unknown"))
+
+	serv.Record(ctx, domain.AuditActionDelete, domain.AuditEntityProjectCategory, 1, nil, nil)
+}
+
+func TestAuditService_Record_WithoutActor(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+
+	repo := mocks.NewMockAuditRepository(mockCtl)
+	serv := service.NewAuditService(repo)
+
+	ctx := context.Background()
+
+	repo.EXPECT().CreateRecord(ctx, gomock.Cond(func(x any) bool {
+		rec := x.(*domain.AuditRecord)
+		return rec.ActorID == nil && rec.Action == domain.AuditActionDelete && rec.EntityID == 1
+	})).Return(nil)
+
+	serv.Record(ctx, domain.AuditActionDelete, domain.AuditEntityProject, 1, &domain.Project{ID: 1}, nil)
+}
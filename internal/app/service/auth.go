@@ -5,28 +5,81 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"web-studio-backend/internal/app/domain"
 	"web-studio-backend/internal/app/domain/apperr"
 	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/pkg/auth"
 	"web-studio-backend/internal/pkg/auth/session"
+	"web-studio-backend/internal/pkg/passhash"
+	"web-studio-backend/internal/pkg/strhelp"
 )
 
 //go:generate mockgen -source=auth.go -destination=./mocks/auth.go -package=mocks
 type AuthRepository interface {
 	GetUserByLogin(ctx context.Context, login string) (*domain.User, error)
 	GetActiveUser(ctx context.Context, id int32) (*domain.User, error)
+	GetUserCredentials(ctx context.Context, id int32) (*domain.User, error)
+	UpdateUserPassword(ctx context.Context, id int32, encodedPassword string) error
+	SetUserEmailVerified(ctx context.Context, id int32) error
 }
 
+// SessionStore keeps user sessions.
+// Sessions are kept under hash of their ID, so leaked store doesn't leak valid sessions.
+// All session IDs passed to the store are such hashes.
+// Get must return session.ErrSessionNotFound if session does not exist or expired.
+type SessionStore interface {
+	Create(ctx context.Context, sess *session.Session) error
+	Get(ctx context.Context, sessionID string) (*session.Session, error)
+	Touch(ctx context.Context, sessionID string, at time.Time) error
+	ListUserSessions(ctx context.Context, userID int32) ([]session.Session, error)
+	Delete(ctx context.Context, sessionID string) error
+	DeleteUserSessions(ctx context.Context, userID int32) error
+	DeleteOtherUserSessions(ctx context.Context, userID int32, keepSessionID string) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// lastSeenPrecision limits how often session last seen time is written, so not every request updates the store.
+const lastSeenPrecision = time.Minute
+
 type AuthService struct {
-	repo AuthRepository
+	repo      AuthRepository
+	sessions  SessionStore
+	apiTokens APITokenRepository
+	hasher    passhash.Hasher
+	accounts  *AccountMailer
+	twoFactor *TwoFactorService
+	limiter   *SignInLimiter
 }
 
-func NewAuthService(repo AuthRepository) *AuthService {
-	return &AuthService{repo}
+func NewAuthService(
+	repo AuthRepository,
+	sessions SessionStore,
+	apiTokens APITokenRepository,
+	hasher passhash.Hasher,
+	accounts *AccountMailer,
+	twoFactor *TwoFactorService,
+	limiter *SignInLimiter,
+) *AuthService {
+	return &AuthService{repo, sessions, apiTokens, hasher, accounts, twoFactor, limiter}
 }
 
 func (s *AuthService) SignIn(ctx context.Context, req *domain.SignInRequest) (*domain.SignInResponse, error) {
+	err := s.limiter.reserveIP(ctx, req.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	// Password is not checked while the login is locked, so guessing makes no progress.
+	// Login is counted before the user is looked up, so unknown and known logins are throttled alike
+	failures, err := s.limiter.reserveLogin(ctx, req.Login)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := s.repo.GetUserByLogin(ctx, req.Login)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
@@ -35,25 +88,354 @@ func (s *AuthService) SignIn(ctx context.Context, req *domain.SignInRequest) (*d
 		return nil, fmt.Errorf("getting user by login: %w", err)
 	}
 
-	if !user.ComparePassword(req.Password) {
+	if !user.ComparePassword(s.hasher, req.Password) {
 		slog.Debug("Invalid password")
+		s.limiter.fail(ctx, user.ID, req.IP, failures)
 		return nil, apperr.NewInvalidRequest("Invalid credentials.", "")
 	}
 
-	sessionID, csrfToken, err := session.New(user.ID)
+	err = s.limiter.releaseIP(ctx, req.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.limiter.releaseLogin(ctx, req.Login)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.hasher.NeedsRehash(user.PasswordHash()) {
+		s.rehashPassword(ctx, user.ID, req.Password)
+	}
+
+	// Failed attempts to the account are forgotten once the session is started, possibly after the second factor
+	return s.twoFactor.startSignIn(ctx, user.ID, req.IP, req.UserAgent)
+}
+
+// UnlockUser lifts sign in lockout of the user.
+func (s *AuthService) UnlockUser(ctx context.Context, userID int32) error {
+	user, err := s.repo.GetUserCredentials(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewNotFound("user_id")
+		}
+		return fmt.Errorf("getting user %d: %w", userID, err)
+	}
+
+	return s.limiter.UnlockUser(ctx, user)
+}
+
+// maxUserAgentLength limits the client description stored with a session, in bytes.
+const maxUserAgentLength = 512
+
+// startSession signs in the authenticated user.
+func startSession(ctx context.Context, sessions SessionStore, userID int32, ip, userAgent string) (*domain.SignInResponse, error) {
+	sess, err := session.New(userID)
 	if err != nil {
 		return nil, fmt.Errorf("creating new session: %w", err)
 	}
+	sess.IP = ip
+	sess.UserAgent = truncateUserAgent(userAgent)
+
+	sessionID := sess.ID
+	sess.ID = hashToken(sessionID)
+
+	err = sessions.Create(ctx, sess)
+	if err != nil {
+		return nil, fmt.Errorf("saving session: %w", err)
+	}
 
 	return &domain.SignInResponse{
 		SessionID: sessionID,
-		CSRFToken: csrfToken,
-		UserID:    user.ID,
+		CSRFToken: sess.CSRFToken,
+		UserID:    userID,
 	}, nil
 }
 
-func (s *AuthService) SignOut(_ context.Context, sessionID string) {
-	session.Delete(sessionID)
+// truncateUserAgent cuts the header to maxUserAgentLength without splitting a character.
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) <= maxUserAgentLength {
+		return userAgent
+	}
+
+	n := maxUserAgentLength
+	for n > 0 && !utf8.RuneStart(userAgent[n]) {
+		n--
+	}
+
+	return userAgent[:n]
+}
+
+// rehashPassword replaces password hash produced by a legacy algorithm or with outdated parameters.
+// Failure is not fatal for signing in, the password will be rehashed next time.
+func (s *AuthService) rehashPassword(ctx context.Context, userID int32, password string) {
+	encoded, err := s.hasher.Hash(password)
+	if err != nil {
+		slog.Error("Rehashing password", slog.Int("user_id", int(userID)), slog.String("error", err.Error()))
+		return
+	}
+
+	err = s.repo.UpdateUserPassword(ctx, userID, encoded)
+	if err != nil {
+		slog.Error("Saving rehashed password", slog.Int("user_id", int(userID)), slog.String("error", err.Error()))
+	}
+}
+
+func (s *AuthService) SignOut(ctx context.Context, sessionID string) error {
+	err := s.sessions.Delete(ctx, hashToken(sessionID))
+	if err != nil {
+		return fmt.Errorf("deleting session: %w", err)
+	}
+
+	return nil
+}
+
+// GetSession returns the session by ID from the cookie.
+// ID of the returned session is the hash the session is stored under.
+func (s *AuthService) GetSession(ctx context.Context, sessionID string) (*session.Session, error) {
+	sess, err := s.sessions.Get(ctx, hashToken(sessionID))
+	if err != nil {
+		if errors.Is(err, session.ErrSessionNotFound) {
+			return nil, apperr.NewUnauthorized("Session not found.")
+		}
+		return nil, fmt.Errorf("getting session: %w", err)
+	}
+
+	now := time.Now()
+	if now.Sub(sess.LastSeenAt) >= lastSeenPrecision {
+		err = s.sessions.Touch(ctx, sess.ID, now)
+		if err != nil {
+			slog.Error("Updating session last seen", slog.String("error", err.Error()))
+		} else {
+			sess.LastSeenAt = now
+		}
+	}
+
+	return sess, nil
+}
+
+// GetSessions returns active sessions of the authorized user.
+func (s *AuthService) GetSessions(ctx context.Context) ([]domain.Session, error) {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperr.NewUnauthorized("Authorization required.")
+	}
+
+	sessions, err := s.sessions.ListUserSessions(ctx, ac.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("listing user %d sessions: %w", ac.UserID, err)
+	}
+
+	res := make([]domain.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		res = append(res, domain.Session{
+			ID:         sess.PublicID(),
+			IP:         sess.IP,
+			UserAgent:  sess.UserAgent,
+			CreatedAt:  sess.CreatedAt,
+			LastSeenAt: sess.LastSeenAt,
+			ExpiresAt:  sess.ExpiresAt,
+			Current:    sess.ID == ac.SessionID,
+		})
+	}
+
+	return res, nil
+}
+
+// RevokeSession ends session of the authorized user by its public ID.
+func (s *AuthService) RevokeSession(ctx context.Context, publicID string) error {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return apperr.NewUnauthorized("Authorization required.")
+	}
+
+	sessions, err := s.sessions.ListUserSessions(ctx, ac.UserID)
+	if err != nil {
+		return fmt.Errorf("listing user %d sessions: %w", ac.UserID, err)
+	}
+
+	for _, sess := range sessions {
+		if sess.PublicID() != publicID {
+			continue
+		}
+
+		err = s.sessions.Delete(ctx, sess.ID)
+		if err != nil {
+			return fmt.Errorf("deleting session: %w", err)
+		}
+		return nil
+	}
+
+	return apperr.NewNotFound("session_id")
+}
+
+// RevokeOtherSessions ends all sessions of the authorized user except the current one.
+func (s *AuthService) RevokeOtherSessions(ctx context.Context) error {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return apperr.NewUnauthorized("Authorization required.")
+	}
+
+	err := s.sessions.DeleteOtherUserSessions(ctx, ac.UserID, ac.SessionID)
+	if err != nil {
+		return fmt.Errorf("deleting user %d sessions: %w", ac.UserID, err)
+	}
+
+	return nil
+}
+
+// ForgotPassword sends password reset link to the user with the given email.
+// Unknown email is not reported, so the request can't be used to find out registered emails.
+func (s *AuthService) ForgotPassword(ctx context.Context, req *domain.ForgotPasswordRequest) error {
+	if !strhelp.ValidateEmail(req.Email) {
+		return apperr.NewInvalidRequest("Email has invalid format.", "email")
+	}
+
+	user, err := s.repo.GetUserByLogin(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			slog.Debug("Password reset for unknown email")
+			return nil
+		}
+		return fmt.Errorf("getting user by email: %w", err)
+	}
+
+	// Matched username is not an email to send the link to
+	if !strings.EqualFold(user.Email, req.Email) {
+		return nil
+	}
+
+	err = s.accounts.sendPasswordReset(ctx, user)
+	if err != nil {
+		slog.Error("Sending password reset", slog.Int("user_id", int(user.ID)), slog.String("error", err.Error()))
+	}
+
+	return nil
+}
+
+// ResetPassword sets a new password using the token sent by email, ends all user sessions and revokes API tokens,
+// since the tokens could be created by whoever had a session.
+func (s *AuthService) ResetPassword(ctx context.Context, req *domain.ResetPasswordRequest) error {
+	err := domain.ValidatePassword(req.Password, "password")
+	if err != nil {
+		return err
+	}
+
+	userID, err := s.accounts.consume(ctx, req.Token, domain.UserTokenPasswordReset)
+	if err != nil {
+		return err
+	}
+
+	err = s.setPassword(ctx, userID, req.Password)
+	if err != nil {
+		return err
+	}
+
+	err = s.sessions.DeleteUserSessions(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("deleting user %d sessions: %w", userID, err)
+	}
+
+	err = s.apiTokens.DeleteUserAPITokens(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("deleting user %d api tokens: %w", userID, err)
+	}
+
+	return nil
+}
+
+// ChangePassword replaces password of the authorized user, the current password must be confirmed.
+// Other sessions of the user are ended, the current one is kept.
+func (s *AuthService) ChangePassword(ctx context.Context, req *domain.ChangePasswordRequest) error {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return apperr.NewUnauthorized("Authorization required.")
+	}
+
+	err := domain.ValidatePassword(req.NewPassword, "newPassword")
+	if err != nil {
+		return err
+	}
+
+	user, err := s.repo.GetUserCredentials(ctx, ac.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewUnauthorized("User not found.")
+		}
+		return fmt.Errorf("getting user %d: %w", ac.UserID, err)
+	}
+
+	if !user.ComparePassword(s.hasher, req.CurrentPassword) {
+		return apperr.NewInvalidRequest("Invalid password.", "currentPassword")
+	}
+
+	err = s.setPassword(ctx, user.ID, req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	err = s.sessions.DeleteOtherUserSessions(ctx, user.ID, ac.SessionID)
+	if err != nil {
+		return fmt.Errorf("deleting user %d sessions: %w", user.ID, err)
+	}
+
+	return nil
+}
+
+func (s *AuthService) setPassword(ctx context.Context, userID int32, password string) error {
+	encoded, err := s.hasher.Hash(password)
+	if err != nil {
+		return fmt.Errorf("hashing password: %w", err)
+	}
+
+	err = s.repo.UpdateUserPassword(ctx, userID, encoded)
+	if err != nil {
+		return fmt.Errorf("updating user %d password: %w", userID, err)
+	}
+
+	return nil
+}
+
+// VerifyEmail confirms user email using the token sent to it.
+func (s *AuthService) VerifyEmail(ctx context.Context, req *domain.VerifyEmailRequest) error {
+	userID, err := s.accounts.consume(ctx, req.Token, domain.UserTokenEmailVerification)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.SetUserEmailVerified(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("setting user %d email verified: %w", userID, err)
+	}
+
+	return nil
+}
+
+// SendEmailVerification sends a new verification link to the email of the authorized user.
+func (s *AuthService) SendEmailVerification(ctx context.Context) error {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return apperr.NewUnauthorized("Authorization required.")
+	}
+
+	user, err := s.repo.GetActiveUser(ctx, ac.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewUnauthorized("User not found.")
+		}
+		return fmt.Errorf("getting user %d: %w", ac.UserID, err)
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return apperr.NewInvalidRequest("Email is already verified.", "")
+	}
+
+	err = s.accounts.sendEmailVerification(ctx, user)
+	if err != nil {
+		return fmt.Errorf("sending email verification: %w", err)
+	}
+
+	return nil
 }
 
 func (s *AuthService) CheckUserExists(ctx context.Context, id int32) error {
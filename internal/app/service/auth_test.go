@@ -0,0 +1,543 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+	"web-studio-backend/internal/pkg/auth"
+	"web-studio-backend/internal/pkg/auth/session"
+	"web-studio-backend/internal/pkg/mail"
+	"web-studio-backend/internal/pkg/passhash"
+)
+
+func TestAuthService_SignIn_RehashLegacyPassword(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+	defer mockCtl.Finish()
+
+	repo := mocks.NewMockAuthRepository(mockCtl)
+	sessions := mocks.NewMockSessionStore(mockCtl)
+	hasher := &passhash.Upgrading{
+		Current: &passhash.Bcrypt{Cost: 4},
+		Legacy:  []passhash.Hasher{passhash.SHA512{}},
+	}
+	twoFactorRepo := mocks.NewMockTwoFactorRepository(mockCtl)
+	throttles := mocks.NewMockSignInThrottleRepository(mockCtl)
+	tx := mocks.NewMockTxManager(mockCtl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(withinTx).AnyTimes()
+	limiter := service.NewSignInLimiter(throttles, tx, mocks.NewMockSystemAuditor(mockCtl), service.SignInPolicy{})
+	twoFactor := service.NewTwoFactorService(twoFactorRepo, sessions, limiter, nil, nil, service.TwoFactorPolicy{})
+	serv := service.NewAuthService(repo, sessions, mocks.NewMockAPITokenRepository(mockCtl), hasher, nil, twoFactor, limiter)
+
+	ctx := context.Background()
+
+	throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(1, nil)
+	repo.EXPECT().GetUserByLogin(ctx, "login").Return(&domain.User{
+		ID:              1,
+		Username:        "login",
+		EncodedPassword: fmt.Sprintf("%x", sha512.Sum512([]byte("password123"+"salt"))),
+		Salt:            "salt",
+	}, nil)
+	throttles.EXPECT().DeleteSignInThrottle(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(nil)
+	throttles.EXPECT().DeleteSignInThrottle(ctx, domain.SignInThrottleUser, "1", gomock.Any()).Return(repository.ErrObjectNotFound)
+	repo.EXPECT().UpdateUserPassword(ctx, int32(1), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int32, encoded string) error {
+			require.True(t, strings.HasPrefix(encoded, "$2a$"))
+			return nil
+		})
+	twoFactorRepo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(nil, repository.ErrObjectNotFound)
+	sessions.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	res, err := serv.SignIn(ctx, &domain.SignInRequest{Login: "login", Password: "password123"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), res.UserID)
+}
+
+func TestAuthService_SignIn_TruncatesUserAgent(t *testing.T) {
+	t.Parallel()
+
+	serv, m := authService(t)
+	ctx := context.Background()
+
+	encoded, err := (&passhash.Bcrypt{Cost: 4}).Hash("password123")
+	require.NoError(t, err)
+
+	m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(1, nil)
+	m.repo.EXPECT().GetUserByLogin(ctx, "login").Return(&domain.User{ID: 1, Username: "login", EncodedPassword: encoded}, nil)
+	m.throttles.EXPECT().DeleteSignInThrottle(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(nil)
+	m.throttles.EXPECT().DeleteSignInThrottle(ctx, domain.SignInThrottleUser, "1", gomock.Any()).Return(nil)
+	m.twoFactor.EXPECT().GetTwoFactor(ctx, int32(1)).Return(nil, repository.ErrObjectNotFound)
+	var stored *session.Session
+	m.sessions.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, sess *session.Session) error {
+		require.Equal(t, strings.Repeat("a", 511), sess.UserAgent)
+		stored = sess
+		return nil
+	})
+
+	// Two byte character crosses the limit and must not be split
+	res, err := serv.SignIn(ctx, &domain.SignInRequest{Login: "login", Password: "password123", UserAgent: strings.Repeat("a", 511) + "я" + "tail"})
+	require.NoError(t, err)
+	// Only hash of the session ID is stored
+	require.Equal(t, hashToken(res.SessionID), stored.ID)
+}
+
+type authMocks struct {
+	repo      *mocks.MockAuthRepository
+	sessions  *mocks.MockSessionStore
+	apiTokens *mocks.MockAPITokenRepository
+	tokens    *mocks.MockUserTokenRepository
+	mailer    *mocks.MockMailer
+	throttles *mocks.MockSignInThrottleRepository
+	audit     *mocks.MockSystemAuditor
+	twoFactor *mocks.MockTwoFactorRepository
+}
+
+func authService(t *testing.T) (*service.AuthService, authMocks) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	m := authMocks{
+		repo:      mocks.NewMockAuthRepository(mockCtl),
+		sessions:  mocks.NewMockSessionStore(mockCtl),
+		apiTokens: mocks.NewMockAPITokenRepository(mockCtl),
+		tokens:    mocks.NewMockUserTokenRepository(mockCtl),
+		mailer:    mocks.NewMockMailer(mockCtl),
+		throttles: mocks.NewMockSignInThrottleRepository(mockCtl),
+		audit:     mocks.NewMockSystemAuditor(mockCtl),
+		twoFactor: mocks.NewMockTwoFactorRepository(mockCtl),
+	}
+	accounts := service.NewAccountMailer(m.tokens, m.mailer, "https://studio.test")
+	tx := mocks.NewMockTxManager(mockCtl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(withinTx).AnyTimes()
+	limiter := service.NewSignInLimiter(m.throttles, tx, m.audit, service.SignInPolicy{
+		FreeAttempts:    2,
+		IPFreeAttempts:  5,
+		BackoffBase:     time.Second,
+		BackoffMax:      time.Minute,
+		LockoutAttempts: 4,
+		LockoutDuration: time.Hour,
+		Window:          time.Hour,
+	})
+	twoFactor := service.NewTwoFactorService(m.twoFactor, m.sessions, limiter, tx, testBlock(t), service.TwoFactorPolicy{})
+
+	return service.NewAuthService(m.repo, m.sessions, m.apiTokens, &passhash.Bcrypt{Cost: 4}, accounts, twoFactor, limiter), m
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestAuthService_ForgotPassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should send link", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.repo.EXPECT().GetUserByLogin(ctx, "user@mail.com").
+			Return(&domain.User{ID: 1, Username: "user", Email: "user@mail.com"}, nil)
+
+		var saved *domain.UserToken
+		m.tokens.EXPECT().CreateUserToken(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, token *domain.UserToken) error {
+				saved = token
+				return nil
+			})
+		m.mailer.EXPECT().Send(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, msg *mail.Message) error {
+				require.Equal(t, "user@mail.com", msg.To)
+
+				_, link, ok := strings.Cut(msg.Body, "https://studio.test/password/reset?token=")
+				require.True(t, ok)
+				token, _, _ := strings.Cut(link, "\n")
+
+				require.Equal(t, hashToken(token), saved.Hash)
+				require.Equal(t, int32(1), saved.UserID)
+				require.Equal(t, domain.UserTokenPasswordReset, saved.Purpose)
+				return nil
+			})
+
+		err := serv.ForgotPassword(ctx, &domain.ForgotPasswordRequest{Email: "user@mail.com"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should not reveal unknown email", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.repo.EXPECT().GetUserByLogin(ctx, "unknown@mail.com").Return(nil, repository.ErrObjectNotFound)
+
+		err := serv.ForgotPassword(ctx, &domain.ForgotPasswordRequest{Email: "unknown@mail.com"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail on invalid email", func(t *testing.T) {
+		serv, _ := authService(t)
+
+		err := serv.ForgotPassword(ctx, &domain.ForgotPasswordRequest{Email: "user"})
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+}
+
+func TestAuthService_ResetPassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should set password, end sessions and revoke api tokens", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.tokens.EXPECT().ConsumeUserToken(ctx, hashToken("token"), domain.UserTokenPasswordReset).Return(int32(1), nil)
+		m.repo.EXPECT().UpdateUserPassword(ctx, int32(1), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int32, encoded string) error {
+				require.True(t, strings.HasPrefix(encoded, "$2a$"))
+				return nil
+			})
+		m.sessions.EXPECT().DeleteUserSessions(ctx, int32(1)).Return(nil)
+		m.apiTokens.EXPECT().DeleteUserAPITokens(ctx, int32(1)).Return(nil)
+
+		err := serv.ResetPassword(ctx, &domain.ResetPasswordRequest{Token: "token", Password: "password123"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail on invalid token", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.tokens.EXPECT().ConsumeUserToken(ctx, hashToken("token"), domain.UserTokenPasswordReset).
+			Return(int32(0), repository.ErrObjectNotFound)
+
+		err := serv.ResetPassword(ctx, &domain.ResetPasswordRequest{Token: "token", Password: "password123"})
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+
+	t.Run("should fail on empty password", func(t *testing.T) {
+		serv, _ := authService(t)
+
+		err := serv.ResetPassword(ctx, &domain.ResetPasswordRequest{Token: "token", Password: ""})
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+}
+
+func TestAuthService_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 1, Role: domain.UserRoleUser, SessionID: "current"})
+
+	encoded, err := (&passhash.Bcrypt{Cost: 4}).Hash("password123")
+	require.NoError(t, err)
+
+	t.Run("should change password and end other sessions", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.repo.EXPECT().GetUserCredentials(ctx, int32(1)).Return(&domain.User{ID: 1, EncodedPassword: encoded}, nil)
+		m.repo.EXPECT().UpdateUserPassword(ctx, int32(1), gomock.Any()).Return(nil)
+		m.sessions.EXPECT().DeleteOtherUserSessions(ctx, int32(1), "current").Return(nil)
+
+		err := serv.ChangePassword(ctx, &domain.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "password456"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail on wrong current password", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.repo.EXPECT().GetUserCredentials(ctx, int32(1)).Return(&domain.User{ID: 1, EncodedPassword: encoded}, nil)
+
+		err := serv.ChangePassword(ctx, &domain.ChangePasswordRequest{CurrentPassword: "wrong", NewPassword: "password456"})
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+}
+
+func TestAuthService_GetSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 1, Role: domain.UserRoleUser, SessionID: "current"})
+	serv, m := authService(t)
+
+	current := session.Session{ID: "current", UserID: 1, IP: "10.0.0.1", UserAgent: "Firefox"}
+	other := session.Session{ID: "other", UserID: 1, IP: "10.0.0.2", UserAgent: "Chrome"}
+	m.sessions.EXPECT().ListUserSessions(ctx, int32(1)).Return([]session.Session{current, other}, nil)
+
+	res, err := serv.GetSessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, current.PublicID(), res[0].ID)
+	require.True(t, res[0].Current)
+	require.Equal(t, "10.0.0.2", res[1].IP)
+	require.False(t, res[1].Current)
+}
+
+func TestAuthService_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 1, Role: domain.UserRoleUser, SessionID: "current"})
+	other := session.Session{ID: "other", UserID: 1}
+
+	t.Run("should delete session", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.sessions.EXPECT().ListUserSessions(ctx, int32(1)).Return([]session.Session{other}, nil)
+		m.sessions.EXPECT().Delete(ctx, "other").Return(nil)
+
+		err := serv.RevokeSession(ctx, other.PublicID())
+		require.NoError(t, err)
+	})
+
+	t.Run("should not accept session id", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.sessions.EXPECT().ListUserSessions(ctx, int32(1)).Return([]session.Session{other}, nil)
+
+		err := serv.RevokeSession(ctx, "other")
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.NotFoundType, appErr.Type)
+	})
+}
+
+func TestAuthService_GetSession_TouchesLastSeen(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should touch stale session", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.sessions.EXPECT().Get(ctx, hashToken("id")).Return(&session.Session{ID: hashToken("id"), LastSeenAt: time.Now().Add(-time.Hour)}, nil)
+		m.sessions.EXPECT().Touch(ctx, hashToken("id"), gomock.Any()).Return(nil)
+
+		sess, err := serv.GetSession(ctx, "id")
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now(), sess.LastSeenAt, time.Second)
+	})
+
+	t.Run("should not touch recent session", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.sessions.EXPECT().Get(ctx, hashToken("id")).Return(&session.Session{ID: hashToken("id"), LastSeenAt: time.Now()}, nil)
+
+		_, err := serv.GetSession(ctx, "id")
+		require.NoError(t, err)
+	})
+}
+
+func TestAuthService_VerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serv, m := authService(t)
+
+	m.tokens.EXPECT().ConsumeUserToken(ctx, hashToken("token"), domain.UserTokenEmailVerification).Return(int32(1), nil)
+	m.repo.EXPECT().SetUserEmailVerified(ctx, int32(1)).Return(nil)
+
+	err := serv.VerifyEmail(ctx, &domain.VerifyEmailRequest{Token: "token"})
+	require.NoError(t, err)
+}
+
+func TestAuthService_SignOut(t *testing.T) {
+	t.Parallel()
+
+	serv, m := authService(t)
+	ctx := context.Background()
+
+	m.sessions.EXPECT().Delete(ctx, hashToken("id")).Return(nil)
+
+	err := serv.SignOut(ctx, "id")
+	require.NoError(t, err)
+}
+
+func TestAuthService_SignIn_Throttling(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	encoded, err := (&passhash.Bcrypt{Cost: 4}).Hash("password123")
+	require.NoError(t, err)
+	user := &domain.User{ID: 1, Username: "login", EncodedPassword: encoded}
+
+	req := &domain.SignInRequest{Login: "login", Password: "wrong", IP: "10.0.0.1"}
+
+	t.Run("should reject locked login before looking up user", func(t *testing.T) {
+		serv, m := authService(t)
+
+		blockedUntil := time.Now().Add(time.Hour)
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleIP, "10.0.0.1", gomock.Any()).Return(1, nil)
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(0, repository.ErrObjectNotFound)
+		m.throttles.EXPECT().GetSignInThrottle(ctx, domain.SignInThrottleLogin, "login").
+			Return(&domain.SignInThrottle{Failures: 4, BlockedUntil: &blockedUntil}, nil)
+
+		_, err := serv.SignIn(ctx, &domain.SignInRequest{Login: "login", Password: "password123", IP: "10.0.0.1"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.TooManyRequestsType, appErr.Type)
+	})
+
+	t.Run("should reject delayed address before looking up user", func(t *testing.T) {
+		serv, m := authService(t)
+
+		blockedUntil := time.Now().Add(time.Minute)
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleIP, "10.0.0.1", gomock.Any()).Return(0, repository.ErrObjectNotFound)
+		m.throttles.EXPECT().GetSignInThrottle(ctx, domain.SignInThrottleIP, "10.0.0.1").
+			Return(&domain.SignInThrottle{Failures: 6, BlockedUntil: &blockedUntil}, nil)
+
+		_, err := serv.SignIn(ctx, req)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.TooManyRequestsType, appErr.Type)
+	})
+
+	t.Run("should delay after free attempts before checking password", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleIP, "10.0.0.1", gomock.Any()).Return(3, nil)
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(3, nil)
+		m.throttles.EXPECT().BlockSignIn(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ domain.SignInThrottleKind, _ string, until time.Time) error {
+				require.WithinDuration(t, time.Now().Add(time.Second), until, 500*time.Millisecond)
+				return nil
+			})
+		m.repo.EXPECT().GetUserByLogin(ctx, "login").Return(user, nil)
+
+		_, err := serv.SignIn(ctx, req)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+
+	t.Run("should lock login and record audit event", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleIP, "10.0.0.1", gomock.Any()).Return(4, nil)
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(4, nil)
+		m.throttles.EXPECT().BlockSignIn(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ domain.SignInThrottleKind, _ string, until time.Time) error {
+				require.WithinDuration(t, time.Now().Add(time.Hour), until, time.Second)
+				return nil
+			})
+		m.repo.EXPECT().GetUserByLogin(ctx, "login").Return(user, nil)
+		m.audit.EXPECT().RecordSystem(ctx, domain.AuditActionLock, domain.AuditEntityUser, int32(1), gomock.Any())
+
+		_, err := serv.SignIn(ctx, req)
+		require.Error(t, err)
+	})
+
+	t.Run("should forget attempts after session is started", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleIP, "10.0.0.1", gomock.Any()).Return(1, nil)
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(2, nil)
+		m.repo.EXPECT().GetUserByLogin(ctx, "login").Return(user, nil)
+		m.throttles.EXPECT().RemoveSignInFailure(ctx, domain.SignInThrottleIP, "10.0.0.1").Return(nil)
+		m.throttles.EXPECT().DeleteSignInThrottle(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(nil)
+		m.twoFactor.EXPECT().GetTwoFactor(ctx, int32(1)).Return(nil, repository.ErrObjectNotFound)
+		m.sessions.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		m.throttles.EXPECT().DeleteSignInThrottle(ctx, domain.SignInThrottleUser, "1", gomock.Any()).Return(nil)
+
+		_, err := serv.SignIn(ctx, &domain.SignInRequest{Login: "login", Password: "password123", IP: "10.0.0.1"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should keep attempts to account until second factor is passed", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleIP, "10.0.0.1", gomock.Any()).Return(1, nil)
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(1, nil)
+		m.repo.EXPECT().GetUserByLogin(ctx, "login").Return(user, nil)
+		m.throttles.EXPECT().RemoveSignInFailure(ctx, domain.SignInThrottleIP, "10.0.0.1").Return(nil)
+		m.throttles.EXPECT().DeleteSignInThrottle(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(nil)
+		m.twoFactor.EXPECT().GetTwoFactor(ctx, int32(1)).Return(enabledTwoFactor(t, 1, "secret"), nil)
+		m.twoFactor.EXPECT().CreateTwoFactorChallenge(ctx, gomock.Any()).Return(nil)
+
+		res, err := serv.SignIn(ctx, &domain.SignInRequest{Login: "login", Password: "password123", IP: "10.0.0.1"})
+		require.NoError(t, err)
+		require.True(t, res.TwoFactorRequired)
+	})
+
+	t.Run("should count unknown login like known ones", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleIP, "10.0.0.1", gomock.Any()).Return(1, nil)
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleLogin, "unknown", gomock.Any()).Return(1, nil)
+		m.repo.EXPECT().GetUserByLogin(ctx, " Unknown").Return(nil, repository.ErrObjectNotFound)
+
+		_, err := serv.SignIn(ctx, &domain.SignInRequest{Login: " Unknown", Password: "wrong", IP: "10.0.0.1"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+
+	t.Run("should reject locked unknown login like known ones", func(t *testing.T) {
+		serv, m := authService(t)
+
+		blockedUntil := time.Now().Add(time.Hour)
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleIP, "10.0.0.1", gomock.Any()).Return(1, nil)
+		m.throttles.EXPECT().AddSignInFailure(ctx, domain.SignInThrottleLogin, "unknown", gomock.Any()).Return(0, repository.ErrObjectNotFound)
+		m.throttles.EXPECT().GetSignInThrottle(ctx, domain.SignInThrottleLogin, "unknown").
+			Return(&domain.SignInThrottle{Failures: 4, BlockedUntil: &blockedUntil}, nil)
+
+		_, err := serv.SignIn(ctx, &domain.SignInRequest{Login: "unknown", Password: "wrong", IP: "10.0.0.1"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.TooManyRequestsType, appErr.Type)
+	})
+}
+
+func TestAuthService_UnlockUser(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 2, Role: domain.UserRoleAdmin})
+
+	t.Run("should unlock and record audit event", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.repo.EXPECT().GetUserCredentials(ctx, int32(1)).Return(&domain.User{ID: 1, Username: "login", Email: "User@mail.com"}, nil)
+		m.throttles.EXPECT().DeleteSignInThrottle(ctx, domain.SignInThrottleUser, "1", gomock.Any()).Return(repository.ErrObjectNotFound)
+		m.throttles.EXPECT().DeleteSignInThrottle(ctx, domain.SignInThrottleLogin, "login", gomock.Any()).Return(nil)
+		m.throttles.EXPECT().DeleteSignInThrottle(ctx, domain.SignInThrottleLogin, "user@mail.com", gomock.Any()).Return(repository.ErrObjectNotFound)
+		m.audit.EXPECT().Record(ctx, domain.AuditActionUnlock, domain.AuditEntityUser, int32(1), nil, nil)
+
+		require.NoError(t, serv.UnlockUser(ctx, 1))
+	})
+
+	t.Run("should fail on unknown user", func(t *testing.T) {
+		serv, m := authService(t)
+
+		m.repo.EXPECT().GetUserCredentials(ctx, int32(3)).Return(nil, repository.ErrObjectNotFound)
+
+		err := serv.UnlockUser(ctx, 3)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.NotFoundType, appErr.Type)
+	})
+}
@@ -0,0 +1,463 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+//go:generate mockgen -source=board.go -destination=./mocks/board.go -package=mocks
+type BoardRepository interface {
+	GetBoard(ctx context.Context, id int32) (*domain.Board, error)
+	GetProjectBoards(ctx context.Context, projectID int32) ([]domain.Board, error)
+	CreateBoard(ctx context.Context, board *domain.Board) (int32, error)
+	UpdateBoard(ctx context.Context, board *domain.Board) error
+	DeleteBoard(ctx context.Context, id int32) error
+
+	GetColumn(ctx context.Context, id int32) (*domain.BoardColumn, error)
+	GetColumns(ctx context.Context, boardID int32) ([]domain.BoardColumn, error)
+	CreateColumn(ctx context.Context, column *domain.BoardColumn) (int32, error)
+	UpdateColumn(ctx context.Context, column *domain.BoardColumn) error
+	DeleteColumn(ctx context.Context, id int32) error
+
+	GetTask(ctx context.Context, id int32) (*domain.BoardTask, error)
+	GetTasks(ctx context.Context, boardID int32) ([]domain.BoardTask, error)
+	CreateTask(ctx context.Context, task *domain.BoardTask) (int32, error)
+	UpdateTask(ctx context.Context, task *domain.BoardTask) error
+	DeleteTask(ctx context.Context, id int32) error
+
+	GetTaskMembers(ctx context.Context, taskID int32) ([]domain.BoardTaskMember, error)
+	GetTaskMember(ctx context.Context, taskID, userID int32) (*domain.BoardTaskMember, error)
+	AddTaskMember(ctx context.Context, taskID, userID int32) error
+	RemoveTaskMember(ctx context.Context, taskID, userID int32) error
+}
+
+type BoardService struct {
+	repo        BoardRepository
+	projectRepo ProjectRepository
+	userRepo    UserRepository
+}
+
+func NewBoardService(repo BoardRepository, projectRepo ProjectRepository, userRepo UserRepository) *BoardService {
+	return &BoardService{repo, projectRepo, userRepo}
+}
+
+// getProjectBoard returns board only if it belongs to the given project.
+func (s *BoardService) getProjectBoard(ctx context.Context, projectID, boardID int32) (*domain.Board, error) {
+	board, err := s.repo.GetBoard(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("board_id")
+		}
+		return nil, fmt.Errorf("getting board %d: %w", boardID, err)
+	}
+
+	if board.ProjectID != projectID {
+		return nil, apperr.NewNotFound("board_id")
+	}
+
+	return board, nil
+}
+
+// getBoardColumn returns column only if it belongs to the given board.
+func (s *BoardService) getBoardColumn(ctx context.Context, boardID, columnID int32) (*domain.BoardColumn, error) {
+	column, err := s.repo.GetColumn(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("column_id")
+		}
+		return nil, fmt.Errorf("getting column %d: %w", columnID, err)
+	}
+
+	if column.BoardID != boardID {
+		return nil, apperr.NewNotFound("column_id")
+	}
+
+	return column, nil
+}
+
+// getBoardTask returns task only if it belongs to the given board.
+func (s *BoardService) getBoardTask(ctx context.Context, boardID, taskID int32) (*domain.BoardTask, error) {
+	task, err := s.repo.GetTask(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("task_id")
+		}
+		return nil, fmt.Errorf("getting task %d: %w", taskID, err)
+	}
+
+	if task.BoardID != boardID {
+		return nil, apperr.NewNotFound("task_id")
+	}
+
+	return task, nil
+}
+
+func (s *BoardService) GetBoards(ctx context.Context, projectID int32) ([]domain.Board, error) {
+	_, err := s.projectRepo.GetProject(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("project_id")
+		}
+		return nil, fmt.Errorf("getting project %d: %w", projectID, err)
+	}
+
+	boards, err := s.repo.GetProjectBoards(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("getting project %d boards: %w", projectID, err)
+	}
+
+	return boards, nil
+}
+
+func (s *BoardService) GetBoard(ctx context.Context, projectID, boardID int32) (*domain.Board, error) {
+	return s.getProjectBoard(ctx, projectID, boardID)
+}
+
+func (s *BoardService) CreateBoard(ctx context.Context, board *domain.Board) (*domain.Board, error) {
+	if err := board.Validate(); err != nil {
+		return nil, fmt.Errorf("validating board: %w", err)
+	}
+
+	_, err := s.projectRepo.GetProject(ctx, board.ProjectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("project_id")
+		}
+		return nil, fmt.Errorf("getting project %d: %w", board.ProjectID, err)
+	}
+
+	id, err := s.repo.CreateBoard(ctx, board)
+	if err != nil {
+		return nil, fmt.Errorf("creating board: %w", err)
+	}
+
+	createdBoard, err := s.repo.GetBoard(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting board %d: %w", id, err)
+	}
+
+	return createdBoard, nil
+}
+
+func (s *BoardService) UpdateBoard(ctx context.Context, board *domain.Board) (*domain.Board, error) {
+	if err := board.Validate(); err != nil {
+		return nil, fmt.Errorf("validating board: %w", err)
+	}
+
+	_, err := s.getProjectBoard(ctx, board.ProjectID, board.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.UpdateBoard(ctx, board)
+	if err != nil {
+		return nil, fmt.Errorf("updating board %d: %w", board.ID, err)
+	}
+
+	updatedBoard, err := s.repo.GetBoard(ctx, board.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getting board %d: %w", board.ID, err)
+	}
+
+	return updatedBoard, nil
+}
+
+func (s *BoardService) DeleteBoard(ctx context.Context, projectID, boardID int32) error {
+	_, err := s.getProjectBoard(ctx, projectID, boardID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteBoard(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("deleting board %d: %w", boardID, err)
+	}
+
+	return nil
+}
+
+func (s *BoardService) GetColumns(ctx context.Context, projectID, boardID int32) ([]domain.BoardColumn, error) {
+	_, err := s.getProjectBoard(ctx, projectID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.repo.GetColumns(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("getting board %d columns: %w", boardID, err)
+	}
+
+	return columns, nil
+}
+
+func (s *BoardService) CreateColumn(ctx context.Context, projectID int32, column *domain.BoardColumn) (*domain.BoardColumn, error) {
+	if err := column.Validate(); err != nil {
+		return nil, fmt.Errorf("validating column: %w", err)
+	}
+
+	_, err := s.getProjectBoard(ctx, projectID, column.BoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.repo.CreateColumn(ctx, column)
+	if err != nil {
+		return nil, fmt.Errorf("creating column: %w", err)
+	}
+
+	createdColumn, err := s.repo.GetColumn(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting column %d: %w", id, err)
+	}
+
+	return createdColumn, nil
+}
+
+func (s *BoardService) UpdateColumn(ctx context.Context, projectID int32, column *domain.BoardColumn) (*domain.BoardColumn, error) {
+	if err := column.Validate(); err != nil {
+		return nil, fmt.Errorf("validating column: %w", err)
+	}
+
+	_, err := s.getProjectBoard(ctx, projectID, column.BoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getBoardColumn(ctx, column.BoardID, column.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.UpdateColumn(ctx, column)
+	if err != nil {
+		return nil, fmt.Errorf("updating column %d: %w", column.ID, err)
+	}
+
+	updatedColumn, err := s.repo.GetColumn(ctx, column.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getting column %d: %w", column.ID, err)
+	}
+
+	return updatedColumn, nil
+}
+
+func (s *BoardService) DeleteColumn(ctx context.Context, projectID, boardID, columnID int32) error {
+	_, err := s.getProjectBoard(ctx, projectID, boardID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.getBoardColumn(ctx, boardID, columnID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteColumn(ctx, columnID)
+	if err != nil {
+		return fmt.Errorf("deleting column %d: %w", columnID, err)
+	}
+
+	return nil
+}
+
+func (s *BoardService) GetTasks(ctx context.Context, projectID, boardID int32) ([]domain.BoardTask, error) {
+	_, err := s.getProjectBoard(ctx, projectID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.repo.GetTasks(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("getting board %d tasks: %w", boardID, err)
+	}
+
+	return tasks, nil
+}
+
+func (s *BoardService) GetTask(ctx context.Context, projectID, boardID, taskID int32) (*domain.BoardTask, error) {
+	_, err := s.getProjectBoard(ctx, projectID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getBoardTask(ctx, boardID, taskID)
+}
+
+func (s *BoardService) CreateTask(ctx context.Context, projectID int32, task *domain.BoardTask) (*domain.BoardTask, error) {
+	if task.Status == 0 {
+		task.Status = domain.BoardTaskStatusOpen
+	}
+
+	if err := task.Validate(); err != nil {
+		return nil, fmt.Errorf("validating task: %w", err)
+	}
+
+	_, err := s.getProjectBoard(ctx, projectID, task.BoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getBoardColumn(ctx, task.BoardID, task.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.repo.CreateTask(ctx, task)
+	if err != nil {
+		return nil, fmt.Errorf("creating task: %w", err)
+	}
+
+	createdTask, err := s.repo.GetTask(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting task %d: %w", id, err)
+	}
+
+	return createdTask, nil
+}
+
+func (s *BoardService) UpdateTask(ctx context.Context, projectID int32, task *domain.BoardTask) (*domain.BoardTask, error) {
+	if err := task.Validate(); err != nil {
+		return nil, fmt.Errorf("validating task: %w", err)
+	}
+
+	_, err := s.getProjectBoard(ctx, projectID, task.BoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getBoardTask(ctx, task.BoardID, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getBoardColumn(ctx, task.BoardID, task.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.UpdateTask(ctx, task)
+	if err != nil {
+		return nil, fmt.Errorf("updating task %d: %w", task.ID, err)
+	}
+
+	updatedTask, err := s.repo.GetTask(ctx, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getting task %d: %w", task.ID, err)
+	}
+
+	return updatedTask, nil
+}
+
+func (s *BoardService) DeleteTask(ctx context.Context, projectID, boardID, taskID int32) error {
+	_, err := s.getProjectBoard(ctx, projectID, boardID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.getBoardTask(ctx, boardID, taskID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteTask(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("deleting task %d: %w", taskID, err)
+	}
+
+	return nil
+}
+
+func (s *BoardService) GetTaskMembers(ctx context.Context, projectID, boardID, taskID int32) ([]domain.BoardTaskMember, error) {
+	_, err := s.getProjectBoard(ctx, projectID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getBoardTask(ctx, boardID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.GetTaskMembers(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("getting task %d members: %w", taskID, err)
+	}
+
+	return members, nil
+}
+
+func (s *BoardService) AddTaskMember(ctx context.Context, projectID, boardID, taskID, userID int32) (*domain.BoardTaskMember, error) {
+	_, err := s.getProjectBoard(ctx, projectID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getBoardTask(ctx, boardID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.userRepo.GetActiveUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("user_id")
+		}
+		return nil, fmt.Errorf("getting active user: %w", err)
+	}
+
+	_, err = s.repo.GetTaskMember(ctx, taskID, userID)
+	if err != nil && !errors.Is(err, repository.ErrObjectNotFound) {
+		return nil, fmt.Errorf("getting task member: %w", err)
+	}
+	if err == nil {
+		return nil, apperr.NewDuplicate("User already assigned to the task.", "user_id")
+	}
+
+	// Concurrent request can assign the user after the check above
+	err = s.repo.AddTaskMember(ctx, taskID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, apperr.NewDuplicate("User already assigned to the task.", "user_id")
+		}
+		return nil, fmt.Errorf("adding task member: %w", err)
+	}
+
+	member, err := s.repo.GetTaskMember(ctx, taskID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting added task member: %w", err)
+	}
+
+	return member, nil
+}
+
+func (s *BoardService) RemoveTaskMember(ctx context.Context, projectID, boardID, taskID, userID int32) error {
+	_, err := s.getProjectBoard(ctx, projectID, boardID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.getBoardTask(ctx, boardID, taskID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetTaskMember(ctx, taskID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewNotFound("user_id")
+		}
+		return fmt.Errorf("getting task member: %w", err)
+	}
+
+	err = s.repo.RemoveTaskMember(ctx, taskID, userID)
+	if err != nil {
+		return fmt.Errorf("removing task member: %w", err)
+	}
+
+	return nil
+}
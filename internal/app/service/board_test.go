@@ -0,0 +1,41 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+)
+
+func TestBoardService_AddTaskMember(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockBoardRepository(mockCtl)
+	userRepo := mocks.NewMockUserRepository(mockCtl)
+	// Project is not loaded when assigning task members
+	serv := service.NewBoardService(repo, nil, userRepo)
+
+	t.Run("should report member assigned concurrently", func(t *testing.T) {
+		repo.EXPECT().GetBoard(ctx, int32(2)).Return(&domain.Board{ID: 2, ProjectID: 1}, nil)
+		repo.EXPECT().GetTask(ctx, int32(3)).Return(&domain.BoardTask{ID: 3, BoardID: 2}, nil)
+		userRepo.EXPECT().GetActiveUser(ctx, int32(4)).Return(&domain.User{ID: 4}, nil)
+		repo.EXPECT().GetTaskMember(ctx, int32(3), int32(4)).Return(nil, repository.ErrObjectNotFound)
+		repo.EXPECT().AddTaskMember(ctx, int32(3), int32(4)).Return(repository.ErrDuplicate)
+
+		_, err := serv.AddTaskMember(ctx, 1, 2, 3, 4)
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.DuplicateType, appErr.Type)
+		require.Equal(t, "user_id", appErr.Field)
+	})
+}
@@ -1,10 +1,13 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
+	"time"
 
 	"github.com/gabriel-vasile/mimetype"
 	"github.com/google/uuid"
@@ -12,72 +15,110 @@ import (
 	"web-studio-backend/internal/app/domain"
 	"web-studio-backend/internal/app/domain/apperr"
 	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/pkg/auth"
 )
 
 //go:generate mockgen -source=document.go -destination=./mocks/document.go -package=mocks
 type DocumentRepository interface {
 	GetDocument(ctx context.Context, id int32) (*domain.Document, error)
+	GetProjectDocument(ctx context.Context, docID, projectID int32) (*domain.Document, error)
 	CreateDocument(ctx context.Context, doc *domain.Document) (int32, error)
 	DeleteDocument(ctx context.Context, id int32) error
+	RestoreDocument(ctx context.Context, docID, projectID int32) error
+	PurgeDocument(ctx context.Context, id int32) error
+	GetDeletedDocumentIDs(ctx context.Context, deletedBefore time.Time) ([]int32, error)
 
-	GetProjectDocuments(ctx context.Context, projectID int32) ([]domain.Document, error)
+	GetProjectDocuments(ctx context.Context, projectID int32, params *domain.ListParams, deleted bool) ([]domain.Document, int, error)
+	GetProjectDocumentIDs(ctx context.Context, projectID int32) ([]int32, error)
 	AddDocumentToProject(ctx context.Context, docID int32, projectID int32) error
 	RemoveDocumentFromProject(ctx context.Context, docID int32, projectID int32) error
+
+	CreateDocumentVersion(ctx context.Context, v *domain.DocumentVersion) (int32, error)
+	GetDocumentVersion(ctx context.Context, docID, version int32) (*domain.DocumentVersion, error)
+	GetDocumentVersions(ctx context.Context, docID int32, params *domain.ListParams) ([]domain.DocumentVersion, int, error)
+	GetDocumentFileIDs(ctx context.Context, docID int32) ([]string, error)
 }
 
 type DocumentService struct {
-	filesDir    string
 	repo        DocumentRepository
 	projectRepo ProjectRepository
 	fileRepo    FileRepository
+	uow         unitOfWork
+	audit       Auditor
 }
 
-func NewDocumentService(repo DocumentRepository, projectRepo ProjectRepository, fileRepo FileRepository) *DocumentService {
-	mimetype.SetLimit(0) // Make mime type detector read all file
-	return &DocumentService{"documents", repo, projectRepo, fileRepo}
+const (
+	documentsDir    = "documents"
+	maxDocumentSize = 5 << 20 // 5MB
+	mimeDetectSize  = 3072    // Default read limit of mime type detector
+)
+
+func NewDocumentService(repo DocumentRepository, projectRepo ProjectRepository, fileRepo FileRepository, tx TxManager, audit Auditor) *DocumentService {
+	return &DocumentService{repo, projectRepo, fileRepo, unitOfWork{tx, fileRepo}, audit}
 }
 
-func (s *DocumentService) GetDocument(ctx context.Context, id int32) (*domain.Document, error) {
+// OpenDocument returns document and its opened file, the file must be closed by the caller.
+func (s *DocumentService) OpenDocument(ctx context.Context, id int32) (*domain.Document, *domain.File, error) {
 	doc, err := s.repo.GetDocument(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
-			return nil, apperr.NewNotFound("document_id")
+			return nil, nil, apperr.NewNotFound("document_id")
 		}
-		return nil, fmt.Errorf("getting document %d: %w", id, err)
+		return nil, nil, fmt.Errorf("getting document %d: %w", id, err)
 	}
 
-	content, err := s.fileRepo.Read(ctx, filepath.Join(s.filesDir, doc.FileID))
+	file, err := s.fileRepo.Open(ctx, documentPath(doc.FileID))
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
-			return nil, apperr.NewNotFound("document_id")
+			return nil, nil, apperr.NewNotFound("document_id")
 		}
-		return nil, fmt.Errorf("reading document %d content: %w", id, err)
+		return nil, nil, fmt.Errorf("opening document %d file: %w", id, err)
 	}
-	doc.Content = content
 
-	return doc, nil
+	return doc, file, nil
 }
 
-func (s *DocumentService) GetProjectDocuments(ctx context.Context, id int32) ([]domain.Document, error) {
+// GetProjectDocuments returns the requested page of project documents and total number of project documents.
+func (s *DocumentService) GetProjectDocuments(ctx context.Context, id int32, params *domain.ListParams) ([]domain.Document, int, error) {
 	_, err := s.projectRepo.GetProject(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
-			return nil, apperr.NewNotFound("project_id")
+			return nil, 0, apperr.NewNotFound("project_id")
+		}
+		return nil, 0, fmt.Errorf("getting document %d: %w", id, err)
+	}
+
+	documents, total, err := s.repo.GetProjectDocuments(ctx, id, params, false)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting project %d documents: %w", id, err)
+	}
+
+	return documents, total, nil
+}
+
+// GetDeletedProjectDocuments returns the requested page of project documents in the trash and total number of them.
+func (s *DocumentService) GetDeletedProjectDocuments(ctx context.Context, projectID int32, params *domain.ListParams) ([]domain.Document, int, error) {
+	_, err := s.projectRepo.GetProject(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, 0, apperr.NewNotFound("project_id")
 		}
-		return nil, fmt.Errorf("getting document %d: %w", id, err)
+		return nil, 0, fmt.Errorf("getting project %d: %w", projectID, err)
 	}
 
-	documents, err := s.repo.GetProjectDocuments(ctx, id)
+	documents, total, err := s.repo.GetProjectDocuments(ctx, projectID, params, true)
 	if err != nil {
-		return nil, fmt.Errorf("getting project %d documents: %w", id, err)
+		return nil, 0, fmt.Errorf("getting project %d deleted documents: %w", projectID, err)
 	}
 
-	return documents, nil
+	return documents, total, nil
 }
 
-func (s *DocumentService) AddDocumentToProject(ctx context.Context, doc *domain.Document, projectID int32) (*domain.Document, error) {
-	if doc.SizeBytes > (5 << 20) { // 5MB
-		return nil, apperr.NewInvalidRequest("Document size is too big.", "")
+// AddDocumentToProject streams document content to the file storage and adds the document to project.
+func (s *DocumentService) AddDocumentToProject(ctx context.Context, doc *domain.Document, content io.Reader, projectID int32) (*domain.Document, error) {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperr.NewUnauthorized("Authorization required.")
 	}
 
 	_, err := s.projectRepo.GetProject(ctx, projectID)
@@ -88,48 +129,182 @@ func (s *DocumentService) AddDocumentToProject(ctx context.Context, doc *domain.
 		return nil, fmt.Errorf("getting project %d: %w", projectID, err)
 	}
 
-	mt := mimetype.Detect(doc.Content)
-	doc.MimeType = mt.String()
+	files := &fileChanges{}
 
-	doc.FileID = uuid.New().String() + mt.Extension() // Already has dot in file extension
+	upload, err := s.saveFile(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+	files.Saved(documentPath(upload.FileID))
 
-	doc.UserID = 1 // TODO: use auth identifier in the future
+	doc.FileID = upload.FileID
+	doc.MimeType = upload.MimeType
+	doc.SizeBytes = upload.SizeBytes
+	doc.UserID = ac.UserID
 
-	docID, err := s.repo.CreateDocument(ctx, doc)
+	var docID int32
+	err = s.uow.do(ctx, files, func(ctx context.Context) error {
+		docID, err = s.repo.CreateDocument(ctx, doc)
+		if err != nil {
+			return fmt.Errorf("creating document: %w", err)
+		}
+
+		err = s.repo.AddDocumentToProject(ctx, docID, projectID)
+		if err != nil {
+			return fmt.Errorf("adding document %d to project %d: %w", docID, projectID, err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("creating document: %w", err)
+		return nil, err
 	}
 
-	err = s.fileRepo.Save(ctx, doc.Content, filepath.Join(s.filesDir, doc.FileID))
+	document, err := s.repo.GetDocument(ctx, docID)
 	if err != nil {
-		return nil, fmt.Errorf("saving document: %w", err)
+		return nil, fmt.Errorf("getting document: %w", err)
 	}
 
-	err = s.repo.AddDocumentToProject(ctx, docID, projectID)
+	s.audit.Record(ctx, domain.AuditActionCreate, domain.AuditEntityDocument, docID, nil, document)
+
+	return document, nil
+}
+
+// AddDocumentVersion uploads a new version of the project document, files of previous versions are kept.
+func (s *DocumentService) AddDocumentVersion(ctx context.Context, v *domain.DocumentVersion, content io.Reader, projectID int32) (*domain.Document, error) {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperr.NewUnauthorized("Authorization required.")
+	}
+
+	existingDoc, err := s.getProjectDocument(ctx, v.DocumentID, projectID)
 	if err != nil {
-		return nil, fmt.Errorf("adding document %d to project %d: %w", docID, projectID, err)
+		return nil, err
 	}
 
-	document, err := s.repo.GetDocument(ctx, docID)
+	files := &fileChanges{}
+
+	upload, err := s.saveFile(ctx, content)
 	if err != nil {
-		return nil, fmt.Errorf("getting document: %w", err)
+		return nil, err
 	}
+	files.Saved(documentPath(upload.FileID))
+
+	v.FileID = upload.FileID
+	v.MimeType = upload.MimeType
+	v.SizeBytes = upload.SizeBytes
+	v.UserID = ac.UserID
+	if v.OriginalFilename == "" {
+		v.OriginalFilename = existingDoc.OriginalFilename
+	}
+
+	err = s.uow.do(ctx, files, func(ctx context.Context) error {
+		_, err := s.repo.CreateDocumentVersion(ctx, v)
+		if err != nil {
+			return fmt.Errorf("creating document %d version: %w", v.DocumentID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := s.repo.GetDocument(ctx, v.DocumentID)
+	if err != nil {
+		return nil, fmt.Errorf("getting document %d: %w", v.DocumentID, err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionAddVersion, domain.AuditEntityDocument, v.DocumentID, existingDoc, document)
 
 	return document, nil
 }
 
-func (s *DocumentService) DeleteDocumentFromProject(ctx context.Context, docID int32, projectID int32) error {
-	doc, err := s.repo.GetDocument(ctx, docID)
+// GetDocumentVersions returns the requested page of document versions, the latest first by default, and total number of versions.
+func (s *DocumentService) GetDocumentVersions(ctx context.Context, docID int32, params *domain.ListParams) ([]domain.DocumentVersion, int, error) {
+	_, err := s.repo.GetDocument(ctx, docID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, 0, apperr.NewNotFound("document_id")
+		}
+		return nil, 0, fmt.Errorf("getting document %d: %w", docID, err)
+	}
+
+	versions, total, err := s.repo.GetDocumentVersions(ctx, docID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting document %d versions: %w", docID, err)
+	}
+
+	return versions, total, nil
+}
+
+// OpenDocumentVersion returns document version and its opened file, the file must be closed by the caller.
+func (s *DocumentService) OpenDocumentVersion(ctx context.Context, docID, version int32) (*domain.DocumentVersion, *domain.File, error) {
+	v, err := s.repo.GetDocumentVersion(ctx, docID, version)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, nil, apperr.NewNotFound("version")
+		}
+		return nil, nil, fmt.Errorf("getting document %d version %d: %w", docID, version, err)
+	}
+
+	file, err := s.fileRepo.Open(ctx, documentPath(v.FileID))
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, nil, apperr.NewNotFound("version")
+		}
+		return nil, nil, fmt.Errorf("opening document %d version %d file: %w", docID, version, err)
+	}
+
+	return v, file, nil
+}
+
+// RestoreDocumentVersion makes a copy of the given version the latest one. The copy shares the file with
+// the restored version, so history is never rewritten.
+func (s *DocumentService) RestoreDocumentVersion(ctx context.Context, docID, version, projectID int32) (*domain.Document, error) {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperr.NewUnauthorized("Authorization required.")
+	}
+
+	existingDoc, err := s.getProjectDocument(ctx, docID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.repo.GetDocumentVersion(ctx, docID, version)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
-			return apperr.NewNotFound("document_id")
+			return nil, apperr.NewNotFound("version")
 		}
-		return fmt.Errorf("getting document %d: %w", docID, err)
+		return nil, fmt.Errorf("getting document %d version %d: %w", docID, version, err)
+	}
+
+	if v.Version == existingDoc.Version {
+		return nil, apperr.NewInvalidRequest("Version is already the current one.", "version")
+	}
+
+	v.UserID = ac.UserID
+
+	_, err = s.repo.CreateDocumentVersion(ctx, v)
+	if err != nil {
+		return nil, fmt.Errorf("restoring document %d version %d: %w", docID, version, err)
 	}
 
-	err = s.repo.RemoveDocumentFromProject(ctx, docID, projectID)
+	document, err := s.repo.GetDocument(ctx, docID)
+	if err != nil {
+		return nil, fmt.Errorf("getting document %d: %w", docID, err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionRestoreVersion, domain.AuditEntityDocument, docID, existingDoc, document)
+
+	return document, nil
+}
+
+// DeleteDocumentFromProject moves document to the trash, it is purged with all its versions after the retention period.
+func (s *DocumentService) DeleteDocumentFromProject(ctx context.Context, docID int32, projectID int32) error {
+	doc, err := s.getProjectDocument(ctx, docID, projectID)
 	if err != nil {
-		return fmt.Errorf("removing document %d from project %d: %w", docID, projectID, err)
+		return err
 	}
 
 	err = s.repo.DeleteDocument(ctx, docID)
@@ -137,13 +312,121 @@ func (s *DocumentService) DeleteDocumentFromProject(ctx context.Context, docID i
 		return fmt.Errorf("deleting document %d: %w", docID, err)
 	}
 
-	err = s.fileRepo.Delete(ctx, filepath.Join(s.filesDir, doc.FileID))
+	s.audit.Record(ctx, domain.AuditActionDelete, domain.AuditEntityDocument, docID, doc, nil)
+
+	return nil
+}
+
+// RestoreDocument moves project document out of the trash.
+func (s *DocumentService) RestoreDocument(ctx context.Context, docID, projectID int32) (*domain.Document, error) {
+	err := s.repo.RestoreDocument(ctx, docID, projectID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
-			return apperr.NewNotFound("document_id")
+			return nil, apperr.NewNotFound("document_id")
+		}
+		return nil, fmt.Errorf("restoring document %d: %w", docID, err)
+	}
+
+	document, err := s.repo.GetDocument(ctx, docID)
+	if err != nil {
+		return nil, fmt.Errorf("getting document %d: %w", docID, err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionRestore, domain.AuditEntityDocument, docID, nil, document)
+
+	return document, nil
+}
+
+// PurgeDeleted deletes documents which were moved to the trash before the given moment forever
+// with files of all their versions. Returns the number of purged documents.
+func (s *DocumentService) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	ids, err := s.repo.GetDeletedDocumentIDs(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("getting deleted documents: %w", err)
+	}
+
+	for i, id := range ids {
+		files := &fileChanges{}
+
+		err = s.uow.do(ctx, files, func(ctx context.Context) error {
+			return purgeDocuments(ctx, s.repo, files, id)
+		})
+		if err != nil {
+			return i, err
+		}
+	}
+
+	return len(ids), nil
+}
+
+// purgeDocuments deletes documents forever, files of their versions are deleted when the unit of work succeeds.
+func purgeDocuments(ctx context.Context, repo DocumentRepository, files *fileChanges, ids ...int32) error {
+	for _, id := range ids {
+		fileIDs, err := repo.GetDocumentFileIDs(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting document %d files: %w", id, err)
+		}
+		for _, fileID := range fileIDs {
+			files.Delete(documentPath(fileID))
+		}
+
+		err = repo.PurgeDocument(ctx, id)
+		if err != nil {
+			return fmt.Errorf("purging document %d: %w", id, err)
 		}
-		return fmt.Errorf("deleting document %d fs: %w", docID, err)
 	}
 
 	return nil
 }
+
+func (s *DocumentService) getProjectDocument(ctx context.Context, docID, projectID int32) (*domain.Document, error) {
+	doc, err := s.repo.GetProjectDocument(ctx, docID, projectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("document_id")
+		}
+		return nil, fmt.Errorf("getting project %d document %d: %w", projectID, docID, err)
+	}
+
+	return doc, nil
+}
+
+// uploadedFile describes document content saved to the file storage.
+type uploadedFile struct {
+	FileID    string
+	MimeType  string
+	SizeBytes int32
+}
+
+// saveFile streams document content to the file storage detecting its type by the beginning of the content.
+func (s *DocumentService) saveFile(ctx context.Context, content io.Reader) (*uploadedFile, error) {
+	// Only the beginning of the file is needed to detect its type, the rest is streamed as is.
+	head := make([]byte, mimeDetectSize)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("reading document: %w", err)
+	}
+	if n == 0 {
+		return nil, apperr.NewInvalidRequest("Document is empty.", "file")
+	}
+	head = head[:n]
+
+	mt := mimetype.Detect(head)
+	fileID := uuid.New().String() + mt.Extension() // Already has dot in file extension
+
+	lr := &sizeLimitReader{r: io.MultiReader(bytes.NewReader(head), content), limit: maxDocumentSize}
+
+	err = s.fileRepo.Save(ctx, lr, -1, documentPath(fileID))
+	if err != nil {
+		if lr.exceeded() {
+			return nil, apperr.NewInvalidRequest("Document size is too big.", "file")
+		}
+		return nil, fmt.Errorf("saving document: %w", err)
+	}
+
+	return &uploadedFile{FileID: fileID, MimeType: mt.String(), SizeBytes: int32(lr.n)}, nil
+}
+
+func documentPath(fileID string) string {
+	return filepath.Join(documentsDir, fileID)
+}
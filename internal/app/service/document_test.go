@@ -0,0 +1,263 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+	"web-studio-backend/internal/pkg/auth"
+)
+
+type documentMocks struct {
+	repo        *mocks.MockDocumentRepository
+	projectRepo *mocks.MockProjectRepository
+	fileRepo    *mocks.MockFileRepository
+	tx          *mocks.MockTxManager
+}
+
+func document(t *testing.T) (*service.DocumentService, documentMocks) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	m := documentMocks{
+		repo:        mocks.NewMockDocumentRepository(mockCtl),
+		projectRepo: mocks.NewMockProjectRepository(mockCtl),
+		fileRepo:    mocks.NewMockFileRepository(mockCtl),
+		tx:          mocks.NewMockTxManager(mockCtl),
+	}
+	m.tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(withinTx).AnyTimes()
+	auditor := mocks.NewMockAuditor(mockCtl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	return service.NewDocumentService(m.repo, m.projectRepo, m.fileRepo, m.tx, auditor), m
+}
+
+// withinTx makes mocked WithinTx run fn like a real transaction manager does.
+func withinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// saveTo makes mocked Save consume the reader like a real storage does.
+func saveTo(buf *bytes.Buffer) func(context.Context, io.Reader, int64, string) error {
+	return func(_ context.Context, r io.Reader, _ int64, _ string) error {
+		_, err := io.Copy(buf, r)
+		return err
+	}
+}
+
+func TestDocumentService_AddDocumentToProject(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 7, Role: domain.UserRoleUser})
+
+	t.Run("should stream content", func(t *testing.T) {
+		serv, m := document(t)
+
+		content := "%PDF-1.4\n" + strings.Repeat("a", 10000)
+		var saved bytes.Buffer
+
+		m.projectRepo.EXPECT().GetProject(ctx, int32(1)).Return(&domain.Project{}, nil)
+		m.fileRepo.EXPECT().Save(ctx, gomock.Any(), int64(-1), gomock.Any()).DoAndReturn(saveTo(&saved))
+		m.repo.EXPECT().CreateDocument(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, doc *domain.Document) (int32, error) {
+			require.Equal(t, "application/pdf", doc.MimeType)
+			require.Equal(t, int32(len(content)), doc.SizeBytes)
+			require.True(t, strings.HasSuffix(doc.FileID, ".pdf"))
+			require.Equal(t, int32(7), doc.UserID)
+			return 2, nil
+		})
+		m.repo.EXPECT().AddDocumentToProject(gomock.Any(), int32(2), int32(1)).Return(nil)
+		m.repo.EXPECT().GetDocument(ctx, int32(2)).Return(&domain.Document{ID: 2}, nil)
+
+		doc, err := serv.AddDocumentToProject(ctx, &domain.Document{OriginalFilename: "doc.pdf"}, strings.NewReader(content), 1)
+		require.NoError(t, err)
+		require.Equal(t, int32(2), doc.ID)
+		require.Equal(t, content, saved.String())
+	})
+
+	t.Run("too big", func(t *testing.T) {
+		serv, m := document(t)
+
+		m.projectRepo.EXPECT().GetProject(ctx, int32(1)).Return(&domain.Project{}, nil)
+		m.fileRepo.EXPECT().Save(ctx, gomock.Any(), int64(-1), gomock.Any()).DoAndReturn(saveTo(&bytes.Buffer{}))
+
+		content := bytes.NewReader(make([]byte, 5<<20+1))
+		_, err := serv.AddDocumentToProject(ctx, &domain.Document{}, content, 1)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		serv, m := document(t)
+
+		m.projectRepo.EXPECT().GetProject(ctx, int32(1)).Return(&domain.Project{}, nil)
+
+		_, err := serv.AddDocumentToProject(ctx, &domain.Document{}, strings.NewReader(""), 1)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+	})
+
+	t.Run("file is deleted when document is not created", func(t *testing.T) {
+		serv, m := document(t)
+
+		m.projectRepo.EXPECT().GetProject(ctx, int32(1)).Return(&domain.Project{}, nil)
+		m.fileRepo.EXPECT().Save(ctx, gomock.Any(), int64(-1), gomock.Any()).DoAndReturn(saveTo(&bytes.Buffer{}))
+		m.repo.EXPECT().CreateDocument(gomock.Any(), gomock.Any()).Return(int32(0), errors.New("db error"))
+		m.fileRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := serv.AddDocumentToProject(ctx, &domain.Document{}, strings.NewReader("text"), 1)
+		require.Error(t, err)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		serv, _ := document(t)
+
+		_, err := serv.AddDocumentToProject(context.Background(), &domain.Document{}, strings.NewReader("text"), 1)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.UnauthorizedType, appErr.Type)
+	})
+}
+
+func TestDocumentService_AddDocumentVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 7, Role: domain.UserRoleUser})
+	serv, m := document(t)
+
+	m.repo.EXPECT().GetProjectDocument(ctx, int32(2), int32(1)).
+		Return(&domain.Document{ID: 2, OriginalFilename: "report.txt", Version: 1}, nil)
+	m.fileRepo.EXPECT().Save(ctx, gomock.Any(), int64(-1), gomock.Any()).DoAndReturn(saveTo(&bytes.Buffer{}))
+	m.repo.EXPECT().CreateDocumentVersion(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, v *domain.DocumentVersion) (int32, error) {
+		require.Equal(t, int32(2), v.DocumentID)
+		require.Equal(t, "report.txt", v.OriginalFilename)
+		require.Equal(t, int32(7), v.UserID)
+		require.Equal(t, int32(len("new content")), v.SizeBytes)
+		return 2, nil
+	})
+	m.repo.EXPECT().GetDocument(ctx, int32(2)).Return(&domain.Document{ID: 2, Version: 2}, nil)
+
+	doc, err := serv.AddDocumentVersion(ctx, &domain.DocumentVersion{DocumentID: 2}, strings.NewReader("new content"), 1)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), doc.Version)
+}
+
+func TestDocumentService_OpenDocumentVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("document in trash", func(t *testing.T) {
+		serv, m := document(t)
+
+		m.repo.EXPECT().GetDocumentVersion(ctx, int32(2), int32(1)).Return(nil, repository.ErrObjectNotFound)
+
+		_, _, err := serv.OpenDocumentVersion(ctx, 2, 1)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.NotFoundType, appErr.Type)
+	})
+}
+
+func TestDocumentService_RestoreDocumentVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 7, Role: domain.UserRoleUser})
+
+	t.Run("should pass", func(t *testing.T) {
+		serv, m := document(t)
+
+		m.repo.EXPECT().GetProjectDocument(ctx, int32(2), int32(1)).Return(&domain.Document{ID: 2, Version: 3}, nil)
+		m.repo.EXPECT().GetDocumentVersion(ctx, int32(2), int32(1)).
+			Return(&domain.DocumentVersion{DocumentID: 2, Version: 1, FileID: "v1.txt", UserID: 3}, nil)
+		m.repo.EXPECT().CreateDocumentVersion(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, v *domain.DocumentVersion) (int32, error) {
+			// Restored version reuses the file instead of copying it
+			require.Equal(t, "v1.txt", v.FileID)
+			require.Equal(t, int32(7), v.UserID)
+			return 4, nil
+		})
+		m.repo.EXPECT().GetDocument(ctx, int32(2)).Return(&domain.Document{ID: 2, Version: 4, FileID: "v1.txt"}, nil)
+
+		doc, err := serv.RestoreDocumentVersion(ctx, 2, 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, int32(4), doc.Version)
+	})
+
+	t.Run("current version", func(t *testing.T) {
+		serv, m := document(t)
+
+		m.repo.EXPECT().GetProjectDocument(ctx, int32(2), int32(1)).Return(&domain.Document{ID: 2, Version: 3}, nil)
+		m.repo.EXPECT().GetDocumentVersion(ctx, int32(2), int32(3)).Return(&domain.DocumentVersion{DocumentID: 2, Version: 3}, nil)
+
+		_, err := serv.RestoreDocumentVersion(ctx, 2, 3, 1)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+}
+
+func TestDocumentService_DeleteDocumentFromProject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serv, m := document(t)
+
+	// Document is moved to the trash, files are kept until it is purged
+	m.repo.EXPECT().GetProjectDocument(ctx, int32(2), int32(1)).Return(&domain.Document{ID: 2}, nil)
+	m.repo.EXPECT().DeleteDocument(ctx, int32(2)).Return(nil)
+
+	err := serv.DeleteDocumentFromProject(ctx, 2, 1)
+	require.NoError(t, err)
+}
+
+func TestDocumentService_PurgeDeleted(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	before := time.Now().Add(-time.Hour)
+
+	t.Run("should pass", func(t *testing.T) {
+		serv, m := document(t)
+
+		m.repo.EXPECT().GetDeletedDocumentIDs(ctx, before).Return([]int32{2}, nil)
+		m.repo.EXPECT().GetDocumentFileIDs(gomock.Any(), int32(2)).Return([]string{"v1.txt", "v2.txt"}, nil)
+		m.repo.EXPECT().PurgeDocument(gomock.Any(), int32(2)).Return(nil)
+		m.fileRepo.EXPECT().Delete(gomock.Any(), filepath.Join("documents", "v1.txt")).Return(nil)
+		m.fileRepo.EXPECT().Delete(gomock.Any(), filepath.Join("documents", "v2.txt")).Return(nil)
+
+		n, err := serv.PurgeDeleted(ctx, before)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	})
+
+	t.Run("files are kept when document is not purged", func(t *testing.T) {
+		serv, m := document(t)
+
+		m.repo.EXPECT().GetDeletedDocumentIDs(ctx, before).Return([]int32{2, 3}, nil)
+		m.repo.EXPECT().GetDocumentFileIDs(gomock.Any(), int32(2)).Return([]string{"v1.txt"}, nil)
+		m.repo.EXPECT().PurgeDocument(gomock.Any(), int32(2)).Return(errors.New("db error"))
+
+		n, err := serv.PurgeDeleted(ctx, before)
+		require.Error(t, err)
+		require.Equal(t, 0, n)
+	})
+}
@@ -1,10 +1,40 @@
 package service
 
-import "context"
+import (
+	"context"
+	"errors"
+	"io"
+
+	"web-studio-backend/internal/app/domain"
+)
 
 //go:generate mockgen -source=file.go -destination=./mocks/file.go -package=mocks
 type FileRepository interface {
-	Save(ctx context.Context, data []byte, fileName string) error
-	Read(ctx context.Context, fileName string) ([]byte, error)
+	// Save stores data read from r. Size may be -1 if it is not known in advance.
+	Save(ctx context.Context, r io.Reader, size int64, fileName string) error
+	Open(ctx context.Context, fileName string) (*domain.File, error)
 	Delete(ctx context.Context, fileName string) error
 }
+
+var errFileTooBig = errors.New("file is too big")
+
+// sizeLimitReader counts bytes read from r and fails once more than limit bytes are read,
+// so uploads of unknown size can be streamed to storage without buffering.
+type sizeLimitReader struct {
+	r     io.Reader
+	limit int64
+	n     int64
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	if l.n > l.limit {
+		return n, errFileTooBig
+	}
+	return n, err
+}
+
+func (l *sizeLimitReader) exceeded() bool {
+	return l.n > l.limit
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/pkg/imgproc"
+)
+
+const maxImageSize = 5 << 20 // 5MB
+
+// imageStorage keeps resized variants of uploaded images in the files directory.
+type imageStorage struct {
+	dir      string
+	fileRepo FileRepository
+}
+
+func newImageStorage(dir string, fileRepo FileRepository) imageStorage {
+	return imageStorage{dir, fileRepo}
+}
+
+// save validates uploaded image, stores its variants and returns identifier of the new image.
+func (s imageStorage) save(ctx context.Context, img []byte) (string, error) {
+	if len(img) > maxImageSize {
+		return "", apperr.NewInvalidRequest("Image is too big.", "file")
+	}
+
+	mt := mimetype.Detect(img)
+	if !mt.Is("image/jpeg") &&
+		!mt.Is("image/png") &&
+		!mt.Is("image/webp") {
+		return "", apperr.NewInvalidRequest("Invalid image mime type.", "file")
+	}
+
+	processed, err := imgproc.Process(img)
+	if err != nil {
+		if errors.Is(err, imgproc.ErrTooLarge) {
+			return "", apperr.NewInvalidRequest(fmt.Sprintf("Image cannot have more than %d pixels.", imgproc.MaxPixels), "file")
+		}
+		slog.Debug("Processing image", slog.String("error", err.Error()))
+		return "", apperr.NewInvalidRequest("Image cannot be decoded.", "file")
+	}
+
+	imageID := uuid.New().String() + processed.Ext
+
+	for _, v := range processed.Variants {
+		err = s.fileRepo.Save(ctx, bytes.NewReader(v.Data), int64(len(v.Data)), s.path(imgproc.VariantName(imageID, v.Size)))
+		if err != nil {
+			s.delete(ctx, imageID)
+			return "", fmt.Errorf("saving %dpx image variant: %w", v.Size, err)
+		}
+	}
+
+	return imageID, nil
+}
+
+// open opens image variant of the requested size, zero size means the default one.
+// Images uploaded before variants were introduced have only the original file, which is served for every size.
+func (s imageStorage) open(ctx context.Context, imageID string, size int) (*domain.File, error) {
+	if size == 0 {
+		size = imgproc.DefaultSize
+	}
+
+	file, err := s.fileRepo.Open(ctx, s.path(imgproc.VariantName(imageID, size)))
+	if errors.Is(err, repository.ErrObjectNotFound) {
+		file, err = s.fileRepo.Open(ctx, s.path(imageID))
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("image_id")
+		}
+		return nil, fmt.Errorf("opening image: %w", err)
+	}
+
+	return file, nil
+}
+
+// delete removes all files of the image. It is used for replaced images, so failures are only logged.
+func (s imageStorage) delete(ctx context.Context, imageID string) {
+	for _, fileName := range s.fileNames(imageID) {
+		err := s.fileRepo.Delete(ctx, fileName)
+		if err != nil && !errors.Is(err, repository.ErrObjectNotFound) {
+			slog.Error("Deleting image file", slog.String("error", err.Error()), slog.String("file", fileName))
+		}
+	}
+}
+
+// fileNames returns paths of all files the image may have, so they can be registered in a unit of work.
+func (s imageStorage) fileNames(imageID string) []string {
+	fileNames := []string{s.path(imageID)}
+	for _, size := range imgproc.Sizes {
+		fileNames = append(fileNames, s.path(imgproc.VariantName(imageID, size)))
+	}
+
+	return fileNames
+}
+
+func (s imageStorage) path(fileName string) string {
+	return filepath.Join(s.dir, fileName)
+}
+
+// validateImageSize checks requested image variant size, zero means the default size.
+func validateImageSize(size int) error {
+	if size == 0 || imgproc.IsValidSize(size) {
+		return nil
+	}
+
+	sizes := make([]string, 0, len(imgproc.Sizes))
+	for _, s := range imgproc.Sizes {
+		sizes = append(sizes, strconv.Itoa(s))
+	}
+
+	return apperr.NewInvalidRequest(fmt.Sprintf("Image size must be one of: %s.", strings.Join(sizes, ", ")), "size")
+}
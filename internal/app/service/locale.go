@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/pkg/locale"
+)
+
+// Locales are the languages of project and team content.
+type Locales struct {
+	Default   string // Language of the main fields, they are used if there is no translation
+	Supported []string
+}
+
+// requested returns the locale negotiated for the request if the content must be translated to it.
+func (l Locales) requested(ctx context.Context) (string, bool) {
+	loc := locale.FromContext(ctx)
+	return loc, loc != "" && loc != l.Default
+}
+
+// validate checks that translations to the locale can be managed.
+func (l Locales) validate(loc string) error {
+	if loc == l.Default {
+		return apperr.NewInvalidRequest(fmt.Sprintf("Content in %q is set by the main fields.", loc), "locale")
+	}
+	if !slices.Contains(l.Supported, loc) {
+		return apperr.NewInvalidRequest(fmt.Sprintf("Locale %q is not supported.", loc), "locale")
+	}
+
+	return nil
+}
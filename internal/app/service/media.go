@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+//go:generate mockgen -source=media.go -destination=./mocks/media.go -package=mocks
+type ProjectMediaRepository interface {
+	GetProjectMedia(ctx context.Context, projectID int32) ([]domain.ProjectMedia, error)
+	GetMedia(ctx context.Context, mediaID, projectID int32) (*domain.ProjectMedia, error)
+	LockProjectMedia(ctx context.Context, projectID int32) error
+	CreateMedia(ctx context.Context, m *domain.ProjectMedia) (int32, error)
+	UpdateMedia(ctx context.Context, m *domain.ProjectMedia) error
+	UnsetCover(ctx context.Context, projectID int32) error
+	ReorderMedia(ctx context.Context, projectID int32, mediaIDs []int32) error
+	DeleteMedia(ctx context.Context, mediaID, projectID int32) error
+}
+
+const mediaDir = "media"
+
+// MediaService manages project galleries: ordered images and video links with Markdown captions.
+type MediaService struct {
+	images      imageStorage
+	repo        ProjectMediaRepository
+	projectRepo ProjectRepository
+	uow         unitOfWork
+	audit       Auditor
+}
+
+func NewMediaService(repo ProjectMediaRepository, projectRepo ProjectRepository, fileRepo FileRepository, tx TxManager, audit Auditor) *MediaService {
+	return &MediaService{newImageStorage(mediaDir, fileRepo), repo, projectRepo, unitOfWork{tx, fileRepo}, audit}
+}
+
+// GetProjectMedia returns the project gallery in display order.
+func (s *MediaService) GetProjectMedia(ctx context.Context, projectID int32) ([]domain.ProjectMedia, error) {
+	if err := s.checkProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	media, err := s.repo.GetProjectMedia(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("getting project %d media: %w", projectID, err)
+	}
+
+	for i := range media {
+		media[i].Render()
+	}
+
+	return media, nil
+}
+
+func (s *MediaService) GetMedia(ctx context.Context, mediaID, projectID int32) (*domain.ProjectMedia, error) {
+	media, err := s.repo.GetMedia(ctx, mediaID, projectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("media_id")
+		}
+		return nil, fmt.Errorf("getting media %d: %w", mediaID, err)
+	}
+
+	media.Render()
+
+	return media, nil
+}
+
+// AddMedia appends an image or a video link to the end of the project gallery.
+// Image content is required for images and must be nil for videos.
+func (s *MediaService) AddMedia(ctx context.Context, media *domain.ProjectMedia, img []byte) (*domain.ProjectMedia, error) {
+	if err := media.Validate(); err != nil {
+		return nil, fmt.Errorf("validating media: %w", err)
+	}
+	if media.Type == domain.MediaTypeImage && img == nil {
+		return nil, apperr.NewInvalidRequest("Image file is required.", "file")
+	}
+	if media.Type == domain.MediaTypeVideo && img != nil {
+		return nil, apperr.NewInvalidRequest("Video cannot have image file.", "file")
+	}
+
+	if err := s.checkProject(ctx, media.ProjectID); err != nil {
+		return nil, err
+	}
+
+	var (
+		files = &fileChanges{}
+		err   error
+	)
+	if img != nil {
+		media.ImageID, err = s.images.save(ctx, img)
+		if err != nil {
+			return nil, fmt.Errorf("saving media image: %w", err)
+		}
+		for _, fileName := range s.images.fileNames(media.ImageID) {
+			files.Saved(fileName)
+		}
+	}
+
+	// Gallery is counted under the project lock, so concurrent requests cannot exceed the limit or share a position
+	err = s.uow.do(ctx, files, func(ctx context.Context) error {
+		err := s.repo.LockProjectMedia(ctx, media.ProjectID)
+		if err != nil {
+			if errors.Is(err, repository.ErrObjectNotFound) {
+				return apperr.NewNotFound("project_id")
+			}
+			return fmt.Errorf("locking project %d media: %w", media.ProjectID, err)
+		}
+
+		existing, err := s.repo.GetProjectMedia(ctx, media.ProjectID)
+		if err != nil {
+			return fmt.Errorf("getting project %d media: %w", media.ProjectID, err)
+		}
+		if len(existing) >= domain.MaxProjectMedia {
+			return apperr.NewInvalidRequest(fmt.Sprintf("Gallery cannot have more than %d items.", domain.MaxProjectMedia), "project_id")
+		}
+
+		if media.IsCover {
+			if err := s.repo.UnsetCover(ctx, media.ProjectID); err != nil {
+				return err
+			}
+		}
+
+		media.ID, err = s.repo.CreateMedia(ctx, media)
+		if errors.Is(err, repository.ErrDuplicate) {
+			return apperr.NewDuplicate("Gallery already has a cover.", "is_cover")
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating media: %w", err)
+	}
+
+	created, err := s.GetMedia(ctx, media.ID, media.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, domain.AuditActionAddMedia, domain.AuditEntityProject, media.ProjectID, nil, created)
+
+	return created, nil
+}
+
+// UpdateMedia changes caption, cover flag and link of the video. Setting the cover unsets the previous one.
+func (s *MediaService) UpdateMedia(ctx context.Context, media *domain.ProjectMedia) (*domain.ProjectMedia, error) {
+	old, err := s.GetMedia(ctx, media.ID, media.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	media.Type = old.Type
+	media.ImageID = old.ImageID
+	if media.Type == domain.MediaTypeVideo && media.VideoURL == "" {
+		media.VideoURL = old.VideoURL
+	}
+
+	if err = media.Validate(); err != nil {
+		return nil, fmt.Errorf("validating media: %w", err)
+	}
+
+	err = s.uow.do(ctx, &fileChanges{}, func(ctx context.Context) error {
+		if media.IsCover && !old.IsCover {
+			if err := s.repo.UnsetCover(ctx, media.ProjectID); err != nil {
+				return err
+			}
+		}
+
+		err := s.repo.UpdateMedia(ctx, media)
+		if errors.Is(err, repository.ErrDuplicate) {
+			return apperr.NewDuplicate("Gallery already has a cover.", "is_cover")
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("updating media %d: %w", media.ID, err)
+	}
+
+	updated, err := s.GetMedia(ctx, media.ID, media.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, domain.AuditActionUpdateMedia, domain.AuditEntityProject, media.ProjectID, old, updated)
+
+	return updated, nil
+}
+
+// ReorderMedia arranges the project gallery in the order of identifiers, which must list every item once.
+func (s *MediaService) ReorderMedia(ctx context.Context, projectID int32, mediaIDs []int32) ([]domain.ProjectMedia, error) {
+	if err := s.checkProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetProjectMedia(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("getting project %d media: %w", projectID, err)
+	}
+
+	oldOrder := make([]int32, 0, len(existing))
+	for _, m := range existing {
+		oldOrder = append(oldOrder, m.ID)
+	}
+
+	sorted := slices.Clone(mediaIDs)
+	slices.Sort(sorted)
+	expected := slices.Clone(oldOrder)
+	slices.Sort(expected)
+	if !slices.Equal(sorted, expected) {
+		return nil, apperr.NewInvalidRequest("Order must list every item of the gallery exactly once.", "ids")
+	}
+
+	err = s.repo.ReorderMedia(ctx, projectID, mediaIDs)
+	if err != nil {
+		return nil, fmt.Errorf("reordering project %d media: %w", projectID, err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionReorderMedia, domain.AuditEntityProject, projectID,
+		map[string][]int32{"order": oldOrder}, map[string][]int32{"order": mediaIDs})
+
+	return s.GetProjectMedia(ctx, projectID)
+}
+
+// DeleteMedia removes the item from the project gallery with its image files.
+func (s *MediaService) DeleteMedia(ctx context.Context, mediaID, projectID int32) error {
+	media, err := s.GetMedia(ctx, mediaID, projectID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteMedia(ctx, mediaID, projectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewNotFound("media_id")
+		}
+		return fmt.Errorf("deleting media %d: %w", mediaID, err)
+	}
+
+	if media.ImageID != "" {
+		s.images.delete(ctx, media.ImageID)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionRemoveMedia, domain.AuditEntityProject, projectID, media, nil)
+
+	return nil
+}
+
+// GetMediaImage returns gallery image and its opened variant of the given size, the file must be closed by the caller.
+func (s *MediaService) GetMediaImage(ctx context.Context, mediaID, projectID int32, size int) (*domain.ProjectMedia, *domain.File, error) {
+	if err := validateImageSize(size); err != nil {
+		return nil, nil, err
+	}
+
+	media, err := s.GetMedia(ctx, mediaID, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if media.ImageID == "" {
+		return nil, nil, apperr.NewNotFound("image_id")
+	}
+
+	file, err := s.images.open(ctx, media.ImageID, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening media image: %w", err)
+	}
+
+	return media, file, nil
+}
+
+func (s *MediaService) checkProject(ctx context.Context, projectID int32) error {
+	_, err := s.projectRepo.GetProject(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewNotFound("project_id")
+		}
+		return fmt.Errorf("getting project %d: %w", projectID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+)
+
+type mediaMocks struct {
+	repo        *mocks.MockProjectMediaRepository
+	projectRepo *mocks.MockProjectRepository
+	fileRepo    *mocks.MockFileRepository
+}
+
+func media(t *testing.T) (*service.MediaService, mediaMocks) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	m := mediaMocks{
+		repo:        mocks.NewMockProjectMediaRepository(mockCtl),
+		projectRepo: mocks.NewMockProjectRepository(mockCtl),
+		fileRepo:    mocks.NewMockFileRepository(mockCtl),
+	}
+	tx := mocks.NewMockTxManager(mockCtl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(withinTx).AnyTimes()
+	auditor := mocks.NewMockAuditor(mockCtl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	return service.NewMediaService(m.repo, m.projectRepo, m.fileRepo, tx, auditor), m
+}
+
+func TestMediaService_AddMedia(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should append video and make it rendered", func(t *testing.T) {
+		serv, m := media(t)
+
+		video := &domain.ProjectMedia{ProjectID: 1, Type: domain.MediaTypeVideo, VideoURL: "https://video.example/1", Caption: "**Demo**"}
+		m.projectRepo.EXPECT().GetProject(ctx, int32(1)).Return(&domain.Project{ID: 1}, nil)
+		gomock.InOrder(
+			m.repo.EXPECT().LockProjectMedia(gomock.Any(), int32(1)).Return(nil),
+			m.repo.EXPECT().GetProjectMedia(gomock.Any(), int32(1)).Return([]domain.ProjectMedia{}, nil),
+			m.repo.EXPECT().CreateMedia(gomock.Any(), video).Return(int32(5), nil),
+		)
+		m.repo.EXPECT().GetMedia(ctx, int32(5), int32(1)).Return(&domain.ProjectMedia{
+			ID: 5, ProjectID: 1, Type: domain.MediaTypeVideo, VideoURL: "https://video.example/1", Caption: "**Demo**", Position: 1,
+		}, nil)
+
+		created, err := serv.AddMedia(ctx, video, nil)
+		require.NoError(t, err)
+		require.Equal(t, int32(5), created.ID)
+		require.Equal(t, "<p><strong>Demo</strong></p>\n", created.CaptionHTML)
+	})
+
+	t.Run("should reject video cover and unsafe links", func(t *testing.T) {
+		serv, _ := media(t)
+
+		for _, video := range []*domain.ProjectMedia{
+			{ProjectID: 1, Type: domain.MediaTypeVideo, VideoURL: "https://video.example/1", IsCover: true},
+			{ProjectID: 1, Type: domain.MediaTypeVideo, VideoURL: "javascript:alert(1)"},
+		} {
+			_, err := serv.AddMedia(ctx, video, nil)
+
+			var appErr *apperr.Error
+			require.ErrorAs(t, err, &appErr)
+			require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+		}
+	})
+
+	t.Run("should limit gallery size", func(t *testing.T) {
+		serv, m := media(t)
+
+		m.projectRepo.EXPECT().GetProject(ctx, int32(1)).Return(&domain.Project{ID: 1}, nil)
+		gomock.InOrder(
+			m.repo.EXPECT().LockProjectMedia(gomock.Any(), int32(1)).Return(nil),
+			m.repo.EXPECT().GetProjectMedia(gomock.Any(), int32(1)).Return(make([]domain.ProjectMedia, domain.MaxProjectMedia), nil),
+		)
+
+		_, err := serv.AddMedia(ctx, &domain.ProjectMedia{ProjectID: 1, Type: domain.MediaTypeVideo, VideoURL: "https://video.example/1"}, nil)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+
+	t.Run("should delete image files when media is not created", func(t *testing.T) {
+		serv, m := media(t)
+
+		img := &bytes.Buffer{}
+		require.NoError(t, png.Encode(img, image.NewRGBA(image.Rect(0, 0, 300, 300))))
+
+		m.projectRepo.EXPECT().GetProject(ctx, int32(1)).Return(&domain.Project{ID: 1}, nil)
+		m.fileRepo.EXPECT().Save(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(3).Return(nil)
+		m.repo.EXPECT().LockProjectMedia(gomock.Any(), int32(1)).Return(repository.ErrObjectNotFound)
+		// Original and every variant, the original is not stored for new images
+		m.fileRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(4).Return(nil)
+
+		_, err := serv.AddMedia(ctx, &domain.ProjectMedia{ProjectID: 1, Type: domain.MediaTypeImage}, img.Bytes())
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.NotFoundType, appErr.Type)
+	})
+}
+
+func TestMediaService_UpdateMedia(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should unset previous cover", func(t *testing.T) {
+		serv, m := media(t)
+
+		m.repo.EXPECT().GetMedia(ctx, int32(3), int32(1)).
+			Return(&domain.ProjectMedia{ID: 3, ProjectID: 1, Type: domain.MediaTypeImage, ImageID: "img.webp"}, nil).Times(2)
+		gomock.InOrder(
+			m.repo.EXPECT().UnsetCover(gomock.Any(), int32(1)).Return(nil),
+			m.repo.EXPECT().UpdateMedia(gomock.Any(), &domain.ProjectMedia{
+				ID: 3, ProjectID: 1, Type: domain.MediaTypeImage, ImageID: "img.webp", Caption: "Main page", IsCover: true,
+			}).Return(nil),
+		)
+
+		_, err := serv.UpdateMedia(ctx, &domain.ProjectMedia{ID: 3, ProjectID: 1, Caption: "Main page", IsCover: true})
+		require.NoError(t, err)
+	})
+
+	t.Run("should report cover set concurrently", func(t *testing.T) {
+		serv, m := media(t)
+
+		m.repo.EXPECT().GetMedia(ctx, int32(3), int32(1)).
+			Return(&domain.ProjectMedia{ID: 3, ProjectID: 1, Type: domain.MediaTypeImage, ImageID: "img.webp"}, nil)
+		m.repo.EXPECT().UnsetCover(gomock.Any(), int32(1)).Return(nil)
+		m.repo.EXPECT().UpdateMedia(gomock.Any(), gomock.Any()).Return(repository.ErrDuplicate)
+
+		_, err := serv.UpdateMedia(ctx, &domain.ProjectMedia{ID: 3, ProjectID: 1, IsCover: true})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.DuplicateType, appErr.Type)
+	})
+
+	t.Run("should not give video link to image", func(t *testing.T) {
+		serv, m := media(t)
+
+		m.repo.EXPECT().GetMedia(ctx, int32(3), int32(1)).
+			Return(&domain.ProjectMedia{ID: 3, ProjectID: 1, Type: domain.MediaTypeImage, ImageID: "img.webp"}, nil)
+
+		_, err := serv.UpdateMedia(ctx, &domain.ProjectMedia{ID: 3, ProjectID: 1, VideoURL: "https://video.example/1"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+}
+
+func TestMediaService_ReorderMedia(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	gallery := []domain.ProjectMedia{{ID: 1, ProjectID: 7}, {ID: 2, ProjectID: 7}, {ID: 3, ProjectID: 7}}
+
+	t.Run("should save new order", func(t *testing.T) {
+		serv, m := media(t)
+
+		m.projectRepo.EXPECT().GetProject(ctx, int32(7)).Return(&domain.Project{ID: 7}, nil).Times(2)
+		m.repo.EXPECT().GetProjectMedia(ctx, int32(7)).Return(gallery, nil).Times(2)
+		m.repo.EXPECT().ReorderMedia(ctx, int32(7), []int32{3, 1, 2}).Return(nil)
+
+		_, err := serv.ReorderMedia(ctx, 7, []int32{3, 1, 2})
+		require.NoError(t, err)
+	})
+
+	t.Run("should require every item exactly once", func(t *testing.T) {
+		for _, ids := range [][]int32{{3, 1}, {3, 1, 1}, {3, 1, 2, 4}} {
+			serv, m := media(t)
+
+			m.projectRepo.EXPECT().GetProject(ctx, int32(7)).Return(&domain.Project{ID: 7}, nil)
+			m.repo.EXPECT().GetProjectMedia(ctx, int32(7)).Return(gallery, nil)
+
+			_, err := serv.ReorderMedia(ctx, 7, ids)
+
+			var appErr *apperr.Error
+			require.ErrorAs(t, err, &appErr)
+			require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+		}
+	})
+}
@@ -0,0 +1,317 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: board.go
+//
+// Generated by this command:
+//
+//	mockgen -source=board.go -destination=./mocks/board.go -package=mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "web-studio-backend/internal/app/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBoardRepository is a mock of BoardRepository interface.
+type MockBoardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardRepositoryMockRecorder
+}
+
+// MockBoardRepositoryMockRecorder is the mock recorder for MockBoardRepository.
+type MockBoardRepositoryMockRecorder struct {
+	mock *MockBoardRepository
+}
+
+// NewMockBoardRepository creates a new mock instance.
+func NewMockBoardRepository(ctrl *gomock.Controller) *MockBoardRepository {
+	mock := &MockBoardRepository{ctrl: ctrl}
+	mock.recorder = &MockBoardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardRepository) EXPECT() *MockBoardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddTaskMember mocks base method.
+func (m *MockBoardRepository) AddTaskMember(ctx context.Context, taskID, userID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTaskMember", ctx, taskID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTaskMember indicates an expected call of AddTaskMember.
+func (mr *MockBoardRepositoryMockRecorder) AddTaskMember(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTaskMember", reflect.TypeOf((*MockBoardRepository)(nil).AddTaskMember), ctx, taskID, userID)
+}
+
+// CreateBoard mocks base method.
+func (m *MockBoardRepository) CreateBoard(ctx context.Context, board *domain.Board) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBoard", ctx, board)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBoard indicates an expected call of CreateBoard.
+func (mr *MockBoardRepositoryMockRecorder) CreateBoard(ctx, board any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoard", reflect.TypeOf((*MockBoardRepository)(nil).CreateBoard), ctx, board)
+}
+
+// CreateColumn mocks base method.
+func (m *MockBoardRepository) CreateColumn(ctx context.Context, column *domain.BoardColumn) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateColumn", ctx, column)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateColumn indicates an expected call of CreateColumn.
+func (mr *MockBoardRepositoryMockRecorder) CreateColumn(ctx, column any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateColumn", reflect.TypeOf((*MockBoardRepository)(nil).CreateColumn), ctx, column)
+}
+
+// CreateTask mocks base method.
+func (m *MockBoardRepository) CreateTask(ctx context.Context, task *domain.BoardTask) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", ctx, task)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockBoardRepositoryMockRecorder) CreateTask(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockBoardRepository)(nil).CreateTask), ctx, task)
+}
+
+// DeleteBoard mocks base method.
+func (m *MockBoardRepository) DeleteBoard(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBoard", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBoard indicates an expected call of DeleteBoard.
+func (mr *MockBoardRepositoryMockRecorder) DeleteBoard(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoard", reflect.TypeOf((*MockBoardRepository)(nil).DeleteBoard), ctx, id)
+}
+
+// DeleteColumn mocks base method.
+func (m *MockBoardRepository) DeleteColumn(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteColumn", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteColumn indicates an expected call of DeleteColumn.
+func (mr *MockBoardRepositoryMockRecorder) DeleteColumn(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteColumn", reflect.TypeOf((*MockBoardRepository)(nil).DeleteColumn), ctx, id)
+}
+
+// DeleteTask mocks base method.
+func (m *MockBoardRepository) DeleteTask(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTask", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTask indicates an expected call of DeleteTask.
+func (mr *MockBoardRepositoryMockRecorder) DeleteTask(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockBoardRepository)(nil).DeleteTask), ctx, id)
+}
+
+// GetBoard mocks base method.
+func (m *MockBoardRepository) GetBoard(ctx context.Context, id int32) (*domain.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoard", ctx, id)
+	ret0, _ := ret[0].(*domain.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoard indicates an expected call of GetBoard.
+func (mr *MockBoardRepositoryMockRecorder) GetBoard(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockBoardRepository)(nil).GetBoard), ctx, id)
+}
+
+// GetColumn mocks base method.
+func (m *MockBoardRepository) GetColumn(ctx context.Context, id int32) (*domain.BoardColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumn", ctx, id)
+	ret0, _ := ret[0].(*domain.BoardColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumn indicates an expected call of GetColumn.
+func (mr *MockBoardRepositoryMockRecorder) GetColumn(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumn", reflect.TypeOf((*MockBoardRepository)(nil).GetColumn), ctx, id)
+}
+
+// GetColumns mocks base method.
+func (m *MockBoardRepository) GetColumns(ctx context.Context, boardID int32) ([]domain.BoardColumn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumns", ctx, boardID)
+	ret0, _ := ret[0].([]domain.BoardColumn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumns indicates an expected call of GetColumns.
+func (mr *MockBoardRepositoryMockRecorder) GetColumns(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumns", reflect.TypeOf((*MockBoardRepository)(nil).GetColumns), ctx, boardID)
+}
+
+// GetProjectBoards mocks base method.
+func (m *MockBoardRepository) GetProjectBoards(ctx context.Context, projectID int32) ([]domain.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectBoards", ctx, projectID)
+	ret0, _ := ret[0].([]domain.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectBoards indicates an expected call of GetProjectBoards.
+func (mr *MockBoardRepositoryMockRecorder) GetProjectBoards(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectBoards", reflect.TypeOf((*MockBoardRepository)(nil).GetProjectBoards), ctx, projectID)
+}
+
+// GetTask mocks base method.
+func (m *MockBoardRepository) GetTask(ctx context.Context, id int32) (*domain.BoardTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTask", ctx, id)
+	ret0, _ := ret[0].(*domain.BoardTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTask indicates an expected call of GetTask.
+func (mr *MockBoardRepositoryMockRecorder) GetTask(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockBoardRepository)(nil).GetTask), ctx, id)
+}
+
+// GetTaskMember mocks base method.
+func (m *MockBoardRepository) GetTaskMember(ctx context.Context, taskID, userID int32) (*domain.BoardTaskMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskMember", ctx, taskID, userID)
+	ret0, _ := ret[0].(*domain.BoardTaskMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskMember indicates an expected call of GetTaskMember.
+func (mr *MockBoardRepositoryMockRecorder) GetTaskMember(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskMember", reflect.TypeOf((*MockBoardRepository)(nil).GetTaskMember), ctx, taskID, userID)
+}
+
+// GetTaskMembers mocks base method.
+func (m *MockBoardRepository) GetTaskMembers(ctx context.Context, taskID int32) ([]domain.BoardTaskMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskMembers", ctx, taskID)
+	ret0, _ := ret[0].([]domain.BoardTaskMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskMembers indicates an expected call of GetTaskMembers.
+func (mr *MockBoardRepositoryMockRecorder) GetTaskMembers(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskMembers", reflect.TypeOf((*MockBoardRepository)(nil).GetTaskMembers), ctx, taskID)
+}
+
+// GetTasks mocks base method.
+func (m *MockBoardRepository) GetTasks(ctx context.Context, boardID int32) ([]domain.BoardTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasks", ctx, boardID)
+	ret0, _ := ret[0].([]domain.BoardTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasks indicates an expected call of GetTasks.
+func (mr *MockBoardRepositoryMockRecorder) GetTasks(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasks", reflect.TypeOf((*MockBoardRepository)(nil).GetTasks), ctx, boardID)
+}
+
+// RemoveTaskMember mocks base method.
+func (m *MockBoardRepository) RemoveTaskMember(ctx context.Context, taskID, userID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTaskMember", ctx, taskID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTaskMember indicates an expected call of RemoveTaskMember.
+func (mr *MockBoardRepositoryMockRecorder) RemoveTaskMember(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTaskMember", reflect.TypeOf((*MockBoardRepository)(nil).RemoveTaskMember), ctx, taskID, userID)
+}
+
+// UpdateBoard mocks base method.
+func (m *MockBoardRepository) UpdateBoard(ctx context.Context, board *domain.Board) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoard", ctx, board)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBoard indicates an expected call of UpdateBoard.
+func (mr *MockBoardRepositoryMockRecorder) UpdateBoard(ctx, board any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoard", reflect.TypeOf((*MockBoardRepository)(nil).UpdateBoard), ctx, board)
+}
+
+// UpdateColumn mocks base method.
+func (m *MockBoardRepository) UpdateColumn(ctx context.Context, column *domain.BoardColumn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateColumn", ctx, column)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateColumn indicates an expected call of UpdateColumn.
+func (mr *MockBoardRepositoryMockRecorder) UpdateColumn(ctx, column any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateColumn", reflect.TypeOf((*MockBoardRepository)(nil).UpdateColumn), ctx, column)
+}
+
+// UpdateTask mocks base method.
+func (m *MockBoardRepository) UpdateTask(ctx context.Context, task *domain.BoardTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTask", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTask indicates an expected call of UpdateTask.
+func (mr *MockBoardRepositoryMockRecorder) UpdateTask(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockBoardRepository)(nil).UpdateTask), ctx, task)
+}
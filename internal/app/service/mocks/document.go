@@ -11,6 +11,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	domain "web-studio-backend/internal/app/domain"
 
 	gomock "go.uber.org/mock/gomock"
@@ -68,6 +69,21 @@ func (mr *MockDocumentRepositoryMockRecorder) CreateDocument(ctx, doc any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDocument", reflect.TypeOf((*MockDocumentRepository)(nil).CreateDocument), ctx, doc)
 }
 
+// CreateDocumentVersion mocks base method.
+func (m *MockDocumentRepository) CreateDocumentVersion(ctx context.Context, v *domain.DocumentVersion) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDocumentVersion", ctx, v)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDocumentVersion indicates an expected call of CreateDocumentVersion.
+func (mr *MockDocumentRepositoryMockRecorder) CreateDocumentVersion(ctx, v any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDocumentVersion", reflect.TypeOf((*MockDocumentRepository)(nil).CreateDocumentVersion), ctx, v)
+}
+
 // DeleteDocument mocks base method.
 func (m *MockDocumentRepository) DeleteDocument(ctx context.Context, id int32) error {
 	m.ctrl.T.Helper()
@@ -82,6 +98,21 @@ func (mr *MockDocumentRepositoryMockRecorder) DeleteDocument(ctx, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDocument", reflect.TypeOf((*MockDocumentRepository)(nil).DeleteDocument), ctx, id)
 }
 
+// GetDeletedDocumentIDs mocks base method.
+func (m *MockDocumentRepository) GetDeletedDocumentIDs(ctx context.Context, deletedBefore time.Time) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedDocumentIDs", ctx, deletedBefore)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedDocumentIDs indicates an expected call of GetDeletedDocumentIDs.
+func (mr *MockDocumentRepositoryMockRecorder) GetDeletedDocumentIDs(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedDocumentIDs", reflect.TypeOf((*MockDocumentRepository)(nil).GetDeletedDocumentIDs), ctx, deletedBefore)
+}
+
 // GetDocument mocks base method.
 func (m *MockDocumentRepository) GetDocument(ctx context.Context, id int32) (*domain.Document, error) {
 	m.ctrl.T.Helper()
@@ -97,19 +128,110 @@ func (mr *MockDocumentRepositoryMockRecorder) GetDocument(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocument", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocument), ctx, id)
 }
 
-// GetProjectDocuments mocks base method.
-func (m *MockDocumentRepository) GetProjectDocuments(ctx context.Context, projectID int32) ([]domain.Document, error) {
+// GetDocumentFileIDs mocks base method.
+func (m *MockDocumentRepository) GetDocumentFileIDs(ctx context.Context, docID int32) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProjectDocuments", ctx, projectID)
-	ret0, _ := ret[0].([]domain.Document)
+	ret := m.ctrl.Call(m, "GetDocumentFileIDs", ctx, docID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentFileIDs indicates an expected call of GetDocumentFileIDs.
+func (mr *MockDocumentRepositoryMockRecorder) GetDocumentFileIDs(ctx, docID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentFileIDs", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocumentFileIDs), ctx, docID)
+}
+
+// GetDocumentVersion mocks base method.
+func (m *MockDocumentRepository) GetDocumentVersion(ctx context.Context, docID, version int32) (*domain.DocumentVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentVersion", ctx, docID, version)
+	ret0, _ := ret[0].(*domain.DocumentVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentVersion indicates an expected call of GetDocumentVersion.
+func (mr *MockDocumentRepositoryMockRecorder) GetDocumentVersion(ctx, docID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentVersion", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocumentVersion), ctx, docID, version)
+}
+
+// GetDocumentVersions mocks base method.
+func (m *MockDocumentRepository) GetDocumentVersions(ctx context.Context, docID int32, params *domain.ListParams) ([]domain.DocumentVersion, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentVersions", ctx, docID, params)
+	ret0, _ := ret[0].([]domain.DocumentVersion)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDocumentVersions indicates an expected call of GetDocumentVersions.
+func (mr *MockDocumentRepositoryMockRecorder) GetDocumentVersions(ctx, docID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentVersions", reflect.TypeOf((*MockDocumentRepository)(nil).GetDocumentVersions), ctx, docID, params)
+}
+
+// GetProjectDocument mocks base method.
+func (m *MockDocumentRepository) GetProjectDocument(ctx context.Context, docID, projectID int32) (*domain.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectDocument", ctx, docID, projectID)
+	ret0, _ := ret[0].(*domain.Document)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetProjectDocument indicates an expected call of GetProjectDocument.
+func (mr *MockDocumentRepositoryMockRecorder) GetProjectDocument(ctx, docID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDocument", reflect.TypeOf((*MockDocumentRepository)(nil).GetProjectDocument), ctx, docID, projectID)
+}
+
+// GetProjectDocumentIDs mocks base method.
+func (m *MockDocumentRepository) GetProjectDocumentIDs(ctx context.Context, projectID int32) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectDocumentIDs", ctx, projectID)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectDocumentIDs indicates an expected call of GetProjectDocumentIDs.
+func (mr *MockDocumentRepositoryMockRecorder) GetProjectDocumentIDs(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDocumentIDs", reflect.TypeOf((*MockDocumentRepository)(nil).GetProjectDocumentIDs), ctx, projectID)
+}
+
+// GetProjectDocuments mocks base method.
+func (m *MockDocumentRepository) GetProjectDocuments(ctx context.Context, projectID int32, params *domain.ListParams, deleted bool) ([]domain.Document, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectDocuments", ctx, projectID, params, deleted)
+	ret0, _ := ret[0].([]domain.Document)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // GetProjectDocuments indicates an expected call of GetProjectDocuments.
-func (mr *MockDocumentRepositoryMockRecorder) GetProjectDocuments(ctx, projectID any) *gomock.Call {
+func (mr *MockDocumentRepositoryMockRecorder) GetProjectDocuments(ctx, projectID, params, deleted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDocuments", reflect.TypeOf((*MockDocumentRepository)(nil).GetProjectDocuments), ctx, projectID, params, deleted)
+}
+
+// PurgeDocument mocks base method.
+func (m *MockDocumentRepository) PurgeDocument(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDocument", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDocument indicates an expected call of PurgeDocument.
+func (mr *MockDocumentRepositoryMockRecorder) PurgeDocument(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDocuments", reflect.TypeOf((*MockDocumentRepository)(nil).GetProjectDocuments), ctx, projectID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDocument", reflect.TypeOf((*MockDocumentRepository)(nil).PurgeDocument), ctx, id)
 }
 
 // RemoveDocumentFromProject mocks base method.
@@ -125,3 +247,17 @@ func (mr *MockDocumentRepositoryMockRecorder) RemoveDocumentFromProject(ctx, doc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDocumentFromProject", reflect.TypeOf((*MockDocumentRepository)(nil).RemoveDocumentFromProject), ctx, docID, projectID)
 }
+
+// RestoreDocument mocks base method.
+func (m *MockDocumentRepository) RestoreDocument(ctx context.Context, docID, projectID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDocument", ctx, docID, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDocument indicates an expected call of RestoreDocument.
+func (mr *MockDocumentRepositoryMockRecorder) RestoreDocument(ctx, docID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDocument", reflect.TypeOf((*MockDocumentRepository)(nil).RestoreDocument), ctx, docID, projectID)
+}
@@ -10,7 +10,9 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	domain "web-studio-backend/internal/app/domain"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -52,31 +54,31 @@ func (mr *MockFileRepositoryMockRecorder) Delete(ctx, fileName any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFileRepository)(nil).Delete), ctx, fileName)
 }
 
-// Read mocks base method.
-func (m *MockFileRepository) Read(ctx context.Context, fileName string) ([]byte, error) {
+// Open mocks base method.
+func (m *MockFileRepository) Open(ctx context.Context, fileName string) (*domain.File, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Read", ctx, fileName)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "Open", ctx, fileName)
+	ret0, _ := ret[0].(*domain.File)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Read indicates an expected call of Read.
-func (mr *MockFileRepositoryMockRecorder) Read(ctx, fileName any) *gomock.Call {
+// Open indicates an expected call of Open.
+func (mr *MockFileRepositoryMockRecorder) Open(ctx, fileName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockFileRepository)(nil).Read), ctx, fileName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockFileRepository)(nil).Open), ctx, fileName)
 }
 
 // Save mocks base method.
-func (m *MockFileRepository) Save(ctx context.Context, data []byte, fileName string) error {
+func (m *MockFileRepository) Save(ctx context.Context, r io.Reader, size int64, fileName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", ctx, data, fileName)
+	ret := m.ctrl.Call(m, "Save", ctx, r, size, fileName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockFileRepositoryMockRecorder) Save(ctx, data, fileName any) *gomock.Call {
+func (mr *MockFileRepositoryMockRecorder) Save(ctx, r, size, fileName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockFileRepository)(nil).Save), ctx, data, fileName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockFileRepository)(nil).Save), ctx, r, size, fileName)
 }
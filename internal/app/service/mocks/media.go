@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: media.go
+//
+// Generated by this command:
+//
+//	mockgen -source=media.go -destination=./mocks/media.go -package=mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "web-studio-backend/internal/app/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectMediaRepository is a mock of ProjectMediaRepository interface.
+type MockProjectMediaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectMediaRepositoryMockRecorder
+}
+
+// MockProjectMediaRepositoryMockRecorder is the mock recorder for MockProjectMediaRepository.
+type MockProjectMediaRepositoryMockRecorder struct {
+	mock *MockProjectMediaRepository
+}
+
+// NewMockProjectMediaRepository creates a new mock instance.
+func NewMockProjectMediaRepository(ctrl *gomock.Controller) *MockProjectMediaRepository {
+	mock := &MockProjectMediaRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectMediaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectMediaRepository) EXPECT() *MockProjectMediaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateMedia mocks base method.
+func (m_2 *MockProjectMediaRepository) CreateMedia(ctx context.Context, m *domain.ProjectMedia) (int32, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "CreateMedia", ctx, m)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMedia indicates an expected call of CreateMedia.
+func (mr *MockProjectMediaRepositoryMockRecorder) CreateMedia(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMedia", reflect.TypeOf((*MockProjectMediaRepository)(nil).CreateMedia), ctx, m)
+}
+
+// DeleteMedia mocks base method.
+func (m *MockProjectMediaRepository) DeleteMedia(ctx context.Context, mediaID, projectID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMedia", ctx, mediaID, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMedia indicates an expected call of DeleteMedia.
+func (mr *MockProjectMediaRepositoryMockRecorder) DeleteMedia(ctx, mediaID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMedia", reflect.TypeOf((*MockProjectMediaRepository)(nil).DeleteMedia), ctx, mediaID, projectID)
+}
+
+// GetMedia mocks base method.
+func (m *MockProjectMediaRepository) GetMedia(ctx context.Context, mediaID, projectID int32) (*domain.ProjectMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMedia", ctx, mediaID, projectID)
+	ret0, _ := ret[0].(*domain.ProjectMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMedia indicates an expected call of GetMedia.
+func (mr *MockProjectMediaRepositoryMockRecorder) GetMedia(ctx, mediaID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedia", reflect.TypeOf((*MockProjectMediaRepository)(nil).GetMedia), ctx, mediaID, projectID)
+}
+
+// GetProjectMedia mocks base method.
+func (m *MockProjectMediaRepository) GetProjectMedia(ctx context.Context, projectID int32) ([]domain.ProjectMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectMedia", ctx, projectID)
+	ret0, _ := ret[0].([]domain.ProjectMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectMedia indicates an expected call of GetProjectMedia.
+func (mr *MockProjectMediaRepositoryMockRecorder) GetProjectMedia(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectMedia", reflect.TypeOf((*MockProjectMediaRepository)(nil).GetProjectMedia), ctx, projectID)
+}
+
+// LockProjectMedia mocks base method.
+func (m *MockProjectMediaRepository) LockProjectMedia(ctx context.Context, projectID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockProjectMedia", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockProjectMedia indicates an expected call of LockProjectMedia.
+func (mr *MockProjectMediaRepositoryMockRecorder) LockProjectMedia(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockProjectMedia", reflect.TypeOf((*MockProjectMediaRepository)(nil).LockProjectMedia), ctx, projectID)
+}
+
+// ReorderMedia mocks base method.
+func (m *MockProjectMediaRepository) ReorderMedia(ctx context.Context, projectID int32, mediaIDs []int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderMedia", ctx, projectID, mediaIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderMedia indicates an expected call of ReorderMedia.
+func (mr *MockProjectMediaRepositoryMockRecorder) ReorderMedia(ctx, projectID, mediaIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderMedia", reflect.TypeOf((*MockProjectMediaRepository)(nil).ReorderMedia), ctx, projectID, mediaIDs)
+}
+
+// UnsetCover mocks base method.
+func (m *MockProjectMediaRepository) UnsetCover(ctx context.Context, projectID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetCover", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetCover indicates an expected call of UnsetCover.
+func (mr *MockProjectMediaRepositoryMockRecorder) UnsetCover(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetCover", reflect.TypeOf((*MockProjectMediaRepository)(nil).UnsetCover), ctx, projectID)
+}
+
+// UpdateMedia mocks base method.
+func (m_2 *MockProjectMediaRepository) UpdateMedia(ctx context.Context, m *domain.ProjectMedia) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "UpdateMedia", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMedia indicates an expected call of UpdateMedia.
+func (mr *MockProjectMediaRepositoryMockRecorder) UpdateMedia(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMedia", reflect.TypeOf((*MockProjectMediaRepository)(nil).UpdateMedia), ctx, m)
+}
@@ -11,6 +11,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	domain "web-studio-backend/internal/app/domain"
 
 	gomock "go.uber.org/mock/gomock"
@@ -53,6 +54,20 @@ func (mr *MockProjectRepositoryMockRecorder) AddParticipant(ctx, participant any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddParticipant", reflect.TypeOf((*MockProjectRepository)(nil).AddParticipant), ctx, participant)
 }
 
+// ChangeProjectSlug mocks base method.
+func (m *MockProjectRepository) ChangeProjectSlug(ctx context.Context, id int32, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeProjectSlug", ctx, id, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeProjectSlug indicates an expected call of ChangeProjectSlug.
+func (mr *MockProjectRepositoryMockRecorder) ChangeProjectSlug(ctx, id, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeProjectSlug", reflect.TypeOf((*MockProjectRepository)(nil).ChangeProjectSlug), ctx, id, slug)
+}
+
 // CreateProject mocks base method.
 func (m *MockProjectRepository) CreateProject(ctx context.Context, project *domain.Project) (int32, error) {
 	m.ctrl.T.Helper()
@@ -68,6 +83,34 @@ func (mr *MockProjectRepositoryMockRecorder) CreateProject(ctx, project any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectRepository)(nil).CreateProject), ctx, project)
 }
 
+// DeleteProject mocks base method.
+func (m *MockProjectRepository) DeleteProject(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockProjectRepositoryMockRecorder) DeleteProject(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectRepository)(nil).DeleteProject), ctx, id)
+}
+
+// DeleteProjectTranslation mocks base method.
+func (m *MockProjectRepository) DeleteProjectTranslation(ctx context.Context, projectID int32, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectTranslation", ctx, projectID, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectTranslation indicates an expected call of DeleteProjectTranslation.
+func (mr *MockProjectRepositoryMockRecorder) DeleteProjectTranslation(ctx, projectID, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectTranslation", reflect.TypeOf((*MockProjectRepository)(nil).DeleteProjectTranslation), ctx, projectID, locale)
+}
+
 // DisableProject mocks base method.
 func (m *MockProjectRepository) DisableProject(ctx context.Context, id int32) error {
 	m.ctrl.T.Helper()
@@ -82,19 +125,20 @@ func (mr *MockProjectRepositoryMockRecorder) DisableProject(ctx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableProject", reflect.TypeOf((*MockProjectRepository)(nil).DisableProject), ctx, id)
 }
 
-// GetActiveProject mocks base method.
-func (m *MockProjectRepository) GetActiveProject(ctx context.Context, id int32) (*domain.Project, error) {
+// GetDeletedProjects mocks base method.
+func (m *MockProjectRepository) GetDeletedProjects(ctx context.Context, params *domain.ListParams, deletedBefore time.Time) ([]domain.Project, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActiveProject", ctx, id)
-	ret0, _ := ret[0].(*domain.Project)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetDeletedProjects", ctx, params, deletedBefore)
+	ret0, _ := ret[0].([]domain.Project)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetActiveProject indicates an expected call of GetActiveProject.
-func (mr *MockProjectRepositoryMockRecorder) GetActiveProject(ctx, id any) *gomock.Call {
+// GetDeletedProjects indicates an expected call of GetDeletedProjects.
+func (mr *MockProjectRepositoryMockRecorder) GetDeletedProjects(ctx, params, deletedBefore any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveProject", reflect.TypeOf((*MockProjectRepository)(nil).GetActiveProject), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedProjects", reflect.TypeOf((*MockProjectRepository)(nil).GetDeletedProjects), ctx, params, deletedBefore)
 }
 
 // GetParticipant mocks base method.
@@ -113,18 +157,19 @@ func (mr *MockProjectRepositoryMockRecorder) GetParticipant(ctx, participantID,
 }
 
 // GetParticipants mocks base method.
-func (m *MockProjectRepository) GetParticipants(ctx context.Context, projectID int32) ([]domain.ProjectParticipant, error) {
+func (m *MockProjectRepository) GetParticipants(ctx context.Context, projectID int32, params *domain.ListParams, filter *domain.ParticipantFilter) ([]domain.ProjectParticipant, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetParticipants", ctx, projectID)
+	ret := m.ctrl.Call(m, "GetParticipants", ctx, projectID, params, filter)
 	ret0, _ := ret[0].([]domain.ProjectParticipant)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetParticipants indicates an expected call of GetParticipants.
-func (mr *MockProjectRepositoryMockRecorder) GetParticipants(ctx, projectID any) *gomock.Call {
+func (mr *MockProjectRepositoryMockRecorder) GetParticipants(ctx, projectID, params, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipants", reflect.TypeOf((*MockProjectRepository)(nil).GetParticipants), ctx, projectID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipants", reflect.TypeOf((*MockProjectRepository)(nil).GetParticipants), ctx, projectID, params, filter)
 }
 
 // GetProject mocks base method.
@@ -142,19 +187,109 @@ func (mr *MockProjectRepositoryMockRecorder) GetProject(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockProjectRepository)(nil).GetProject), ctx, id)
 }
 
+// GetProjectBySlug mocks base method.
+func (m *MockProjectRepository) GetProjectBySlug(ctx context.Context, slug string) (*domain.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectBySlug", ctx, slug)
+	ret0, _ := ret[0].(*domain.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectBySlug indicates an expected call of GetProjectBySlug.
+func (mr *MockProjectRepositoryMockRecorder) GetProjectBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectBySlug", reflect.TypeOf((*MockProjectRepository)(nil).GetProjectBySlug), ctx, slug)
+}
+
+// GetProjectSlugRedirect mocks base method.
+func (m *MockProjectRepository) GetProjectSlugRedirect(ctx context.Context, slug string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectSlugRedirect", ctx, slug)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectSlugRedirect indicates an expected call of GetProjectSlugRedirect.
+func (mr *MockProjectRepositoryMockRecorder) GetProjectSlugRedirect(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectSlugRedirect", reflect.TypeOf((*MockProjectRepository)(nil).GetProjectSlugRedirect), ctx, slug)
+}
+
+// GetProjectTranslations mocks base method.
+func (m *MockProjectRepository) GetProjectTranslations(ctx context.Context, projectID int32) ([]domain.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectTranslations", ctx, projectID)
+	ret0, _ := ret[0].([]domain.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectTranslations indicates an expected call of GetProjectTranslations.
+func (mr *MockProjectRepositoryMockRecorder) GetProjectTranslations(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectTranslations", reflect.TypeOf((*MockProjectRepository)(nil).GetProjectTranslations), ctx, projectID)
+}
+
+// GetProjectTranslationsByLocale mocks base method.
+func (m *MockProjectRepository) GetProjectTranslationsByLocale(ctx context.Context, projectIDs []int32, locale string) (map[int32]domain.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectTranslationsByLocale", ctx, projectIDs, locale)
+	ret0, _ := ret[0].(map[int32]domain.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectTranslationsByLocale indicates an expected call of GetProjectTranslationsByLocale.
+func (mr *MockProjectRepositoryMockRecorder) GetProjectTranslationsByLocale(ctx, projectIDs, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectTranslationsByLocale", reflect.TypeOf((*MockProjectRepository)(nil).GetProjectTranslationsByLocale), ctx, projectIDs, locale)
+}
+
 // GetProjects mocks base method.
-func (m *MockProjectRepository) GetProjects(ctx context.Context) ([]domain.Project, error) {
+func (m *MockProjectRepository) GetProjects(ctx context.Context, params *domain.ListParams, filter *domain.ProjectFilter) ([]domain.Project, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProjects", ctx)
+	ret := m.ctrl.Call(m, "GetProjects", ctx, params, filter)
 	ret0, _ := ret[0].([]domain.Project)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjects indicates an expected call of GetProjects.
+func (mr *MockProjectRepositoryMockRecorder) GetProjects(ctx, params, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjects", reflect.TypeOf((*MockProjectRepository)(nil).GetProjects), ctx, params, filter)
+}
+
+// ProjectSlugExists mocks base method.
+func (m *MockProjectRepository) ProjectSlugExists(ctx context.Context, slug string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectSlugExists", ctx, slug)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProjects indicates an expected call of GetProjects.
-func (mr *MockProjectRepositoryMockRecorder) GetProjects(ctx any) *gomock.Call {
+// ProjectSlugExists indicates an expected call of ProjectSlugExists.
+func (mr *MockProjectRepositoryMockRecorder) ProjectSlugExists(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectSlugExists", reflect.TypeOf((*MockProjectRepository)(nil).ProjectSlugExists), ctx, slug)
+}
+
+// PurgeProject mocks base method.
+func (m *MockProjectRepository) PurgeProject(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeProject", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeProject indicates an expected call of PurgeProject.
+func (mr *MockProjectRepositoryMockRecorder) PurgeProject(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjects", reflect.TypeOf((*MockProjectRepository)(nil).GetProjects), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeProject", reflect.TypeOf((*MockProjectRepository)(nil).PurgeProject), ctx, id)
 }
 
 // RemoveParticipant mocks base method.
@@ -171,6 +306,48 @@ func (mr *MockProjectRepositoryMockRecorder) RemoveParticipant(ctx, participantI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveParticipant", reflect.TypeOf((*MockProjectRepository)(nil).RemoveParticipant), ctx, participantID, projectID)
 }
 
+// RestoreProject mocks base method.
+func (m *MockProjectRepository) RestoreProject(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProject", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreProject indicates an expected call of RestoreProject.
+func (mr *MockProjectRepositoryMockRecorder) RestoreProject(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProject", reflect.TypeOf((*MockProjectRepository)(nil).RestoreProject), ctx, id)
+}
+
+// SaveProjectTranslation mocks base method.
+func (m *MockProjectRepository) SaveProjectTranslation(ctx context.Context, projectID int32, t *domain.Translation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProjectTranslation", ctx, projectID, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProjectTranslation indicates an expected call of SaveProjectTranslation.
+func (mr *MockProjectRepositoryMockRecorder) SaveProjectTranslation(ctx, projectID, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProjectTranslation", reflect.TypeOf((*MockProjectRepository)(nil).SaveProjectTranslation), ctx, projectID, t)
+}
+
+// SetProjectImageID mocks base method.
+func (m *MockProjectRepository) SetProjectImageID(ctx context.Context, projectID int32, imageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectImageID", ctx, projectID, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProjectImageID indicates an expected call of SetProjectImageID.
+func (mr *MockProjectRepositoryMockRecorder) SetProjectImageID(ctx, projectID, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectImageID", reflect.TypeOf((*MockProjectRepository)(nil).SetProjectImageID), ctx, projectID, imageID)
+}
+
 // UpdateParticipant mocks base method.
 func (m *MockProjectRepository) UpdateParticipant(ctx context.Context, participant *domain.ProjectParticipant) error {
 	m.ctrl.T.Helper()
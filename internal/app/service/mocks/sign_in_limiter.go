@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sign_in_limiter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sign_in_limiter.go -destination=./mocks/sign_in_limiter.go -package=mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "web-studio-backend/internal/app/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSignInThrottleRepository is a mock of SignInThrottleRepository interface.
+type MockSignInThrottleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignInThrottleRepositoryMockRecorder
+}
+
+// MockSignInThrottleRepositoryMockRecorder is the mock recorder for MockSignInThrottleRepository.
+type MockSignInThrottleRepositoryMockRecorder struct {
+	mock *MockSignInThrottleRepository
+}
+
+// NewMockSignInThrottleRepository creates a new mock instance.
+func NewMockSignInThrottleRepository(ctrl *gomock.Controller) *MockSignInThrottleRepository {
+	mock := &MockSignInThrottleRepository{ctrl: ctrl}
+	mock.recorder = &MockSignInThrottleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignInThrottleRepository) EXPECT() *MockSignInThrottleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddSignInFailure mocks base method.
+func (m *MockSignInThrottleRepository) AddSignInFailure(ctx context.Context, kind domain.SignInThrottleKind, subject string, windowStart time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSignInFailure", ctx, kind, subject, windowStart)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSignInFailure indicates an expected call of AddSignInFailure.
+func (mr *MockSignInThrottleRepositoryMockRecorder) AddSignInFailure(ctx, kind, subject, windowStart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSignInFailure", reflect.TypeOf((*MockSignInThrottleRepository)(nil).AddSignInFailure), ctx, kind, subject, windowStart)
+}
+
+// BlockSignIn mocks base method.
+func (m *MockSignInThrottleRepository) BlockSignIn(ctx context.Context, kind domain.SignInThrottleKind, subject string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSignIn", ctx, kind, subject, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockSignIn indicates an expected call of BlockSignIn.
+func (mr *MockSignInThrottleRepositoryMockRecorder) BlockSignIn(ctx, kind, subject, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSignIn", reflect.TypeOf((*MockSignInThrottleRepository)(nil).BlockSignIn), ctx, kind, subject, until)
+}
+
+// DeleteSignInThrottle mocks base method.
+func (m *MockSignInThrottleRepository) DeleteSignInThrottle(ctx context.Context, kind domain.SignInThrottleKind, subject string, staleBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSignInThrottle", ctx, kind, subject, staleBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSignInThrottle indicates an expected call of DeleteSignInThrottle.
+func (mr *MockSignInThrottleRepositoryMockRecorder) DeleteSignInThrottle(ctx, kind, subject, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSignInThrottle", reflect.TypeOf((*MockSignInThrottleRepository)(nil).DeleteSignInThrottle), ctx, kind, subject, staleBefore)
+}
+
+// GetSignInThrottle mocks base method.
+func (m *MockSignInThrottleRepository) GetSignInThrottle(ctx context.Context, kind domain.SignInThrottleKind, subject string) (*domain.SignInThrottle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignInThrottle", ctx, kind, subject)
+	ret0, _ := ret[0].(*domain.SignInThrottle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignInThrottle indicates an expected call of GetSignInThrottle.
+func (mr *MockSignInThrottleRepositoryMockRecorder) GetSignInThrottle(ctx, kind, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignInThrottle", reflect.TypeOf((*MockSignInThrottleRepository)(nil).GetSignInThrottle), ctx, kind, subject)
+}
+
+// RemoveSignInFailure mocks base method.
+func (m *MockSignInThrottleRepository) RemoveSignInFailure(ctx context.Context, kind domain.SignInThrottleKind, subject string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSignInFailure", ctx, kind, subject)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSignInFailure indicates an expected call of RemoveSignInFailure.
+func (mr *MockSignInThrottleRepositoryMockRecorder) RemoveSignInFailure(ctx, kind, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSignInFailure", reflect.TypeOf((*MockSignInThrottleRepository)(nil).RemoveSignInFailure), ctx, kind, subject)
+}
+
+// MockSystemAuditor is a mock of SystemAuditor interface.
+type MockSystemAuditor struct {
+	ctrl     *gomock.Controller
+	recorder *MockSystemAuditorMockRecorder
+}
+
+// MockSystemAuditorMockRecorder is the mock recorder for MockSystemAuditor.
+type MockSystemAuditorMockRecorder struct {
+	mock *MockSystemAuditor
+}
+
+// NewMockSystemAuditor creates a new mock instance.
+func NewMockSystemAuditor(ctrl *gomock.Controller) *MockSystemAuditor {
+	mock := &MockSystemAuditor{ctrl: ctrl}
+	mock.recorder = &MockSystemAuditorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSystemAuditor) EXPECT() *MockSystemAuditorMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockSystemAuditor) Record(ctx context.Context, action domain.AuditAction, entity domain.AuditEntity, entityID int32, before, after any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", ctx, action, entity, entityID, before, after)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockSystemAuditorMockRecorder) Record(ctx, action, entity, entityID, before, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockSystemAuditor)(nil).Record), ctx, action, entity, entityID, before, after)
+}
+
+// RecordSystem mocks base method.
+func (m *MockSystemAuditor) RecordSystem(ctx context.Context, action domain.AuditAction, entity domain.AuditEntity, entityID int32, details any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordSystem", ctx, action, entity, entityID, details)
+}
+
+// RecordSystem indicates an expected call of RecordSystem.
+func (mr *MockSystemAuditorMockRecorder) RecordSystem(ctx, action, entity, entityID, details any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSystem", reflect.TypeOf((*MockSystemAuditor)(nil).RecordSystem), ctx, action, entity, entityID, details)
+}
@@ -39,6 +39,34 @@ func (m *MockTeamRepository) EXPECT() *MockTeamRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddMember mocks base method.
+func (m *MockTeamRepository) AddMember(ctx context.Context, member *domain.TeamMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockTeamRepositoryMockRecorder) AddMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockTeamRepository)(nil).AddMember), ctx, member)
+}
+
+// ChangeTeamSlug mocks base method.
+func (m *MockTeamRepository) ChangeTeamSlug(ctx context.Context, id int32, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeTeamSlug", ctx, id, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeTeamSlug indicates an expected call of ChangeTeamSlug.
+func (mr *MockTeamRepositoryMockRecorder) ChangeTeamSlug(ctx, id, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeTeamSlug", reflect.TypeOf((*MockTeamRepository)(nil).ChangeTeamSlug), ctx, id, slug)
+}
+
 // CheckTeamUniqueness mocks base method.
 func (m *MockTeamRepository) CheckTeamUniqueness(ctx context.Context, title string) (*domain.Team, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +97,20 @@ func (mr *MockTeamRepositoryMockRecorder) CreateTeam(ctx, team any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockTeamRepository)(nil).CreateTeam), ctx, team)
 }
 
+// DeleteTeamTranslation mocks base method.
+func (m *MockTeamRepository) DeleteTeamTranslation(ctx context.Context, teamID int32, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeamTranslation", ctx, teamID, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeamTranslation indicates an expected call of DeleteTeamTranslation.
+func (mr *MockTeamRepositoryMockRecorder) DeleteTeamTranslation(ctx, teamID, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeamTranslation", reflect.TypeOf((*MockTeamRepository)(nil).DeleteTeamTranslation), ctx, teamID, locale)
+}
+
 // DisableTeam mocks base method.
 func (m *MockTeamRepository) DisableTeam(ctx context.Context, teamID int32) error {
 	m.ctrl.T.Helper()
@@ -97,6 +139,36 @@ func (mr *MockTeamRepositoryMockRecorder) EnableTeam(ctx, teamID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTeam", reflect.TypeOf((*MockTeamRepository)(nil).EnableTeam), ctx, teamID)
 }
 
+// GetMember mocks base method.
+func (m *MockTeamRepository) GetMember(ctx context.Context, memberID, teamID int32) (*domain.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, memberID, teamID)
+	ret0, _ := ret[0].(*domain.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockTeamRepositoryMockRecorder) GetMember(ctx, memberID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockTeamRepository)(nil).GetMember), ctx, memberID, teamID)
+}
+
+// GetMembers mocks base method.
+func (m *MockTeamRepository) GetMembers(ctx context.Context, teamID int32) ([]domain.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", ctx, teamID)
+	ret0, _ := ret[0].([]domain.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockTeamRepositoryMockRecorder) GetMembers(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockTeamRepository)(nil).GetMembers), ctx, teamID)
+}
+
 // GetTeam mocks base method.
 func (m *MockTeamRepository) GetTeam(ctx context.Context, id int32) (*domain.Team, error) {
 	m.ctrl.T.Helper()
@@ -112,19 +184,108 @@ func (mr *MockTeamRepositoryMockRecorder) GetTeam(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockTeamRepository)(nil).GetTeam), ctx, id)
 }
 
-// GetTeams mocks base method.
-func (m *MockTeamRepository) GetTeams(ctx context.Context) ([]domain.Team, error) {
+// GetTeamBySlug mocks base method.
+func (m *MockTeamRepository) GetTeamBySlug(ctx context.Context, slug string) (*domain.Team, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTeams", ctx)
-	ret0, _ := ret[0].([]domain.Team)
+	ret := m.ctrl.Call(m, "GetTeamBySlug", ctx, slug)
+	ret0, _ := ret[0].(*domain.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamBySlug indicates an expected call of GetTeamBySlug.
+func (mr *MockTeamRepositoryMockRecorder) GetTeamBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamBySlug", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamBySlug), ctx, slug)
+}
+
+// GetTeamSlugRedirect mocks base method.
+func (m *MockTeamRepository) GetTeamSlugRedirect(ctx context.Context, slug string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamSlugRedirect", ctx, slug)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamSlugRedirect indicates an expected call of GetTeamSlugRedirect.
+func (mr *MockTeamRepositoryMockRecorder) GetTeamSlugRedirect(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamSlugRedirect", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamSlugRedirect), ctx, slug)
+}
+
+// GetTeamTranslations mocks base method.
+func (m *MockTeamRepository) GetTeamTranslations(ctx context.Context, teamID int32) ([]domain.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamTranslations", ctx, teamID)
+	ret0, _ := ret[0].([]domain.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamTranslations indicates an expected call of GetTeamTranslations.
+func (mr *MockTeamRepositoryMockRecorder) GetTeamTranslations(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamTranslations", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamTranslations), ctx, teamID)
+}
+
+// GetTeamTranslationsByLocale mocks base method.
+func (m *MockTeamRepository) GetTeamTranslationsByLocale(ctx context.Context, teamIDs []int32, locale string) (map[int32]domain.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamTranslationsByLocale", ctx, teamIDs, locale)
+	ret0, _ := ret[0].(map[int32]domain.Translation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetTeamTranslationsByLocale indicates an expected call of GetTeamTranslationsByLocale.
+func (mr *MockTeamRepositoryMockRecorder) GetTeamTranslationsByLocale(ctx, teamIDs, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamTranslationsByLocale", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamTranslationsByLocale), ctx, teamIDs, locale)
+}
+
+// GetTeams mocks base method.
+func (m *MockTeamRepository) GetTeams(ctx context.Context, params *domain.ListParams, filter *domain.TeamFilter) ([]domain.Team, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeams", ctx, params, filter)
+	ret0, _ := ret[0].([]domain.Team)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // GetTeams indicates an expected call of GetTeams.
-func (mr *MockTeamRepositoryMockRecorder) GetTeams(ctx any) *gomock.Call {
+func (mr *MockTeamRepositoryMockRecorder) GetTeams(ctx, params, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeams", reflect.TypeOf((*MockTeamRepository)(nil).GetTeams), ctx, params, filter)
+}
+
+// RemoveMember mocks base method.
+func (m *MockTeamRepository) RemoveMember(ctx context.Context, memberID, teamID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, memberID, teamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockTeamRepositoryMockRecorder) RemoveMember(ctx, memberID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockTeamRepository)(nil).RemoveMember), ctx, memberID, teamID)
+}
+
+// SaveTeamTranslation mocks base method.
+func (m *MockTeamRepository) SaveTeamTranslation(ctx context.Context, teamID int32, t *domain.Translation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTeamTranslation", ctx, teamID, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTeamTranslation indicates an expected call of SaveTeamTranslation.
+func (mr *MockTeamRepositoryMockRecorder) SaveTeamTranslation(ctx, teamID, t any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeams", reflect.TypeOf((*MockTeamRepository)(nil).GetTeams), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTeamTranslation", reflect.TypeOf((*MockTeamRepository)(nil).SaveTeamTranslation), ctx, teamID, t)
 }
 
 // SetTeamImageID mocks base method.
@@ -141,6 +302,35 @@ func (mr *MockTeamRepositoryMockRecorder) SetTeamImageID(ctx, teamID, imageID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamImageID", reflect.TypeOf((*MockTeamRepository)(nil).SetTeamImageID), ctx, teamID, imageID)
 }
 
+// TeamSlugExists mocks base method.
+func (m *MockTeamRepository) TeamSlugExists(ctx context.Context, slug string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TeamSlugExists", ctx, slug)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TeamSlugExists indicates an expected call of TeamSlugExists.
+func (mr *MockTeamRepositoryMockRecorder) TeamSlugExists(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TeamSlugExists", reflect.TypeOf((*MockTeamRepository)(nil).TeamSlugExists), ctx, slug)
+}
+
+// UpdateMember mocks base method.
+func (m *MockTeamRepository) UpdateMember(ctx context.Context, member *domain.TeamMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMember indicates an expected call of UpdateMember.
+func (mr *MockTeamRepositoryMockRecorder) UpdateMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMember", reflect.TypeOf((*MockTeamRepository)(nil).UpdateMember), ctx, member)
+}
+
 // UpdateTeam mocks base method.
 func (m *MockTeamRepository) UpdateTeam(ctx context.Context, team *domain.Team) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: technology.go
+//
+// Generated by this command:
+//
+//	mockgen -source=technology.go -destination=./mocks/technology.go -package=mocks
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "web-studio-backend/internal/app/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTechnologyRepository is a mock of TechnologyRepository interface.
+type MockTechnologyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTechnologyRepositoryMockRecorder
+}
+
+// MockTechnologyRepositoryMockRecorder is the mock recorder for MockTechnologyRepository.
+type MockTechnologyRepositoryMockRecorder struct {
+	mock *MockTechnologyRepository
+}
+
+// NewMockTechnologyRepository creates a new mock instance.
+func NewMockTechnologyRepository(ctrl *gomock.Controller) *MockTechnologyRepository {
+	mock := &MockTechnologyRepository{ctrl: ctrl}
+	mock.recorder = &MockTechnologyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTechnologyRepository) EXPECT() *MockTechnologyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTechnology mocks base method.
+func (m *MockTechnologyRepository) CreateTechnology(ctx context.Context, t *domain.Technology) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTechnology", ctx, t)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTechnology indicates an expected call of CreateTechnology.
+func (mr *MockTechnologyRepositoryMockRecorder) CreateTechnology(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTechnology", reflect.TypeOf((*MockTechnologyRepository)(nil).CreateTechnology), ctx, t)
+}
+
+// DeleteTechnology mocks base method.
+func (m *MockTechnologyRepository) DeleteTechnology(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTechnology", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTechnology indicates an expected call of DeleteTechnology.
+func (mr *MockTechnologyRepositoryMockRecorder) DeleteTechnology(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTechnology", reflect.TypeOf((*MockTechnologyRepository)(nil).DeleteTechnology), ctx, id)
+}
+
+// FindTechnologies mocks base method.
+func (m *MockTechnologyRepository) FindTechnologies(ctx context.Context, names []string) ([]domain.Technology, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTechnologies", ctx, names)
+	ret0, _ := ret[0].([]domain.Technology)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTechnologies indicates an expected call of FindTechnologies.
+func (mr *MockTechnologyRepositoryMockRecorder) FindTechnologies(ctx, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTechnologies", reflect.TypeOf((*MockTechnologyRepository)(nil).FindTechnologies), ctx, names)
+}
+
+// GetTechnologies mocks base method.
+func (m *MockTechnologyRepository) GetTechnologies(ctx context.Context, filter *domain.TechnologyFilter) ([]domain.Technology, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTechnologies", ctx, filter)
+	ret0, _ := ret[0].([]domain.Technology)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTechnologies indicates an expected call of GetTechnologies.
+func (mr *MockTechnologyRepositoryMockRecorder) GetTechnologies(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTechnologies", reflect.TypeOf((*MockTechnologyRepository)(nil).GetTechnologies), ctx, filter)
+}
+
+// GetTechnology mocks base method.
+func (m *MockTechnologyRepository) GetTechnology(ctx context.Context, id int32) (*domain.Technology, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTechnology", ctx, id)
+	ret0, _ := ret[0].(*domain.Technology)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTechnology indicates an expected call of GetTechnology.
+func (mr *MockTechnologyRepositoryMockRecorder) GetTechnology(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTechnology", reflect.TypeOf((*MockTechnologyRepository)(nil).GetTechnology), ctx, id)
+}
+
+// GetTechnologyUsage mocks base method.
+func (m *MockTechnologyRepository) GetTechnologyUsage(ctx context.Context, limit int, activeOnly bool) ([]domain.TechnologyUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTechnologyUsage", ctx, limit, activeOnly)
+	ret0, _ := ret[0].([]domain.TechnologyUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTechnologyUsage indicates an expected call of GetTechnologyUsage.
+func (mr *MockTechnologyRepositoryMockRecorder) GetTechnologyUsage(ctx, limit, activeOnly any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTechnologyUsage", reflect.TypeOf((*MockTechnologyRepository)(nil).GetTechnologyUsage), ctx, limit, activeOnly)
+}
+
+// SetProjectTechnologies mocks base method.
+func (m *MockTechnologyRepository) SetProjectTechnologies(ctx context.Context, projectID int32, technologyIDs []int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectTechnologies", ctx, projectID, technologyIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProjectTechnologies indicates an expected call of SetProjectTechnologies.
+func (mr *MockTechnologyRepositoryMockRecorder) SetProjectTechnologies(ctx, projectID, technologyIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectTechnologies", reflect.TypeOf((*MockTechnologyRepository)(nil).SetProjectTechnologies), ctx, projectID, technologyIDs)
+}
+
+// UpdateTechnology mocks base method.
+func (m *MockTechnologyRepository) UpdateTechnology(ctx context.Context, t *domain.Technology) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTechnology", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTechnology indicates an expected call of UpdateTechnology.
+func (mr *MockTechnologyRepositoryMockRecorder) UpdateTechnology(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTechnology", reflect.TypeOf((*MockTechnologyRepository)(nil).UpdateTechnology), ctx, t)
+}
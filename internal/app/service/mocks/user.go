@@ -129,18 +129,19 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByLogin(ctx, login any) *gomock
 }
 
 // GetUsers mocks base method.
-func (m *MockUserRepository) GetUsers(ctx context.Context) ([]domain.User, error) {
+func (m *MockUserRepository) GetUsers(ctx context.Context, params *domain.ListParams, filter *domain.UserFilter) ([]domain.User, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsers", ctx)
+	ret := m.ctrl.Call(m, "GetUsers", ctx, params, filter)
 	ret0, _ := ret[0].([]domain.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetUsers indicates an expected call of GetUsers.
-func (mr *MockUserRepositoryMockRecorder) GetUsers(ctx any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) GetUsers(ctx, params, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockUserRepository)(nil).GetUsers), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockUserRepository)(nil).GetUsers), ctx, params, filter)
 }
 
 // SetUserImage mocks base method.
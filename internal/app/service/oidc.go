@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/pkg/oidc"
+)
+
+//go:generate mockgen -source=oidc.go -destination=./mocks/oidc.go -package=mocks
+type OIDCProvider interface {
+	AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error)
+	Authenticate(ctx context.Context, code, verifier, nonce string) (*oidc.Claims, error)
+}
+
+type OIDCRepository interface {
+	CreateOIDCState(ctx context.Context, state *domain.OIDCState) error
+	ConsumeOIDCState(ctx context.Context, hash string) (*domain.OIDCState, error)
+	GetUserIdentity(ctx context.Context, provider, subject string) (*domain.UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, identity *domain.UserIdentity) error
+}
+
+// oidcStateTTL is the time user has to sign in on the provider page.
+const oidcStateTTL = 10 * time.Minute
+
+// OIDCService signs in users with external identity providers.
+// Provider account is linked to the user with the same email on the first sign-in,
+// users are not created, so only existing studio members can sign in.
+type OIDCService struct {
+	providers map[string]OIDCProvider
+	repo      OIDCRepository
+	users     AuthRepository
+	twoFactor *TwoFactorService
+}
+
+func NewOIDCService(providers map[string]OIDCProvider, repo OIDCRepository, users AuthRepository, twoFactor *TwoFactorService) *OIDCService {
+	return &OIDCService{providers, repo, users, twoFactor}
+}
+
+func (s *OIDCService) GetProviders(_ context.Context) []domain.OIDCProvider {
+	providers := make([]domain.OIDCProvider, 0, len(s.providers))
+	for name := range s.providers {
+		providers = append(providers, domain.OIDCProvider{Name: name})
+	}
+
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].Name < providers[j].Name
+	})
+
+	return providers
+}
+
+// StartSignIn returns URL of the provider login page.
+// Returned state must be bound to the browser, e.g. by cookie, and passed to FinishSignIn.
+func (s *OIDCService) StartSignIn(ctx context.Context, providerName string) (*domain.OIDCAuthURL, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, apperr.NewNotFound("provider")
+	}
+
+	var values [3]string
+	for i := range values {
+		v, err := randomToken()
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	state, nonce, verifier := values[0], values[1], values[2]
+
+	err := s.repo.CreateOIDCState(ctx, &domain.OIDCState{
+		Hash:         hashToken(state),
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    time.Now().Add(oidcStateTTL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("saving oidc state: %w", err)
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return nil, fmt.Errorf("building %s auth url: %w", providerName, err)
+	}
+
+	return &domain.OIDCAuthURL{AuthURL: authURL, State: state}, nil
+}
+
+// FinishSignIn exchanges authorization code from the provider redirect and starts user session.
+// boundState is the state saved in the browser on start, it protects from signing in with someone else's code.
+func (s *OIDCService) FinishSignIn(ctx context.Context, providerName string, req *domain.OIDCCallbackRequest, boundState string) (*domain.SignInResponse, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, apperr.NewNotFound("provider")
+	}
+
+	if req.State == "" || subtle.ConstantTimeCompare([]byte(req.State), []byte(boundState)) != 1 {
+		return nil, apperr.NewInvalidRequest("State doesn't match the started sign-in.", "state")
+	}
+
+	state, err := s.repo.ConsumeOIDCState(ctx, hashToken(req.State))
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewInvalidRequest("Sign-in is expired, start it again.", "state")
+		}
+		return nil, fmt.Errorf("consuming oidc state: %w", err)
+	}
+	if state.Provider != providerName {
+		return nil, apperr.NewInvalidRequest("Sign-in is started with another provider.", "state")
+	}
+
+	claims, err := provider.Authenticate(ctx, req.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidToken) {
+			slog.Warn("Invalid id token", slog.String("provider", providerName), slog.String("error", err.Error()))
+			return nil, apperr.NewUnauthorized("Sign-in with provider failed.")
+		}
+		return nil, fmt.Errorf("authenticating with %s: %w", providerName, err)
+	}
+
+	userID, err := s.linkedUser(ctx, providerName, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.users.GetActiveUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewUnauthorized("User is disabled.")
+		}
+		return nil, fmt.Errorf("getting user %d: %w", userID, err)
+	}
+
+	return s.twoFactor.startSignIn(ctx, userID, req.IP, req.UserAgent)
+}
+
+// linkedUser returns user of the provider account, the account is linked to the user with the same email
+// if the provider has verified it.
+func (s *OIDCService) linkedUser(ctx context.Context, providerName string, claims *oidc.Claims) (int32, error) {
+	identity, err := s.repo.GetUserIdentity(ctx, providerName, claims.Subject)
+	if err == nil {
+		return identity.UserID, nil
+	}
+	if !errors.Is(err, repository.ErrObjectNotFound) {
+		return 0, fmt.Errorf("getting user identity: %w", err)
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return 0, apperr.NewUnauthorized("Email is not verified by provider.")
+	}
+
+	user, err := s.users.GetUserByLogin(ctx, claims.Email)
+	if err != nil && !errors.Is(err, repository.ErrObjectNotFound) {
+		return 0, fmt.Errorf("getting user by email: %w", err)
+	}
+	// Matched username is not an email to link the account by
+	if err != nil || !strings.EqualFold(user.Email, claims.Email) {
+		return 0, apperr.NewUnauthorized("There is no user with such email.")
+	}
+
+	err = s.repo.CreateUserIdentity(ctx, &domain.UserIdentity{
+		Provider: providerName,
+		Subject:  claims.Subject,
+		UserID:   user.ID,
+		Email:    claims.Email,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("linking %s account to user %d: %w", providerName, user.ID, err)
+	}
+
+	slog.Info("Provider account linked", slog.String("provider", providerName), slog.Int("user_id", int(user.ID)))
+
+	return user.ID, nil
+}
@@ -0,0 +1,167 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+	"web-studio-backend/internal/pkg/oidc"
+	"web-studio-backend/internal/pkg/oidc/oidctest"
+)
+
+type oidcMocks struct {
+	server    *oidctest.Server
+	repo      *mocks.MockOIDCRepository
+	users     *mocks.MockAuthRepository
+	sessions  *mocks.MockSessionStore
+	twoFactor *mocks.MockTwoFactorRepository
+}
+
+func oidcService(t *testing.T) (*service.OIDCService, oidcMocks) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	server := oidctest.NewServer("client", "secret")
+	t.Cleanup(server.Close)
+
+	m := oidcMocks{
+		server:    server,
+		repo:      mocks.NewMockOIDCRepository(mockCtl),
+		users:     mocks.NewMockAuthRepository(mockCtl),
+		sessions:  mocks.NewMockSessionStore(mockCtl),
+		twoFactor: mocks.NewMockTwoFactorRepository(mockCtl),
+	}
+
+	provider := oidc.NewProvider(oidc.Config{
+		Issuer:       server.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost:3000/sso/callback",
+	}, nil)
+
+	// Failed password attempts are forgotten after signing in with a provider as well
+	throttles := mocks.NewMockSignInThrottleRepository(mockCtl)
+	throttles.EXPECT().DeleteSignInThrottle(gomock.Any(), domain.SignInThrottleUser, gomock.Any(), gomock.Any()).
+		Return(repository.ErrObjectNotFound).AnyTimes()
+	limiter := service.NewSignInLimiter(throttles, nil, mocks.NewMockSystemAuditor(mockCtl), service.SignInPolicy{})
+
+	twoFactor := service.NewTwoFactorService(m.twoFactor, m.sessions, limiter, nil, nil, service.TwoFactorPolicy{})
+	serv := service.NewOIDCService(map[string]service.OIDCProvider{"test": provider}, m.repo, m.users, twoFactor)
+
+	return serv, m
+}
+
+// startSignIn starts sign-in and follows the provider login page, the saved state is returned by the repo mock once.
+func startSignIn(t *testing.T, ctx context.Context, serv *service.OIDCService, m oidcMocks) *domain.OIDCCallbackRequest {
+	t.Helper()
+
+	var saved *domain.OIDCState
+	m.repo.EXPECT().CreateOIDCState(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, state *domain.OIDCState) error {
+			saved = state
+			return nil
+		})
+
+	res, err := serv.StartSignIn(ctx, "test")
+	require.NoError(t, err)
+
+	code, state, err := m.server.Authorize(res.AuthURL)
+	require.NoError(t, err)
+	require.Equal(t, res.State, state)
+
+	m.repo.EXPECT().ConsumeOIDCState(ctx, hashToken(state)).Return(saved, nil).MaxTimes(1)
+
+	return &domain.OIDCCallbackRequest{Code: code, State: state}
+}
+
+func TestOIDCService_FinishSignIn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should link account by verified email", func(t *testing.T) {
+		serv, m := oidcService(t)
+		m.server.SetUser(oidctest.User{Subject: "sub", Email: "User@mail.com", EmailVerified: true})
+
+		req := startSignIn(t, ctx, serv, m)
+
+		m.repo.EXPECT().GetUserIdentity(ctx, "test", "sub").Return(nil, repository.ErrObjectNotFound)
+		m.users.EXPECT().GetUserByLogin(ctx, "User@mail.com").Return(&domain.User{ID: 1, Email: "user@mail.com"}, nil)
+		m.repo.EXPECT().CreateUserIdentity(ctx, &domain.UserIdentity{
+			Provider: "test",
+			Subject:  "sub",
+			UserID:   1,
+			Email:    "User@mail.com",
+		}).Return(nil)
+		m.users.EXPECT().GetActiveUser(ctx, int32(1)).Return(&domain.User{ID: 1}, nil)
+		m.twoFactor.EXPECT().GetTwoFactor(ctx, gomock.Any()).Return(nil, repository.ErrObjectNotFound)
+		m.sessions.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		res, err := serv.FinishSignIn(ctx, "test", req, req.State)
+		require.NoError(t, err)
+		require.Equal(t, int32(1), res.UserID)
+		require.NotEmpty(t, res.SessionID)
+	})
+
+	t.Run("should sign in linked account", func(t *testing.T) {
+		serv, m := oidcService(t)
+		m.server.SetUser(oidctest.User{Subject: "sub"})
+
+		req := startSignIn(t, ctx, serv, m)
+
+		m.repo.EXPECT().GetUserIdentity(ctx, "test", "sub").Return(&domain.UserIdentity{UserID: 2}, nil)
+		m.users.EXPECT().GetActiveUser(ctx, int32(2)).Return(&domain.User{ID: 2}, nil)
+		m.twoFactor.EXPECT().GetTwoFactor(ctx, gomock.Any()).Return(nil, repository.ErrObjectNotFound)
+		m.sessions.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		res, err := serv.FinishSignIn(ctx, "test", req, req.State)
+		require.NoError(t, err)
+		require.Equal(t, int32(2), res.UserID)
+	})
+
+	t.Run("should not link unverified email", func(t *testing.T) {
+		serv, m := oidcService(t)
+		m.server.SetUser(oidctest.User{Subject: "sub", Email: "user@mail.com"})
+
+		req := startSignIn(t, ctx, serv, m)
+
+		m.repo.EXPECT().GetUserIdentity(ctx, "test", "sub").Return(nil, repository.ErrObjectNotFound)
+
+		_, err := serv.FinishSignIn(ctx, "test", req, req.State)
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.UnauthorizedType, appErr.Type)
+	})
+
+	t.Run("should fail on state from another browser", func(t *testing.T) {
+		serv, m := oidcService(t)
+		m.server.SetUser(oidctest.User{Subject: "sub"})
+
+		req := startSignIn(t, ctx, serv, m)
+
+		_, err := serv.FinishSignIn(ctx, "test", req, "other")
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+
+	t.Run("should fail on unknown provider", func(t *testing.T) {
+		serv, _ := oidcService(t)
+
+		_, err := serv.FinishSignIn(ctx, "unknown", &domain.OIDCCallbackRequest{}, "")
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.NotFoundType, appErr.Type)
+	})
+}
@@ -4,44 +4,82 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log/slog"
-	"path/filepath"
+	"strings"
+	"time"
 
 	"web-studio-backend/internal/app/domain"
 	"web-studio-backend/internal/app/domain/apperr"
 	"web-studio-backend/internal/app/infrastructure/repository"
-
-	"github.com/gabriel-vasile/mimetype"
-	"github.com/google/uuid"
 )
 
 //go:generate mockgen -source=project.go -destination=./mocks/project.go -package=mocks
 type ProjectRepository interface {
 	GetProject(ctx context.Context, id int32) (*domain.Project, error)
-	GetProjects(ctx context.Context) ([]domain.Project, error)
+	GetProjects(ctx context.Context, params *domain.ListParams, filter *domain.ProjectFilter) ([]domain.Project, int, error)
+	GetProjectBySlug(ctx context.Context, slug string) (*domain.Project, error)
+	ProjectSlugExists(ctx context.Context, slug string) (bool, error)
+	GetProjectSlugRedirect(ctx context.Context, slug string) (int32, error)
+	ChangeProjectSlug(ctx context.Context, id int32, slug string) error
 	CreateProject(ctx context.Context, project *domain.Project) (int32, error)
 	UpdateProject(ctx context.Context, project *domain.Project) error
 	DeleteProject(ctx context.Context, id int32) error
 	DisableProject(ctx context.Context, id int32) error
+	RestoreProject(ctx context.Context, id int32) error
+	PurgeProject(ctx context.Context, id int32) error
+	GetDeletedProjects(ctx context.Context, params *domain.ListParams, deletedBefore time.Time) ([]domain.Project, int, error)
 
-	GetParticipants(ctx context.Context, projectID int32) ([]domain.ProjectParticipant, error)
+	GetParticipants(ctx context.Context, projectID int32, params *domain.ListParams, filter *domain.ParticipantFilter) ([]domain.ProjectParticipant, int, error)
 	GetParticipant(ctx context.Context, participantID, projectID int32) (*domain.ProjectParticipant, error)
 	AddParticipant(ctx context.Context, participant *domain.ProjectParticipant) error
 	UpdateParticipant(ctx context.Context, participant *domain.ProjectParticipant) error
 	RemoveParticipant(ctx context.Context, participantID, projectID int32) error
 	SetProjectImageID(ctx context.Context, projectID int32, imageID string) error
+
+	GetProjectTranslations(ctx context.Context, projectID int32) ([]domain.Translation, error)
+	GetProjectTranslationsByLocale(ctx context.Context, projectIDs []int32, locale string) (map[int32]domain.Translation, error)
+	SaveProjectTranslation(ctx context.Context, projectID int32, t *domain.Translation) error
+	DeleteProjectTranslation(ctx context.Context, projectID int32, locale string) error
 }
 
 type ProjectService struct {
-	filesDir    string
-	projectRepo ProjectRepository
-	userRepo    UserRepository
-	teamRepo    TeamRepository
-	fileRepo    FileRepository
+	images       imageStorage
+	mediaImages  imageStorage
+	projectRepo  ProjectRepository
+	userRepo     UserRepository
+	teamRepo     TeamRepository
+	documentRepo DocumentRepository
+	mediaRepo    ProjectMediaRepository
+	techRepo     TechnologyRepository
+	uow          unitOfWork
+	audit        Auditor
+	locales      Locales
 }
 
-func NewProjectService(repo ProjectRepository, userRepo UserRepository, teamRepo TeamRepository, fileRepo FileRepository) *ProjectService {
-	return &ProjectService{"projects", repo, userRepo, teamRepo, fileRepo}
+func NewProjectService(
+	repo ProjectRepository,
+	userRepo UserRepository,
+	teamRepo TeamRepository,
+	documentRepo DocumentRepository,
+	mediaRepo ProjectMediaRepository,
+	techRepo TechnologyRepository,
+	fileRepo FileRepository,
+	tx TxManager,
+	audit Auditor,
+	locales Locales,
+) *ProjectService {
+	return &ProjectService{
+		newImageStorage("projects", fileRepo),
+		newImageStorage(mediaDir, fileRepo),
+		repo,
+		userRepo,
+		teamRepo,
+		documentRepo,
+		mediaRepo,
+		techRepo,
+		unitOfWork{tx, fileRepo},
+		audit,
+		locales,
+	}
 }
 
 func (s *ProjectService) GetProject(ctx context.Context, id int32) (*domain.Project, error) {
@@ -56,13 +94,44 @@ func (s *ProjectService) GetProject(ctx context.Context, id int32) (*domain.Proj
 	return project, nil
 }
 
-func (s *ProjectService) GetProjects(ctx context.Context) ([]domain.Project, error) {
-	projects, err := s.projectRepo.GetProjects(ctx)
+// GetProjectBySlug returns project by its slug.
+// Returns domain.SlugMovedError if the slug was changed, so the client can be redirected.
+func (s *ProjectService) GetProjectBySlug(ctx context.Context, slug string) (*domain.Project, error) {
+	project, moved, err := findProjectBySlug(ctx, s.projectRepo, slug)
 	if err != nil {
-		return nil, fmt.Errorf("getting projects: %w", err)
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("slug")
+		}
+		return nil, fmt.Errorf("getting project %s: %w", slug, err)
+	}
+	if moved {
+		return nil, &domain.SlugMovedError{Slug: project.Slug}
 	}
 
-	return projects, nil
+	return project, nil
+}
+
+// GetProjects returns the requested page of projects and total number of projects matching the filter.
+// Technologies of the filter may be given by aliases.
+func (s *ProjectService) GetProjects(ctx context.Context, params *domain.ListParams, filter *domain.ProjectFilter) ([]domain.Project, int, error) {
+	if filter != nil && len(filter.Technologies) > 0 {
+		technologies, unknown, err := resolveTechnologies(ctx, s.techRepo, filter.Technologies)
+		if err != nil {
+			return nil, 0, err
+		}
+		// No project can use a technology missing from the catalog
+		if len(unknown) > 0 {
+			return []domain.Project{}, 0, nil
+		}
+		filter.TechnologyIDs = technologyIDs(technologies)
+	}
+
+	projects, total, err := s.projectRepo.GetProjects(ctx, params, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting projects: %w", err)
+	}
+
+	return projects, total, nil
 }
 
 func (s *ProjectService) CreateProject(ctx context.Context, project *domain.Project) (*domain.Project, error) {
@@ -80,9 +149,36 @@ func (s *ProjectService) CreateProject(ctx context.Context, project *domain.Proj
 		}
 	}
 
-	projectId, err := s.projectRepo.CreateProject(ctx, project)
+	if project.Slug != "" {
+		err := claimSlug(ctx, 0, project.Slug, s.projectRepo.ProjectSlugExists, s.projectRepo.GetProjectSlugRedirect)
+		if err != nil {
+			return nil, fmt.Errorf("checking project slug: %w", err)
+		}
+	}
+
+	technologyIDs, err := s.resolveProjectTechnologies(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectId int32
+	err = createWithSlug(ctx, &project.Slug, project.Title, "project", s.projectRepo.ProjectSlugExists, func() error {
+		return s.uow.do(ctx, &fileChanges{}, func(ctx context.Context) error {
+			projectId, err = s.projectRepo.CreateProject(ctx, project)
+			if err != nil {
+				return fmt.Errorf("creating project: %w", err)
+			}
+
+			err = s.techRepo.SetProjectTechnologies(ctx, projectId, technologyIDs)
+			if err != nil {
+				return fmt.Errorf("setting project %d technologies: %w", projectId, err)
+			}
+
+			return nil
+		})
+	})
 	if err != nil {
-		return nil, fmt.Errorf("creating project: %w", err)
+		return nil, err
 	}
 
 	createdProject, err := s.projectRepo.GetProject(ctx, projectId)
@@ -90,6 +186,8 @@ func (s *ProjectService) CreateProject(ctx context.Context, project *domain.Proj
 		return nil, fmt.Errorf("getting project %d: %w", projectId, err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionCreate, domain.AuditEntityProject, projectId, nil, createdProject)
+
 	return createdProject, nil
 }
 
@@ -108,7 +206,7 @@ func (s *ProjectService) UpdateProject(ctx context.Context, project *domain.Proj
 		}
 	}
 
-	_, err := s.projectRepo.GetProject(ctx, project.ID)
+	existingProject, err := s.projectRepo.GetProject(ctx, project.ID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
 			return nil, apperr.NewNotFound("project_id")
@@ -116,9 +214,44 @@ func (s *ProjectService) UpdateProject(ctx context.Context, project *domain.Proj
 		return nil, fmt.Errorf("getting project %d before update: %w", project.ID, err)
 	}
 
-	err = s.projectRepo.UpdateProject(ctx, project)
+	slugChanged := project.Slug != "" && project.Slug != existingProject.Slug
+	if slugChanged {
+		err = claimSlug(ctx, project.ID, project.Slug, s.projectRepo.ProjectSlugExists, s.projectRepo.GetProjectSlugRedirect)
+		if err != nil {
+			return nil, fmt.Errorf("checking project slug: %w", err)
+		}
+	}
+
+	technologyIDs, err := s.resolveProjectTechnologies(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.uow.do(ctx, &fileChanges{}, func(ctx context.Context) error {
+		err := s.projectRepo.UpdateProject(ctx, project)
+		if err != nil {
+			return fmt.Errorf("updating project %d: %w", project.ID, err)
+		}
+
+		err = s.techRepo.SetProjectTechnologies(ctx, project.ID, technologyIDs)
+		if err != nil {
+			return fmt.Errorf("setting project %d technologies: %w", project.ID, err)
+		}
+
+		if slugChanged {
+			err = s.projectRepo.ChangeProjectSlug(ctx, project.ID, project.Slug)
+			if err != nil {
+				if errors.Is(err, repository.ErrDuplicate) {
+					return apperr.NewDuplicate("Slug already taken.", "slug")
+				}
+				return fmt.Errorf("changing project %d slug: %w", project.ID, err)
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("updating project %d: %w", project.ID, err)
+		return nil, err
 	}
 
 	updatedProject, err := s.projectRepo.GetProject(ctx, project.ID)
@@ -126,11 +259,30 @@ func (s *ProjectService) UpdateProject(ctx context.Context, project *domain.Proj
 		return nil, fmt.Errorf("getting project %d after update: %w", project.ID, err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityProject, project.ID, existingProject, updatedProject)
+
 	return updatedProject, nil
 }
 
+// resolveProjectTechnologies replaces technologies of the project given by names or aliases with their canonical names.
+// Returns identifiers of the technologies, technologies missing from the catalog are rejected.
+func (s *ProjectService) resolveProjectTechnologies(ctx context.Context, project *domain.Project) ([]int32, error) {
+	technologies, unknown, err := resolveTechnologies(ctx, s.techRepo, project.Technologies)
+	if err != nil {
+		return nil, err
+	}
+	if len(unknown) > 0 {
+		return nil, apperr.NewInvalidRequest(fmt.Sprintf("Unknown technologies: %s.", strings.Join(unknown, ", ")), "technologies")
+	}
+
+	project.Technologies = technologyNames(technologies)
+
+	return technologyIDs(technologies), nil
+}
+
+// DeleteProject moves project to the trash, it is purged with its documents after the retention period.
 func (s *ProjectService) DeleteProject(ctx context.Context, id int32) error {
-	_, err := s.projectRepo.GetProject(ctx, id)
+	project, err := s.projectRepo.GetProject(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
 			return apperr.NewNotFound("project_id")
@@ -143,24 +295,112 @@ func (s *ProjectService) DeleteProject(ctx context.Context, id int32) error {
 		return fmt.Errorf("deleting project %d: %w", id, err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionDelete, domain.AuditEntityProject, id, project, nil)
+
 	return nil
 }
 
-func (s *ProjectService) GetParticipants(ctx context.Context, projectID int32) ([]domain.ProjectParticipant, error) {
-	project, err := s.projectRepo.GetProject(ctx, projectID)
+// GetDeletedProjects returns the requested page of projects in the trash and total number of them.
+func (s *ProjectService) GetDeletedProjects(ctx context.Context, params *domain.ListParams) ([]domain.Project, int, error) {
+	projects, total, err := s.projectRepo.GetDeletedProjects(ctx, params, time.Time{})
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting deleted projects: %w", err)
+	}
+
+	return projects, total, nil
+}
+
+// RestoreProject moves project out of the trash.
+func (s *ProjectService) RestoreProject(ctx context.Context, id int32) (*domain.Project, error) {
+	err := s.projectRepo.RestoreProject(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
 			return nil, apperr.NewNotFound("project_id")
 		}
-		return nil, fmt.Errorf("getting project %d: %w", project.ID, err)
+		return nil, fmt.Errorf("restoring project %d: %w", id, err)
+	}
+
+	project, err := s.projectRepo.GetProject(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting project %d: %w", id, err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionRestore, domain.AuditEntityProject, id, nil, project)
+
+	return project, nil
+}
+
+// PurgeDeleted deletes projects which were moved to the trash before the given moment forever
+// with their documents, images and galleries. Returns the number of purged projects.
+func (s *ProjectService) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	projects, _, err := s.projectRepo.GetDeletedProjects(ctx, nil, before)
+	if err != nil {
+		return 0, fmt.Errorf("getting deleted projects: %w", err)
+	}
+
+	for i, project := range projects {
+		files := &fileChanges{}
+
+		err = s.uow.do(ctx, files, func(ctx context.Context) error {
+			docIDs, err := s.documentRepo.GetProjectDocumentIDs(ctx, project.ID)
+			if err != nil {
+				return fmt.Errorf("getting project %d documents: %w", project.ID, err)
+			}
+
+			err = purgeDocuments(ctx, s.documentRepo, files, docIDs...)
+			if err != nil {
+				return err
+			}
+
+			// Gallery rows are removed with the project, their images are deleted after commit
+			media, err := s.mediaRepo.GetProjectMedia(ctx, project.ID)
+			if err != nil {
+				return fmt.Errorf("getting project %d media: %w", project.ID, err)
+			}
+			for _, m := range media {
+				if m.ImageID == "" {
+					continue
+				}
+				for _, fileName := range s.mediaImages.fileNames(m.ImageID) {
+					files.Delete(fileName)
+				}
+			}
+
+			err = s.projectRepo.PurgeProject(ctx, project.ID)
+			if err != nil {
+				return fmt.Errorf("purging project %d: %w", project.ID, err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return i, err
+		}
+
+		if project.ImageId != "" {
+			s.images.delete(ctx, project.ImageId)
+		}
+	}
+
+	return len(projects), nil
+}
+
+// GetParticipants returns the requested page of project participants and total number of participants matching the filter.
+func (s *ProjectService) GetParticipants(ctx context.Context, projectID int32, params *domain.ListParams, filter *domain.ParticipantFilter) ([]domain.ProjectParticipant, int, error) {
+	_, err := s.projectRepo.GetProject(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, 0, apperr.NewNotFound("project_id")
+		}
+		return nil, 0, fmt.Errorf("getting project %d: %w", projectID, err)
 	}
 
-	participants, err := s.projectRepo.GetParticipants(ctx, projectID)
+	participants, total, err := s.projectRepo.GetParticipants(ctx, projectID, params, filter)
 	if err != nil {
-		return nil, fmt.Errorf("getting project %d participants: %w", projectID, err)
+		return nil, 0, fmt.Errorf("getting project %d participants: %w", projectID, err)
 	}
 
-	return participants, nil
+	return participants, total, nil
 }
 
 func (s *ProjectService) GetParticipant(ctx context.Context, participantID, projectID int32) (*domain.ProjectParticipant, error) {
@@ -214,6 +454,8 @@ func (s *ProjectService) AddParticipant(ctx context.Context, participant *domain
 		return nil, fmt.Errorf("getting created paricipant: %w", err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionAddParticipant, domain.AuditEntityProject, participant.ProjectID, nil, addedParticipant)
+
 	return addedParticipant, nil
 }
 
@@ -222,7 +464,7 @@ func (s *ProjectService) UpdateParticipant(ctx context.Context, participant *dom
 		return nil, fmt.Errorf("validating participant: %w", err)
 	}
 
-	_, err := s.projectRepo.GetParticipant(ctx, participant.UserID, participant.ProjectID)
+	existingParticipant, err := s.projectRepo.GetParticipant(ctx, participant.UserID, participant.ProjectID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
 			return nil, apperr.NewNotFound("user_id")
@@ -240,11 +482,13 @@ func (s *ProjectService) UpdateParticipant(ctx context.Context, participant *dom
 		return nil, fmt.Errorf("getting updated participant: %w", err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionUpdateParticipant, domain.AuditEntityProject, participant.ProjectID, existingParticipant, updatedParticipant)
+
 	return updatedParticipant, nil
 }
 
 func (s *ProjectService) RemoveParticipant(ctx context.Context, participantID, projectID int32) error {
-	_, err := s.projectRepo.GetParticipant(ctx, participantID, projectID)
+	participant, err := s.projectRepo.GetParticipant(ctx, participantID, projectID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
 			return apperr.NewNotFound("user_id")
@@ -257,24 +501,12 @@ func (s *ProjectService) RemoveParticipant(ctx context.Context, participantID, p
 		return fmt.Errorf("removing participant: %w", err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionRemoveParticipant, domain.AuditEntityProject, projectID, participant, nil)
+
 	return nil
 }
 
 func (s *ProjectService) SetProjectImage(ctx context.Context, projectID int32, img []byte) error {
-	if len(img) > 5<<20 {
-		return apperr.NewInvalidRequest("Image is too big.", "file")
-	}
-
-	mt := mimetype.Detect(img)
-	if !mt.Is("image/jpeg") &&
-		!mt.Is("image/png") &&
-		!mt.Is("image/webp") {
-		return apperr.NewInvalidRequest("Invalid image mime type.", "file")
-	}
-
-	fileID := uuid.New().String()
-	fileName := fileID + mt.Extension()
-
 	project, err := s.projectRepo.GetProject(ctx, projectID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
@@ -283,28 +515,55 @@ func (s *ProjectService) SetProjectImage(ctx context.Context, projectID int32, i
 		return fmt.Errorf("getting project %d: %w", projectID, err)
 	}
 
-	err = s.projectRepo.SetProjectImageID(ctx, projectID, fileName)
+	imageID, err := s.images.save(ctx, img)
 	if err != nil {
-		return fmt.Errorf("setting project %d image: %w", projectID, err)
+		return fmt.Errorf("saving project image: %w", err)
 	}
 
-	err = s.fileRepo.Save(ctx, img, filepath.Join(s.filesDir, fileName))
+	err = s.projectRepo.SetProjectImageID(ctx, projectID, imageID)
 	if err != nil {
-		return fmt.Errorf("saving project image: %w", err)
+		s.images.delete(ctx, imageID)
+		return fmt.Errorf("setting project %d image: %w", projectID, err)
 	}
 
 	if project.ImageId != "" {
-		err = s.fileRepo.Delete(ctx, filepath.Join(s.filesDir, project.ImageId))
-		if err != nil {
-			slog.Error("Deleting old project image", slog.String("error", err.Error()))
-		}
+		s.images.delete(ctx, project.ImageId)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionSetImage, domain.AuditEntityProject, projectID, nil, nil)
+
 	return nil
 }
 
-func (s *ProjectService) GetProjectImage(ctx context.Context, projectID int32) (*domain.Project, error) {
+// GetProjectImage returns project and its opened image variant of the given size, the file must be closed by the caller.
+func (s *ProjectService) GetProjectImage(ctx context.Context, projectID int32, size int) (*domain.Project, *domain.File, error) {
+	if err := validateImageSize(size); err != nil {
+		return nil, nil, err
+	}
+
 	project, err := s.projectRepo.GetProject(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, nil, apperr.NewNotFound("project_id")
+		}
+		return nil, nil, fmt.Errorf("getting project %d: %w", projectID, err)
+	}
+
+	if project.ImageId == "" {
+		return nil, nil, apperr.NewNotFound("image_id")
+	}
+
+	file, err := s.images.open(ctx, project.ImageId, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening project image: %w", err)
+	}
+
+	return project, file, nil
+}
+
+// GetProjectTranslations returns translations of the project to all languages except the default one.
+func (s *ProjectService) GetProjectTranslations(ctx context.Context, projectID int32) ([]domain.Translation, error) {
+	_, err := s.projectRepo.GetProject(ctx, projectID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
 			return nil, apperr.NewNotFound("project_id")
@@ -312,19 +571,57 @@ func (s *ProjectService) GetProjectImage(ctx context.Context, projectID int32) (
 		return nil, fmt.Errorf("getting project %d: %w", projectID, err)
 	}
 
-	if project.ImageId == "" {
-		return nil, apperr.NewNotFound("image_id")
+	translations, err := s.projectRepo.GetProjectTranslations(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("getting project %d translations: %w", projectID, err)
+	}
+
+	return translations, nil
+}
+
+// SetProjectTranslation creates or replaces translation of the project to the locale.
+func (s *ProjectService) SetProjectTranslation(ctx context.Context, projectID int32, translation *domain.Translation) (*domain.Translation, error) {
+	if err := s.locales.validate(translation.Locale); err != nil {
+		return nil, err
+	}
+	if err := translation.ValidateProject(); err != nil {
+		return nil, fmt.Errorf("validating project translation: %w", err)
 	}
 
-	data, err := s.fileRepo.Read(ctx, filepath.Join(s.filesDir, project.ImageId))
+	_, err := s.projectRepo.GetProject(ctx, projectID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
-			return nil, apperr.NewNotFound("image_id")
+			return nil, apperr.NewNotFound("project_id")
 		}
-		return nil, fmt.Errorf("reading team image: %w", err)
+		return nil, fmt.Errorf("getting project %d: %w", projectID, err)
+	}
+
+	err = s.projectRepo.SaveProjectTranslation(ctx, projectID, translation)
+	if err != nil {
+		return nil, fmt.Errorf("saving project %d translation: %w", projectID, err)
 	}
 
-	project.ImageContent = data
+	saved, err := s.projectRepo.GetProjectTranslationsByLocale(ctx, []int32{projectID}, translation.Locale)
+	if err != nil {
+		return nil, fmt.Errorf("getting project %d translation: %w", projectID, err)
+	}
+	result := saved[projectID]
 
-	return project, nil
+	s.audit.Record(ctx, domain.AuditActionSetTranslation, domain.AuditEntityProject, projectID, nil, &result)
+
+	return &result, nil
+}
+
+func (s *ProjectService) DeleteProjectTranslation(ctx context.Context, projectID int32, locale string) error {
+	err := s.projectRepo.DeleteProjectTranslation(ctx, projectID, locale)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewNotFound("locale")
+		}
+		return fmt.Errorf("deleting project %d translation: %w", projectID, err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionDeleteTranslation, domain.AuditEntityProject, projectID, nil, map[string]string{"locale": locale})
+
+	return nil
 }
@@ -20,11 +20,12 @@ type ProjectCategoryRepository interface {
 }
 
 type ProjectCategoryService struct {
-	repo ProjectCategoryRepository
+	repo  ProjectCategoryRepository
+	audit Auditor
 }
 
-func NewProjectCategoryService(repo ProjectCategoryRepository) *ProjectCategoryService {
-	return &ProjectCategoryService{repo}
+func NewProjectCategoryService(repo ProjectCategoryRepository, audit Auditor) *ProjectCategoryService {
+	return &ProjectCategoryService{repo, audit}
 }
 
 func (s *ProjectCategoryService) CreateProjectCategory(ctx context.Context, pc *domain.ProjectCategory) (*domain.ProjectCategory, error) {
@@ -48,6 +49,8 @@ func (s *ProjectCategoryService) CreateProjectCategory(ctx context.Context, pc *
 		return nil, fmt.Errorf("getting created category: %w", err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionCreate, domain.AuditEntityProjectCategory, int32(id), nil, createdPc)
+
 	return createdPc, nil
 }
 
@@ -56,7 +59,7 @@ func (s *ProjectCategoryService) GetProjectCategories(ctx context.Context) ([]do
 }
 
 func (s *ProjectCategoryService) UpdateProjectCategory(ctx context.Context, pc *domain.ProjectCategory) error {
-	_, err := s.repo.GetProjectCategory(ctx, pc.ID)
+	existingPc, err := s.repo.GetProjectCategory(ctx, pc.ID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
 			return apperr.NewNotFound("id")
@@ -77,11 +80,13 @@ func (s *ProjectCategoryService) UpdateProjectCategory(ctx context.Context, pc *
 		return fmt.Errorf("updating project category: %w", err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityProjectCategory, int32(pc.ID), existingPc, pc)
+
 	return nil
 }
 
 func (s *ProjectCategoryService) DeleteProjectCategory(ctx context.Context, id int16) error {
-	_, err := s.repo.GetProjectCategory(ctx, id)
+	pc, err := s.repo.GetProjectCategory(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
 			return apperr.NewNotFound("id")
@@ -94,5 +99,7 @@ func (s *ProjectCategoryService) DeleteProjectCategory(ctx context.Context, id i
 		return fmt.Errorf("deleting project category: %w", err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionDelete, domain.AuditEntityProjectCategory, int32(id), pc, nil)
+
 	return nil
 }
@@ -0,0 +1,236 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+	"web-studio-backend/internal/pkg/imgproc"
+)
+
+type projectMocks struct {
+	projectRepo  *mocks.MockProjectRepository
+	teamRepo     *mocks.MockTeamRepository
+	documentRepo *mocks.MockDocumentRepository
+	mediaRepo    *mocks.MockProjectMediaRepository
+	techRepo     *mocks.MockTechnologyRepository
+	fileRepo     *mocks.MockFileRepository
+	inTx         *bool // Whether a transaction is running
+}
+
+func project(t *testing.T) (*service.ProjectService, projectMocks) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	m := projectMocks{
+		projectRepo:  mocks.NewMockProjectRepository(mockCtl),
+		teamRepo:     mocks.NewMockTeamRepository(mockCtl),
+		documentRepo: mocks.NewMockDocumentRepository(mockCtl),
+		mediaRepo:    mocks.NewMockProjectMediaRepository(mockCtl),
+		techRepo:     mocks.NewMockTechnologyRepository(mockCtl),
+		fileRepo:     mocks.NewMockFileRepository(mockCtl),
+		inTx:         new(bool),
+	}
+	tx := mocks.NewMockTxManager(mockCtl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		*m.inTx = true
+		defer func() { *m.inTx = false }()
+		return fn(ctx)
+	}).AnyTimes()
+	auditor := mocks.NewMockAuditor(mockCtl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	return service.NewProjectService(
+		m.projectRepo,
+		mocks.NewMockUserRepository(mockCtl),
+		m.teamRepo,
+		m.documentRepo,
+		m.mediaRepo,
+		m.techRepo,
+		m.fileRepo,
+		tx,
+		auditor,
+		locales,
+	), m
+}
+
+func TestProjectService_PurgeDeleted(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	before := time.Now().Add(-time.Hour)
+
+	imageFiles := func(dir, imageID string) []string {
+		files := []string{filepath.Join(dir, imageID)}
+		for _, size := range []int{64, 256, 1024} {
+			files = append(files, filepath.Join(dir, imgproc.VariantName(imageID, size)))
+		}
+		return files
+	}
+
+	t.Run("should delete files after commit", func(t *testing.T) {
+		serv, m := project(t)
+
+		m.projectRepo.EXPECT().GetDeletedProjects(ctx, nil, before).
+			Return([]domain.Project{{ID: 1, ImageId: "img.jpg"}}, 1, nil)
+		m.documentRepo.EXPECT().GetProjectDocumentIDs(gomock.Any(), int32(1)).Return([]int32{2}, nil)
+		m.documentRepo.EXPECT().GetDocumentFileIDs(gomock.Any(), int32(2)).Return([]string{"v1.txt"}, nil)
+		m.documentRepo.EXPECT().PurgeDocument(gomock.Any(), int32(2)).Return(nil)
+		m.mediaRepo.EXPECT().GetProjectMedia(gomock.Any(), int32(1)).Return([]domain.ProjectMedia{
+			{ID: 3, ProjectID: 1, Type: domain.MediaTypeImage, ImageID: "img.jpg"},
+			{ID: 4, ProjectID: 1, Type: domain.MediaTypeVideo, VideoURL: "https://video.example/1"},
+		}, nil)
+		m.projectRepo.EXPECT().PurgeProject(gomock.Any(), int32(1)).Return(nil)
+
+		var deleted []string
+		m.fileRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fileName string) error {
+			require.False(t, *m.inTx, "file %s deleted before commit", fileName)
+			deleted = append(deleted, fileName)
+			return nil
+		}).AnyTimes()
+
+		n, err := serv.PurgeDeleted(ctx, before)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		expected := []string{filepath.Join("documents", "v1.txt")}
+		expected = append(expected, imageFiles("projects", "img.jpg")...)
+		expected = append(expected, imageFiles("media", "img.jpg")...)
+		require.ElementsMatch(t, expected, deleted)
+	})
+
+	t.Run("files are kept when project is not purged", func(t *testing.T) {
+		serv, m := project(t)
+
+		m.projectRepo.EXPECT().GetDeletedProjects(ctx, nil, before).
+			Return([]domain.Project{{ID: 1, ImageId: "img.jpg"}}, 1, nil)
+		m.documentRepo.EXPECT().GetProjectDocumentIDs(gomock.Any(), int32(1)).Return(nil, nil)
+		m.mediaRepo.EXPECT().GetProjectMedia(gomock.Any(), int32(1)).
+			Return([]domain.ProjectMedia{{ID: 3, ProjectID: 1, Type: domain.MediaTypeImage, ImageID: "img.jpg"}}, nil)
+		m.projectRepo.EXPECT().PurgeProject(gomock.Any(), int32(1)).Return(errors.New("db error"))
+
+		n, err := serv.PurgeDeleted(ctx, before)
+		require.Error(t, err)
+		require.Zero(t, n)
+	})
+}
+
+func TestProjectService_CreateProject_Technologies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should save canonical names of aliases", func(t *testing.T) {
+		serv, m := project(t)
+
+		p := &domain.Project{Title: "Shop", Technologies: []string{"golang", "Postgres", "go"}}
+		m.projectRepo.EXPECT().ProjectSlugExists(ctx, "shop").Return(false, nil)
+		m.techRepo.EXPECT().FindTechnologies(ctx, []string{"golang", "Postgres", "go"}).Return([]domain.Technology{golang, postgres}, nil)
+		m.projectRepo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p *domain.Project) (int32, error) {
+			require.Equal(t, []string{"Go", "PostgreSQL"}, p.Technologies)
+			return 7, nil
+		})
+		m.techRepo.EXPECT().SetProjectTechnologies(gomock.Any(), int32(7), []int32{1, 2}).Return(nil)
+		m.projectRepo.EXPECT().GetProject(ctx, int32(7)).Return(&domain.Project{ID: 7, Technologies: []string{"Go", "PostgreSQL"}}, nil)
+
+		created, err := serv.CreateProject(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Go", "PostgreSQL"}, created.Technologies)
+	})
+
+	t.Run("should reject technologies missing from the catalog", func(t *testing.T) {
+		serv, m := project(t)
+
+		p := &domain.Project{Title: "Shop", Technologies: []string{"golang", "Cobol"}}
+		m.techRepo.EXPECT().FindTechnologies(ctx, []string{"golang", "Cobol"}).Return([]domain.Technology{golang}, nil)
+
+		_, err := serv.CreateProject(ctx, p)
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+		require.Contains(t, appErr.Error(), "Cobol")
+	})
+}
+
+func TestProjectService_UpdateProject_Technologies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should relink technologies", func(t *testing.T) {
+		serv, m := project(t)
+
+		p := &domain.Project{ID: 7, Title: "Shop", Technologies: []string{"pg"}}
+		m.projectRepo.EXPECT().GetProject(ctx, int32(7)).Return(&domain.Project{ID: 7, Title: "Shop", Technologies: []string{"Go"}}, nil)
+		m.techRepo.EXPECT().FindTechnologies(ctx, []string{"pg"}).Return([]domain.Technology{postgres}, nil)
+		m.projectRepo.EXPECT().UpdateProject(gomock.Any(), p).DoAndReturn(func(_ context.Context, p *domain.Project) error {
+			require.True(t, *m.inTx)
+			require.Equal(t, []string{"PostgreSQL"}, p.Technologies)
+			return nil
+		})
+		m.techRepo.EXPECT().SetProjectTechnologies(gomock.Any(), int32(7), []int32{2}).DoAndReturn(func(context.Context, int32, []int32) error {
+			require.True(t, *m.inTx)
+			return nil
+		})
+		m.projectRepo.EXPECT().GetProject(ctx, int32(7)).Return(&domain.Project{ID: 7, Title: "Shop", Technologies: []string{"PostgreSQL"}}, nil)
+
+		updated, err := serv.UpdateProject(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, []string{"PostgreSQL"}, updated.Technologies)
+	})
+
+	t.Run("should unlink all technologies", func(t *testing.T) {
+		serv, m := project(t)
+
+		p := &domain.Project{ID: 7, Title: "Shop"}
+		m.projectRepo.EXPECT().GetProject(ctx, int32(7)).Return(&domain.Project{ID: 7, Title: "Shop", Technologies: []string{"Go"}}, nil)
+		m.projectRepo.EXPECT().UpdateProject(gomock.Any(), p).Return(nil)
+		m.techRepo.EXPECT().SetProjectTechnologies(gomock.Any(), int32(7), gomock.Len(0)).Return(nil)
+		m.projectRepo.EXPECT().GetProject(ctx, int32(7)).Return(&domain.Project{ID: 7, Title: "Shop", Technologies: []string{}}, nil)
+
+		updated, err := serv.UpdateProject(ctx, p)
+		require.NoError(t, err)
+		require.Empty(t, updated.Technologies)
+	})
+}
+
+func TestProjectService_GetProjects_Technologies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	params := &domain.ListParams{Limit: 10}
+
+	t.Run("should filter by identifiers of technologies", func(t *testing.T) {
+		serv, m := project(t)
+
+		m.techRepo.EXPECT().FindTechnologies(ctx, []string{"pg"}).Return([]domain.Technology{postgres}, nil)
+		m.projectRepo.EXPECT().GetProjects(ctx, params, &domain.ProjectFilter{Technologies: []string{"pg"}, TechnologyIDs: []int32{2}}).
+			Return([]domain.Project{{ID: 1}}, 1, nil)
+
+		projects, total, err := serv.GetProjects(ctx, params, &domain.ProjectFilter{Technologies: []string{"pg"}})
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		require.Len(t, projects, 1)
+	})
+
+	t.Run("should return empty page for unknown technology", func(t *testing.T) {
+		serv, m := project(t)
+
+		m.techRepo.EXPECT().FindTechnologies(ctx, []string{"Cobol"}).Return(nil, nil)
+
+		projects, total, err := serv.GetProjects(ctx, params, &domain.ProjectFilter{Technologies: []string{"Cobol"}})
+		require.NoError(t, err)
+		require.Zero(t, total)
+		require.Empty(t, projects)
+	})
+}
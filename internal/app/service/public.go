@@ -0,0 +1,445 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+// PublicService serves the portfolio to anonymous visitors.
+// Only active projects and enabled teams are visible, they are looked up by slugs.
+type PublicService struct {
+	projectImages imageStorage
+	teamImages    imageStorage
+	mediaImages   imageStorage
+	projectRepo   ProjectRepository
+	teamRepo      TeamRepository
+	categoryRepo  ProjectCategoryRepository
+	mediaRepo     ProjectMediaRepository
+	techRepo      TechnologyRepository
+	locales       Locales
+}
+
+func NewPublicService(
+	projectRepo ProjectRepository,
+	teamRepo TeamRepository,
+	categoryRepo ProjectCategoryRepository,
+	mediaRepo ProjectMediaRepository,
+	techRepo TechnologyRepository,
+	fileRepo FileRepository,
+	locales Locales,
+) *PublicService {
+	return &PublicService{
+		newImageStorage("projects", fileRepo),
+		newImageStorage("teams", fileRepo),
+		newImageStorage(mediaDir, fileRepo),
+		projectRepo,
+		teamRepo,
+		categoryRepo,
+		mediaRepo,
+		techRepo,
+		locales,
+	}
+}
+
+// GetProjects returns the requested page of active projects and total number of active projects matching the filter.
+func (s *PublicService) GetProjects(ctx context.Context, params *domain.ListParams, filter *domain.PublicProjectFilter) ([]domain.PublicProject, int, error) {
+	isActive := true
+	projectFilter := domain.ProjectFilter{
+		CategoryID: filter.CategoryID,
+		IsActive:   &isActive,
+	}
+
+	if len(filter.Technologies) > 0 {
+		technologies, unknown, err := resolveTechnologies(ctx, s.techRepo, filter.Technologies)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(unknown) > 0 {
+			return []domain.PublicProject{}, 0, nil
+		}
+		projectFilter.TechnologyIDs = technologyIDs(technologies)
+	}
+
+	if filter.Team != "" {
+		// Former slugs are accepted as is, redirecting by a query parameter is not worth it
+		team, _, err := findTeamBySlug(ctx, s.teamRepo, filter.Team)
+		if err != nil {
+			if errors.Is(err, repository.ErrObjectNotFound) {
+				return []domain.PublicProject{}, 0, nil
+			}
+			return nil, 0, fmt.Errorf("getting team %s: %w", filter.Team, err)
+		}
+		if team.DisabledAt != nil {
+			return []domain.PublicProject{}, 0, nil
+		}
+		projectFilter.TeamID = &team.ID
+	}
+
+	projects, total, err := s.projectRepo.GetProjects(ctx, params, &projectFilter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting projects: %w", err)
+	}
+
+	projectPtrs := make([]*domain.Project, 0, len(projects))
+	for i := range projects {
+		projectPtrs = append(projectPtrs, &projects[i])
+	}
+	if err = s.translateProjects(ctx, projectPtrs...); err != nil {
+		return nil, 0, err
+	}
+
+	// Projects of a page usually belong to a few teams
+	teams := make(map[int32]*domain.PublicTeamRef)
+	result := make([]domain.PublicProject, 0, len(projects))
+	for i := range projects {
+		var team *domain.PublicTeamRef
+		if id := projects[i].TeamID; id != nil {
+			ref, ok := teams[*id]
+			if !ok {
+				ref, err = s.teamRef(ctx, *id)
+				if err != nil {
+					return nil, 0, err
+				}
+				teams[*id] = ref
+			}
+			team = ref
+		}
+
+		result = append(result, domain.NewPublicProject(&projects[i], team))
+	}
+
+	return result, total, nil
+}
+
+func (s *PublicService) GetProject(ctx context.Context, slug string) (*domain.PublicProject, error) {
+	project, err := s.visibleProject(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.translateProjects(ctx, project); err != nil {
+		return nil, err
+	}
+
+	var team *domain.PublicTeamRef
+	if project.TeamID != nil {
+		team, err = s.teamRef(ctx, *project.TeamID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := domain.NewPublicProject(project, team)
+	return &result, nil
+}
+
+func (s *PublicService) GetProjectParticipants(ctx context.Context, slug string) ([]domain.PublicMember, error) {
+	project, err := s.visibleProject(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, _, err := s.projectRepo.GetParticipants(ctx, project.ID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting project %d participants: %w", project.ID, err)
+	}
+
+	result := make([]domain.PublicMember, 0, len(participants))
+	for _, p := range participants {
+		result = append(result, domain.PublicMember{Name: p.Name, Surname: p.Surname, Position: p.Position.String()})
+	}
+
+	return result, nil
+}
+
+// GetProjectImage returns project and its opened image variant of the given size, the file must be closed by the caller.
+func (s *PublicService) GetProjectImage(ctx context.Context, slug string, size int) (*domain.Project, *domain.File, error) {
+	if err := validateImageSize(size); err != nil {
+		return nil, nil, err
+	}
+
+	project, err := s.visibleProject(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if project.ImageId == "" {
+		return nil, nil, apperr.NewNotFound("image_id")
+	}
+
+	file, err := s.projectImages.open(ctx, project.ImageId, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening project image: %w", err)
+	}
+
+	return project, file, nil
+}
+
+// GetProjectMedia returns the project gallery in display order.
+func (s *PublicService) GetProjectMedia(ctx context.Context, slug string) ([]domain.PublicMedia, error) {
+	project, err := s.visibleProject(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := s.mediaRepo.GetProjectMedia(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getting project %d media: %w", project.ID, err)
+	}
+
+	result := make([]domain.PublicMedia, 0, len(media))
+	for i := range media {
+		result = append(result, domain.NewPublicMedia(&media[i]))
+	}
+
+	return result, nil
+}
+
+// GetProjectMediaImage returns gallery image and its opened variant of the given size, the file must be closed by the caller.
+func (s *PublicService) GetProjectMediaImage(ctx context.Context, slug string, mediaID int32, size int) (*domain.ProjectMedia, *domain.File, error) {
+	if err := validateImageSize(size); err != nil {
+		return nil, nil, err
+	}
+
+	project, err := s.visibleProject(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	media, err := s.mediaRepo.GetMedia(ctx, mediaID, project.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, nil, apperr.NewNotFound("media_id")
+		}
+		return nil, nil, fmt.Errorf("getting media %d: %w", mediaID, err)
+	}
+	if media.ImageID == "" {
+		return nil, nil, apperr.NewNotFound("image_id")
+	}
+
+	file, err := s.mediaImages.open(ctx, media.ImageID, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening media image: %w", err)
+	}
+
+	return media, file, nil
+}
+
+// GetTeams returns the requested page of enabled teams and total number of enabled teams.
+func (s *PublicService) GetTeams(ctx context.Context, params *domain.ListParams) ([]domain.PublicTeam, int, error) {
+	disabled := false
+
+	teams, total, err := s.teamRepo.GetTeams(ctx, params, &domain.TeamFilter{Disabled: &disabled})
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting teams: %w", err)
+	}
+
+	teamPtrs := make([]*domain.Team, 0, len(teams))
+	for i := range teams {
+		teamPtrs = append(teamPtrs, &teams[i])
+	}
+	if err = s.translateTeams(ctx, teamPtrs...); err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]domain.PublicTeam, 0, len(teams))
+	for i := range teams {
+		result = append(result, domain.NewPublicTeam(&teams[i]))
+	}
+
+	return result, total, nil
+}
+
+func (s *PublicService) GetTeam(ctx context.Context, slug string) (*domain.PublicTeam, error) {
+	team, err := s.visibleTeam(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.translateTeams(ctx, team); err != nil {
+		return nil, err
+	}
+
+	result := domain.NewPublicTeam(team)
+	return &result, nil
+}
+
+func (s *PublicService) GetTeamMembers(ctx context.Context, slug string) ([]domain.PublicMember, error) {
+	team, err := s.visibleTeam(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.teamRepo.GetMembers(ctx, team.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getting team %d members: %w", team.ID, err)
+	}
+
+	result := make([]domain.PublicMember, 0, len(members))
+	for _, m := range members {
+		result = append(result, domain.PublicMember{Name: m.Name, Surname: m.Surname, Position: m.Position.String()})
+	}
+
+	return result, nil
+}
+
+// GetTeamImage returns team and its opened image variant of the given size, the file must be closed by the caller.
+func (s *PublicService) GetTeamImage(ctx context.Context, slug string, size int) (*domain.Team, *domain.File, error) {
+	if err := validateImageSize(size); err != nil {
+		return nil, nil, err
+	}
+
+	team, err := s.visibleTeam(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if team.ImageID == "" {
+		return nil, nil, apperr.NewNotFound("image_id")
+	}
+
+	file, err := s.teamImages.open(ctx, team.ImageID, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening team image: %w", err)
+	}
+
+	return team, file, nil
+}
+
+func (s *PublicService) GetCategories(ctx context.Context) ([]domain.ProjectCategory, error) {
+	categories, err := s.categoryRepo.GetProjectCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting project categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// GetTechnologies returns technologies used by active projects with the number of such projects, most used first.
+func (s *PublicService) GetTechnologies(ctx context.Context) ([]domain.TechnologyUsage, error) {
+	usage, err := s.techRepo.GetTechnologyUsage(ctx, 0, true)
+	if err != nil {
+		return nil, fmt.Errorf("getting technology usage: %w", err)
+	}
+
+	return usage, nil
+}
+
+// visibleProject returns active project, inactive ones are reported as not found.
+// Returns domain.SlugMovedError if the slug was changed.
+func (s *PublicService) visibleProject(ctx context.Context, slug string) (*domain.Project, error) {
+	project, moved, err := findProjectBySlug(ctx, s.projectRepo, slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("slug")
+		}
+		return nil, fmt.Errorf("getting project %s: %w", slug, err)
+	}
+	if !project.IsActive {
+		return nil, apperr.NewNotFound("slug")
+	}
+	if moved {
+		return nil, &domain.SlugMovedError{Slug: project.Slug}
+	}
+
+	return project, nil
+}
+
+// visibleTeam returns enabled team, disabled ones are reported as not found.
+// Returns domain.SlugMovedError if the slug was changed.
+func (s *PublicService) visibleTeam(ctx context.Context, slug string) (*domain.Team, error) {
+	team, moved, err := findTeamBySlug(ctx, s.teamRepo, slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("slug")
+		}
+		return nil, fmt.Errorf("getting team %s: %w", slug, err)
+	}
+	if team.DisabledAt != nil {
+		return nil, apperr.NewNotFound("slug")
+	}
+	if moved {
+		return nil, &domain.SlugMovedError{Slug: team.Slug}
+	}
+
+	return team, nil
+}
+
+// teamRef returns reference to the project team, nil if the team is disabled.
+func (s *PublicService) teamRef(ctx context.Context, teamID int32) (*domain.PublicTeamRef, error) {
+	team, err := s.teamRepo.GetTeam(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting team %d: %w", teamID, err)
+	}
+	if team.DisabledAt != nil {
+		return nil, nil
+	}
+
+	if err = s.translateTeams(ctx, team); err != nil {
+		return nil, err
+	}
+
+	return &domain.PublicTeamRef{Slug: team.Slug, Title: team.Title}, nil
+}
+
+// translateProjects replaces content of the projects with translations to the requested locale.
+// Projects without translation keep content in the default locale.
+func (s *PublicService) translateProjects(ctx context.Context, projects ...*domain.Project) error {
+	loc, ok := s.locales.requested(ctx)
+	if !ok || len(projects) == 0 {
+		return nil
+	}
+
+	ids := make([]int32, 0, len(projects))
+	for _, p := range projects {
+		ids = append(ids, p.ID)
+	}
+
+	translations, err := s.projectRepo.GetProjectTranslationsByLocale(ctx, ids, loc)
+	if err != nil {
+		return fmt.Errorf("getting project translations: %w", err)
+	}
+
+	for _, p := range projects {
+		if t, ok := translations[p.ID]; ok {
+			p.Translate(&t)
+		}
+	}
+
+	return nil
+}
+
+// translateTeams replaces content of the teams with translations to the requested locale.
+// Teams without translation keep content in the default locale.
+func (s *PublicService) translateTeams(ctx context.Context, teams ...*domain.Team) error {
+	loc, ok := s.locales.requested(ctx)
+	if !ok || len(teams) == 0 {
+		return nil
+	}
+
+	ids := make([]int32, 0, len(teams))
+	for _, t := range teams {
+		ids = append(ids, t.ID)
+	}
+
+	translations, err := s.teamRepo.GetTeamTranslationsByLocale(ctx, ids, loc)
+	if err != nil {
+		return fmt.Errorf("getting team translations: %w", err)
+	}
+
+	for _, t := range teams {
+		if tr, ok := translations[t.ID]; ok {
+			t.Translate(&tr)
+		}
+	}
+
+	return nil
+}
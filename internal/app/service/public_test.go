@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+	"web-studio-backend/internal/pkg/ptr"
+)
+
+var locales = service.Locales{Default: "ru", Supported: []string{"ru", "en"}}
+
+type publicMocks struct {
+	projectRepo *mocks.MockProjectRepository
+	teamRepo    *mocks.MockTeamRepository
+	mediaRepo   *mocks.MockProjectMediaRepository
+	techRepo    *mocks.MockTechnologyRepository
+}
+
+func public(t *testing.T) (*service.PublicService, publicMocks) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	m := publicMocks{
+		projectRepo: mocks.NewMockProjectRepository(mockCtl),
+		teamRepo:    mocks.NewMockTeamRepository(mockCtl),
+		mediaRepo:   mocks.NewMockProjectMediaRepository(mockCtl),
+		techRepo:    mocks.NewMockTechnologyRepository(mockCtl),
+	}
+
+	return service.NewPublicService(m.projectRepo, m.teamRepo, nil, m.mediaRepo, m.techRepo, mocks.NewMockFileRepository(mockCtl), locales), m
+}
+
+func TestPublicService_GetProject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should hide internal details", func(t *testing.T) {
+		serv, m := public(t)
+
+		m.projectRepo.EXPECT().GetProjectBySlug(ctx, "shop").Return(&domain.Project{
+			ID: 1, Slug: "shop", Title: "Shop", IsActive: true, TeamID: ptr.Int32(2), ImageId: "image",
+		}, nil)
+		m.teamRepo.EXPECT().GetTeam(ctx, int32(2)).Return(&domain.Team{ID: 2, Slug: "team", Title: "Team"}, nil)
+
+		project, err := serv.GetProject(ctx, "shop")
+		require.NoError(t, err)
+		require.Equal(t, &domain.PublicProject{
+			Slug:     "shop",
+			Title:    "Shop",
+			HasImage: true,
+			Team:     &domain.PublicTeamRef{Slug: "team", Title: "Team"},
+		}, project)
+	})
+
+	t.Run("should not find inactive project", func(t *testing.T) {
+		serv, m := public(t)
+
+		m.projectRepo.EXPECT().GetProjectBySlug(ctx, "old").Return(&domain.Project{ID: 1, Slug: "old"}, nil)
+
+		_, err := serv.GetProject(ctx, "old")
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.NotFoundType, appErr.Type)
+	})
+
+	t.Run("should omit disabled team", func(t *testing.T) {
+		serv, m := public(t)
+
+		disabledAt := time.Now()
+		m.projectRepo.EXPECT().GetProjectBySlug(ctx, "shop").Return(&domain.Project{
+			ID: 1, Slug: "shop", IsActive: true, TeamID: ptr.Int32(2),
+		}, nil)
+		m.teamRepo.EXPECT().GetTeam(ctx, int32(2)).Return(&domain.Team{ID: 2, DisabledAt: &disabledAt}, nil)
+
+		project, err := serv.GetProject(ctx, "shop")
+		require.NoError(t, err)
+		require.Nil(t, project.Team)
+	})
+}
+
+func TestPublicService_GetProjects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	params := &domain.ListParams{Limit: 10}
+
+	t.Run("should list active projects of the team", func(t *testing.T) {
+		serv, m := public(t)
+
+		isActive := true
+		m.teamRepo.EXPECT().GetTeamBySlug(ctx, "team").Return(&domain.Team{ID: 2, Slug: "team", Title: "Team"}, nil)
+		m.projectRepo.EXPECT().GetProjects(ctx, params, &domain.ProjectFilter{TeamID: ptr.Int32(2), IsActive: &isActive}).
+			Return([]domain.Project{
+				{ID: 1, Slug: "a", IsActive: true, TeamID: ptr.Int32(2)},
+				{ID: 2, Slug: "b", IsActive: true, TeamID: ptr.Int32(2)},
+			}, 2, nil)
+		m.teamRepo.EXPECT().GetTeam(ctx, int32(2)).Return(&domain.Team{ID: 2, Slug: "team", Title: "Team"}, nil).Times(1)
+
+		projects, total, err := serv.GetProjects(ctx, params, &domain.PublicProjectFilter{Team: "team"})
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+		require.Len(t, projects, 2)
+		require.Equal(t, "team", projects[1].Team.Slug)
+	})
+
+	t.Run("should return empty page for unknown team", func(t *testing.T) {
+		serv, m := public(t)
+
+		m.teamRepo.EXPECT().GetTeamBySlug(ctx, "unknown").Return(nil, repository.ErrObjectNotFound)
+		m.teamRepo.EXPECT().GetTeamSlugRedirect(ctx, "unknown").Return(int32(0), repository.ErrObjectNotFound)
+
+		projects, total, err := serv.GetProjects(ctx, params, &domain.PublicProjectFilter{Team: "unknown"})
+		require.NoError(t, err)
+		require.Zero(t, total)
+		require.Empty(t, projects)
+	})
+}
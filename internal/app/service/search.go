@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"web-studio-backend/internal/app/domain"
+)
+
+//go:generate mockgen -source=search.go -destination=./mocks/search.go -package=mocks
+type SearchRepository interface {
+	Search(ctx context.Context, req *domain.SearchRequest) ([]domain.SearchResult, error)
+}
+
+type SearchService struct {
+	repo SearchRepository
+}
+
+func NewSearchService(repo SearchRepository) *SearchService {
+	return &SearchService{repo}
+}
+
+// Search returns projects, users, teams and documents matching the query ordered by relevance.
+func (s *SearchService) Search(ctx context.Context, req *domain.SearchRequest) ([]domain.SearchResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("validating search request: %w", err)
+	}
+
+	results, err := s.repo.Search(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("searching %q: %w", req.Query, err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+//go:generate mockgen -source=sign_in_limiter.go -destination=./mocks/sign_in_limiter.go -package=mocks
+type SignInThrottleRepository interface {
+	GetSignInThrottle(ctx context.Context, kind domain.SignInThrottleKind, subject string) (*domain.SignInThrottle, error)
+	AddSignInFailure(ctx context.Context, kind domain.SignInThrottleKind, subject string, windowStart time.Time) (int, error)
+	RemoveSignInFailure(ctx context.Context, kind domain.SignInThrottleKind, subject string) error
+	BlockSignIn(ctx context.Context, kind domain.SignInThrottleKind, subject string, until time.Time) error
+	DeleteSignInThrottle(ctx context.Context, kind domain.SignInThrottleKind, subject string, staleBefore time.Time) error
+}
+
+// SystemAuditor records actions of authenticated users and events caused by the application itself.
+type SystemAuditor interface {
+	Auditor
+	RecordSystem(ctx context.Context, action domain.AuditAction, entity domain.AuditEntity, entityID int32, details any)
+}
+
+type SignInPolicy struct {
+	FreeAttempts    int           // Failed attempts to an account allowed without delay
+	IPFreeAttempts  int           // Failed attempts from an IP address allowed without delay
+	BackoffBase     time.Duration // Delay after the first attempt over the free ones, doubled with each next failure
+	BackoffMax      time.Duration // Upper limit of the delay
+	LockoutAttempts int           // Failed attempts after which the account is locked
+	LockoutDuration time.Duration // Lockout can be lifted earlier by an admin
+	Window          time.Duration // Failures are forgotten if there were none for this time
+}
+
+// SignInLimiter slows down password and second factor guessing. Passwords are counted per login and per IP address,
+// second factor codes per account and per IP address. Each next attempt over the free ones is delayed twice as long,
+// and the login or the account is locked after too many failures.
+//
+// An attempt is counted as failed before the credentials are checked, so concurrent requests can't slip through
+// before the delay is saved. Logins are counted before users are looked up, so unknown ones are throttled the same way
+// and responses don't tell which accounts exist. Attempts with a login are forgotten once the password turns out to be valid,
+// attempts to an account count until a session is started, an attempt from an address is taken back as soon as
+// the credentials turn out to be valid.
+type SignInLimiter struct {
+	repo   SignInThrottleRepository
+	tx     TxManager
+	audit  SystemAuditor
+	policy SignInPolicy
+}
+
+func NewSignInLimiter(repo SignInThrottleRepository, tx TxManager, audit SystemAuditor, policy SignInPolicy) *SignInLimiter {
+	return &SignInLimiter{repo, tx, audit, policy}
+}
+
+// reserveIP counts the attempt from the address, returns an error if sign in from it is delayed.
+func (l *SignInLimiter) reserveIP(ctx context.Context, ip string) error {
+	if ip == "" {
+		return nil
+	}
+
+	_, err := l.reserve(ctx, domain.SignInThrottleIP, ip)
+	return err
+}
+
+// reserveLogin counts the attempt with the login and returns the number of failures,
+// or an error if the login is locked or sign in is delayed.
+func (l *SignInLimiter) reserveLogin(ctx context.Context, login string) (int, error) {
+	return l.reserve(ctx, domain.SignInThrottleLogin, loginSubject(login))
+}
+
+// reserveUser counts the attempt to the account and returns the number of failures,
+// or an error if the account is locked or sign in is delayed.
+func (l *SignInLimiter) reserveUser(ctx context.Context, userID int32) (int, error) {
+	return l.reserve(ctx, domain.SignInThrottleUser, userSubject(userID))
+}
+
+func (l *SignInLimiter) reserve(ctx context.Context, kind domain.SignInThrottleKind, subject string) (int, error) {
+	now := time.Now()
+
+	var failures int
+	err := l.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		failures, err = l.repo.AddSignInFailure(ctx, kind, subject, now.Add(-l.policy.Window))
+		if err != nil {
+			return err
+		}
+
+		// The row is locked until the delay is saved, concurrent attempts wait and see it
+		if delay := l.delay(kind, failures); delay > 0 {
+			return l.repo.BlockSignIn(ctx, kind, subject, now.Add(delay))
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return 0, l.blocked(ctx, kind, subject)
+		}
+		return 0, fmt.Errorf("counting sign in attempt: %w", err)
+	}
+
+	return failures, nil
+}
+
+// blocked returns the error telling how long sign in is delayed.
+func (l *SignInLimiter) blocked(ctx context.Context, kind domain.SignInThrottleKind, subject string) error {
+	wait := time.Second
+
+	t, err := l.repo.GetSignInThrottle(ctx, kind, subject)
+	if err != nil && !errors.Is(err, repository.ErrObjectNotFound) {
+		return fmt.Errorf("getting sign in throttle: %w", err)
+	}
+	if now := time.Now(); t.Blocked(now) {
+		wait = max(t.BlockedUntil.Sub(now).Round(time.Second), time.Second)
+	}
+
+	return apperr.NewTooManyRequests(fmt.Sprintf("Too many failed sign in attempts, try again in %s.", wait))
+}
+
+// delay returns how long sign in is blocked after the given number of failures.
+func (l *SignInLimiter) delay(kind domain.SignInThrottleKind, failures int) time.Duration {
+	if kind == domain.SignInThrottleIP {
+		return l.backoff(failures - l.policy.IPFreeAttempts)
+	}
+
+	delay := l.backoff(failures - l.policy.FreeAttempts)
+	if l.locked(failures) && l.policy.LockoutDuration > delay {
+		delay = l.policy.LockoutDuration
+	}
+
+	return delay
+}
+
+func (l *SignInLimiter) locked(failures int) bool {
+	return l.policy.LockoutAttempts > 0 && failures >= l.policy.LockoutAttempts
+}
+
+// fail records the lockout if the reserved attempt to the account or with its login has failed and locked it.
+func (l *SignInLimiter) fail(ctx context.Context, userID int32, ip string, failures int) {
+	if userID == 0 || !l.locked(failures) {
+		return
+	}
+
+	// Attempts are rejected without counting while locked, so the lockout is recorded once
+	l.audit.RecordSystem(ctx, domain.AuditActionLock, domain.AuditEntityUser, userID, &domain.SignInLockout{
+		Failures:    failures,
+		IP:          ip,
+		LockedUntil: time.Now().Add(l.delay(domain.SignInThrottleUser, failures)),
+	})
+}
+
+// releaseIP takes back the attempt from the address after valid credentials.
+func (l *SignInLimiter) releaseIP(ctx context.Context, ip string) error {
+	if ip == "" {
+		return nil
+	}
+
+	err := l.repo.RemoveSignInFailure(ctx, domain.SignInThrottleIP, ip)
+	if err != nil {
+		return fmt.Errorf("taking back sign in attempt from %s: %w", ip, err)
+	}
+
+	return nil
+}
+
+// releaseLogin forgets failed attempts with the login after valid password.
+func (l *SignInLimiter) releaseLogin(ctx context.Context, login string) error {
+	err := l.repo.DeleteSignInThrottle(ctx, domain.SignInThrottleLogin, loginSubject(login), time.Now().Add(-l.policy.Window))
+	if err != nil && !errors.Is(err, repository.ErrObjectNotFound) {
+		return fmt.Errorf("resetting failed sign ins with login: %w", err)
+	}
+
+	return nil
+}
+
+// succeed forgets failed attempts to the account, it is called once the session is started.
+func (l *SignInLimiter) succeed(ctx context.Context, userID int32) error {
+	err := l.repo.DeleteSignInThrottle(ctx, domain.SignInThrottleUser, userSubject(userID), time.Now().Add(-l.policy.Window))
+	if err != nil && !errors.Is(err, repository.ErrObjectNotFound) {
+		return fmt.Errorf("resetting user %d failed sign ins: %w", userID, err)
+	}
+
+	return nil
+}
+
+// UnlockUser lifts the lockout of the account and its logins and forgets their failed attempts.
+func (l *SignInLimiter) UnlockUser(ctx context.Context, user *domain.User) error {
+	subjects := map[domain.SignInThrottleKind][]string{
+		domain.SignInThrottleUser:  {userSubject(user.ID)},
+		domain.SignInThrottleLogin: {loginSubject(user.Username), loginSubject(user.Email)},
+	}
+
+	unlocked := false
+	for kind, kindSubjects := range subjects {
+		for _, subject := range kindSubjects {
+			if subject == "" {
+				continue
+			}
+
+			err := l.repo.DeleteSignInThrottle(ctx, kind, subject, time.Now().Add(-l.policy.Window))
+			if err != nil {
+				if errors.Is(err, repository.ErrObjectNotFound) {
+					continue
+				}
+				return fmt.Errorf("unlocking user %d: %w", user.ID, err)
+			}
+			unlocked = true
+		}
+	}
+	if !unlocked {
+		return nil
+	}
+
+	l.audit.Record(ctx, domain.AuditActionUnlock, domain.AuditEntityUser, user.ID, nil, nil)
+
+	return nil
+}
+
+// backoff returns delay after the given number of failures over the free ones.
+func (l *SignInLimiter) backoff(over int) time.Duration {
+	if over <= 0 || l.policy.BackoffBase <= 0 {
+		return 0
+	}
+
+	delay := l.policy.BackoffBase
+	for i := 1; i < over && delay < l.policy.BackoffMax; i++ {
+		delay *= 2
+	}
+	if l.policy.BackoffMax > 0 && delay > l.policy.BackoffMax {
+		delay = l.policy.BackoffMax
+	}
+
+	return delay
+}
+
+func userSubject(userID int32) string {
+	return strconv.Itoa(int(userID))
+}
+
+// loginSubject normalizes the login, so its spellings are counted together like users are looked up by them.
+func loginSubject(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/pkg/slug"
+)
+
+// uniqueSlug makes slug from the title, fallback is used if nothing is left of the title.
+// Numeric suffix is added if the slug is taken.
+func uniqueSlug(ctx context.Context, title, fallback string, exists func(ctx context.Context, slug string) (bool, error)) (string, error) {
+	base := slug.Make(title)
+	if base == "" {
+		base = fallback
+	}
+
+	candidate := base
+	for i := 2; ; i++ {
+		taken, err := exists(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+
+		candidate = base + "-" + strconv.Itoa(i)
+		if len(candidate) > slug.MaxLength {
+			return "", fmt.Errorf("no free slug for %q", title)
+		}
+	}
+}
+
+// slugAttempts limits how many times a slug is generated when concurrent requests take the generated ones.
+const slugAttempts = 3
+
+// createWithSlug runs create with the slug chosen by a user or, if it is empty, generated from the title.
+// Slug is checked before insert, so a concurrent request can take it in between: generated slug is made again,
+// while the chosen one is reported as duplicate.
+func createWithSlug(
+	ctx context.Context,
+	slug *string,
+	title, fallback string,
+	exists func(ctx context.Context, slug string) (bool, error),
+	create func() error,
+) error {
+	generated := *slug == ""
+	for attempt := 1; ; attempt++ {
+		if generated {
+			s, err := uniqueSlug(ctx, title, fallback, exists)
+			if err != nil {
+				return fmt.Errorf("making slug: %w", err)
+			}
+			*slug = s
+		}
+
+		err := create()
+		if !errors.Is(err, repository.ErrDuplicate) {
+			return err
+		}
+		if !generated || attempt == slugAttempts {
+			return apperr.NewDuplicate("Slug already taken.", "slug")
+		}
+	}
+}
+
+// claimSlug checks that the slug chosen by a user is free, objects can get back their former slugs.
+// Zero id is used for objects being created.
+func claimSlug(
+	ctx context.Context,
+	id int32,
+	slug string,
+	exists func(ctx context.Context, slug string) (bool, error),
+	formerOwner func(ctx context.Context, slug string) (int32, error),
+) error {
+	owner, err := formerOwner(ctx, slug)
+	if err != nil && !errors.Is(err, repository.ErrObjectNotFound) {
+		return fmt.Errorf("getting slug redirect: %w", err)
+	}
+	if err == nil && owner == id {
+		return nil
+	}
+
+	taken, err := exists(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return apperr.NewDuplicate("Slug already taken.", "slug")
+	}
+
+	return nil
+}
+
+// findProjectBySlug returns project by its current or former slug, moved reports whether the slug is a former one.
+// Returns repository.ErrObjectNotFound if there is no such project.
+func findProjectBySlug(ctx context.Context, repo ProjectRepository, slug string) (project *domain.Project, moved bool, err error) {
+	project, err = repo.GetProjectBySlug(ctx, slug)
+	if err == nil || !errors.Is(err, repository.ErrObjectNotFound) {
+		return project, false, err
+	}
+
+	id, err := repo.GetProjectSlugRedirect(ctx, slug)
+	if err != nil {
+		return nil, false, err
+	}
+
+	project, err = repo.GetProject(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return project, true, nil
+}
+
+// findTeamBySlug returns team by its current or former slug, moved reports whether the slug is a former one.
+// Returns repository.ErrObjectNotFound if there is no such team.
+func findTeamBySlug(ctx context.Context, repo TeamRepository, slug string) (team *domain.Team, moved bool, err error) {
+	team, err = repo.GetTeamBySlug(ctx, slug)
+	if err == nil || !errors.Is(err, repository.ErrObjectNotFound) {
+		return team, false, err
+	}
+
+	id, err := repo.GetTeamSlugRedirect(ctx, slug)
+	if err != nil {
+		return nil, false, err
+	}
+
+	team, err = repo.GetTeam(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return team, true, nil
+}
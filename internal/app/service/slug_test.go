@@ -0,0 +1,197 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+)
+
+func team(t *testing.T) (*service.TeamService, *mocks.MockTeamRepository) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	repo := mocks.NewMockTeamRepository(mockCtl)
+	tx := mocks.NewMockTxManager(mockCtl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(context.WithValue(ctx, txKey{}, true))
+	}).AnyTimes()
+	auditor := mocks.NewMockAuditor(mockCtl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	return service.NewTeamService(repo, mocks.NewMockUserRepository(mockCtl), mocks.NewMockFileRepository(mockCtl), tx, auditor, locales), repo
+}
+
+// txKey marks contexts passed to functions run within the mocked transaction.
+type txKey struct{}
+
+// requireTx fails the test if ctx does not belong to a transaction.
+func requireTx(t *testing.T, ctx context.Context) {
+	t.Helper()
+	require.Equal(t, true, ctx.Value(txKey{}), "called outside of transaction")
+}
+
+func TestTeamService_CreateTeam_Slug(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should transliterate title and skip taken slugs", func(t *testing.T) {
+		serv, repo := team(t)
+
+		repo.EXPECT().CheckTeamUniqueness(ctx, "Веб Студия").Return(nil, repository.ErrObjectNotFound)
+		repo.EXPECT().TeamSlugExists(ctx, "veb-studiya").Return(true, nil)
+		repo.EXPECT().TeamSlugExists(ctx, "veb-studiya-2").Return(false, nil)
+		repo.EXPECT().CreateTeam(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, team *domain.Team) (int32, error) {
+			require.Equal(t, "veb-studiya-2", team.Slug)
+			return 1, nil
+		})
+		repo.EXPECT().GetTeam(ctx, int32(1)).Return(&domain.Team{ID: 1, Slug: "veb-studiya-2"}, nil)
+
+		_, err := serv.CreateTeam(ctx, &domain.Team{Title: "Веб Студия"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should make slug again when it is taken concurrently", func(t *testing.T) {
+		serv, repo := team(t)
+
+		repo.EXPECT().CheckTeamUniqueness(ctx, "Team").Return(nil, repository.ErrObjectNotFound)
+		gomock.InOrder(
+			repo.EXPECT().TeamSlugExists(ctx, "team").Return(false, nil),
+			repo.EXPECT().CreateTeam(ctx, gomock.Any()).Return(int32(0), repository.ErrDuplicate),
+			repo.EXPECT().TeamSlugExists(ctx, "team").Return(true, nil),
+			repo.EXPECT().TeamSlugExists(ctx, "team-2").Return(false, nil),
+			repo.EXPECT().CreateTeam(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, team *domain.Team) (int32, error) {
+				require.Equal(t, "team-2", team.Slug)
+				return 1, nil
+			}),
+		)
+		repo.EXPECT().GetTeam(ctx, int32(1)).Return(&domain.Team{ID: 1, Slug: "team-2"}, nil)
+
+		_, err := serv.CreateTeam(ctx, &domain.Team{Title: "Team"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should give up when slugs keep being taken", func(t *testing.T) {
+		serv, repo := team(t)
+
+		repo.EXPECT().CheckTeamUniqueness(ctx, "Team").Return(nil, repository.ErrObjectNotFound)
+		repo.EXPECT().TeamSlugExists(ctx, "team").Return(false, nil).Times(3)
+		repo.EXPECT().CreateTeam(ctx, gomock.Any()).Return(int32(0), repository.ErrDuplicate).Times(3)
+
+		_, err := serv.CreateTeam(ctx, &domain.Team{Title: "Team"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.DuplicateType, appErr.Type)
+	})
+
+	t.Run("should reject chosen slug taken concurrently", func(t *testing.T) {
+		serv, repo := team(t)
+
+		repo.EXPECT().CheckTeamUniqueness(ctx, "Team").Return(nil, repository.ErrObjectNotFound)
+		repo.EXPECT().GetTeamSlugRedirect(ctx, "chosen").Return(int32(0), repository.ErrObjectNotFound)
+		repo.EXPECT().TeamSlugExists(ctx, "chosen").Return(false, nil)
+		repo.EXPECT().CreateTeam(ctx, gomock.Any()).Return(int32(0), repository.ErrDuplicate)
+
+		_, err := serv.CreateTeam(ctx, &domain.Team{Title: "Team", Slug: "chosen"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.DuplicateType, appErr.Type)
+	})
+
+	t.Run("should reject former slug of another team", func(t *testing.T) {
+		serv, repo := team(t)
+
+		repo.EXPECT().CheckTeamUniqueness(ctx, "Team").Return(nil, repository.ErrObjectNotFound)
+		repo.EXPECT().GetTeamSlugRedirect(ctx, "old").Return(int32(2), nil)
+		repo.EXPECT().TeamSlugExists(ctx, "old").Return(true, nil)
+
+		_, err := serv.CreateTeam(ctx, &domain.Team{Title: "Team", Slug: "old"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.DuplicateType, appErr.Type)
+	})
+}
+
+func TestTeamService_UpdateTeam_Slug(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	existing := &domain.Team{ID: 1, Slug: "new", Title: "Team"}
+
+	t.Run("should give back former slug within transaction", func(t *testing.T) {
+		serv, repo := team(t)
+
+		repo.EXPECT().GetTeam(ctx, int32(1)).Return(existing, nil)
+		repo.EXPECT().CheckTeamUniqueness(ctx, "Renamed").Return(nil, repository.ErrObjectNotFound)
+		repo.EXPECT().GetTeamSlugRedirect(ctx, "old").Return(int32(1), nil)
+		repo.EXPECT().UpdateTeam(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, _ *domain.Team) error {
+			requireTx(t, ctx)
+			return nil
+		})
+		repo.EXPECT().ChangeTeamSlug(gomock.Any(), int32(1), "old").DoAndReturn(func(ctx context.Context, _ int32, _ string) error {
+			requireTx(t, ctx)
+			return nil
+		})
+		repo.EXPECT().GetTeam(ctx, int32(1)).Return(&domain.Team{ID: 1, Slug: "old"}, nil)
+
+		updated, err := serv.UpdateTeam(ctx, &domain.Team{ID: 1, Title: "Renamed", Slug: "old"})
+		require.NoError(t, err)
+		require.Equal(t, "old", updated.Slug)
+	})
+
+	t.Run("should reject slug taken concurrently", func(t *testing.T) {
+		serv, repo := team(t)
+
+		repo.EXPECT().GetTeam(ctx, int32(1)).Return(existing, nil)
+		repo.EXPECT().CheckTeamUniqueness(ctx, "Team").Return(nil, repository.ErrObjectNotFound)
+		repo.EXPECT().GetTeamSlugRedirect(ctx, "other").Return(int32(0), repository.ErrObjectNotFound)
+		repo.EXPECT().TeamSlugExists(ctx, "other").Return(false, nil)
+		repo.EXPECT().UpdateTeam(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().ChangeTeamSlug(gomock.Any(), int32(1), "other").Return(repository.ErrDuplicate)
+
+		_, err := serv.UpdateTeam(ctx, &domain.Team{ID: 1, Title: "Team", Slug: "other"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.DuplicateType, appErr.Type)
+	})
+
+	t.Run("should reject invalid slug", func(t *testing.T) {
+		serv, _ := team(t)
+
+		_, err := serv.UpdateTeam(ctx, &domain.Team{ID: 1, Title: "Team", Slug: "Not a slug"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+}
+
+func TestTeamService_GetTeamBySlug(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serv, repo := team(t)
+
+	repo.EXPECT().GetTeamBySlug(ctx, "old").Return(nil, repository.ErrObjectNotFound)
+	repo.EXPECT().GetTeamSlugRedirect(ctx, "old").Return(int32(1), nil)
+	repo.EXPECT().GetTeam(ctx, int32(1)).Return(&domain.Team{ID: 1, Slug: "new"}, nil)
+
+	_, err := serv.GetTeamBySlug(ctx, "old")
+
+	var moved *domain.SlugMovedError
+	require.ErrorAs(t, err, &moved)
+	require.Equal(t, "new", moved.Slug)
+}
@@ -4,11 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log/slog"
-	"path/filepath"
-
-	"github.com/gabriel-vasile/mimetype"
-	"github.com/google/uuid"
 
 	"web-studio-backend/internal/app/domain"
 	"web-studio-backend/internal/app/domain/apperr"
@@ -18,23 +13,41 @@ import (
 //go:generate mockgen -source=team.go -destination=./mocks/team.go -package=mocks
 type TeamRepository interface {
 	GetTeam(ctx context.Context, id int32) (*domain.Team, error)
-	GetTeams(ctx context.Context) ([]domain.Team, error)
+	GetTeams(ctx context.Context, params *domain.ListParams, filter *domain.TeamFilter) ([]domain.Team, int, error)
+	GetTeamBySlug(ctx context.Context, slug string) (*domain.Team, error)
+	TeamSlugExists(ctx context.Context, slug string) (bool, error)
+	GetTeamSlugRedirect(ctx context.Context, slug string) (int32, error)
+	ChangeTeamSlug(ctx context.Context, id int32, slug string) error
 	CreateTeam(ctx context.Context, team *domain.Team) (int32, error)
 	UpdateTeam(ctx context.Context, team *domain.Team) error
 	SetTeamImageID(ctx context.Context, teamID int32, imageID string) error
 	DisableTeam(ctx context.Context, teamID int32) error
 	EnableTeam(ctx context.Context, teamID int32) error
 	CheckTeamUniqueness(ctx context.Context, title string) (*domain.Team, error)
+
+	GetMembers(ctx context.Context, teamID int32) ([]domain.TeamMember, error)
+	GetMember(ctx context.Context, memberID, teamID int32) (*domain.TeamMember, error)
+	AddMember(ctx context.Context, member *domain.TeamMember) error
+	UpdateMember(ctx context.Context, member *domain.TeamMember) error
+	RemoveMember(ctx context.Context, memberID, teamID int32) error
+
+	GetTeamTranslations(ctx context.Context, teamID int32) ([]domain.Translation, error)
+	GetTeamTranslationsByLocale(ctx context.Context, teamIDs []int32, locale string) (map[int32]domain.Translation, error)
+	SaveTeamTranslation(ctx context.Context, teamID int32, t *domain.Translation) error
+	DeleteTeamTranslation(ctx context.Context, teamID int32, locale string) error
 }
 
 type TeamService struct {
-	filesDir string
+	images   imageStorage
 	repo     TeamRepository
-	fileRepo FileRepository
+	userRepo UserRepository
+	tx       TxManager
+	audit    Auditor
+	locales  Locales
 }
 
-func NewTeamService(repo TeamRepository, fileRepo FileRepository) *TeamService {
-	return &TeamService{"teams", repo, fileRepo}
+func NewTeamService(repo TeamRepository, userRepo UserRepository, fileRepo FileRepository, tx TxManager, audit Auditor, locales Locales) *TeamService {
+	return &TeamService{newImageStorage("teams", fileRepo), repo, userRepo, tx, audit, locales}
 }
 
 func (s *TeamService) GetTeam(ctx context.Context, id int32) (*domain.Team, error) {
@@ -49,13 +62,31 @@ func (s *TeamService) GetTeam(ctx context.Context, id int32) (*domain.Team, erro
 	return team, nil
 }
 
-func (s *TeamService) GetTeams(ctx context.Context) ([]domain.Team, error) {
-	teams, err := s.repo.GetTeams(ctx)
+// GetTeamBySlug returns team by its slug.
+// Returns domain.SlugMovedError if the slug was changed, so the client can be redirected.
+func (s *TeamService) GetTeamBySlug(ctx context.Context, slug string) (*domain.Team, error) {
+	team, moved, err := findTeamBySlug(ctx, s.repo, slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("slug")
+		}
+		return nil, fmt.Errorf("getting team %s: %w", slug, err)
+	}
+	if moved {
+		return nil, &domain.SlugMovedError{Slug: team.Slug}
+	}
+
+	return team, nil
+}
+
+// GetTeams returns the requested page of teams and total number of teams matching the filter.
+func (s *TeamService) GetTeams(ctx context.Context, params *domain.ListParams, filter *domain.TeamFilter) ([]domain.Team, int, error) {
+	teams, total, err := s.repo.GetTeams(ctx, params, filter)
 	if err != nil {
-		return nil, fmt.Errorf("getting teams: %w", err)
+		return nil, 0, fmt.Errorf("getting teams: %w", err)
 	}
 
-	return teams, nil
+	return teams, total, nil
 }
 
 func (s *TeamService) CreateTeam(ctx context.Context, team *domain.Team) (*domain.Team, error) {
@@ -71,9 +102,23 @@ func (s *TeamService) CreateTeam(ctx context.Context, team *domain.Team) (*domai
 		return nil, apperr.NewDuplicate("Title already taken.", "title")
 	}
 
-	id, err := s.repo.CreateTeam(ctx, team)
+	if team.Slug != "" {
+		err = claimSlug(ctx, 0, team.Slug, s.repo.TeamSlugExists, s.repo.GetTeamSlugRedirect)
+		if err != nil {
+			return nil, fmt.Errorf("checking team slug: %w", err)
+		}
+	}
+
+	var id int32
+	err = createWithSlug(ctx, &team.Slug, team.Title, "team", s.repo.TeamSlugExists, func() error {
+		id, err = s.repo.CreateTeam(ctx, team)
+		if err != nil {
+			return fmt.Errorf("creating team: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("creating team: %w", err)
+		return nil, err
 	}
 
 	createdTeam, err := s.repo.GetTeam(ctx, id)
@@ -81,6 +126,8 @@ func (s *TeamService) CreateTeam(ctx context.Context, team *domain.Team) (*domai
 		return nil, fmt.Errorf("getting team %d: %w", id, err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionCreate, domain.AuditEntityTeam, id, nil, createdTeam)
+
 	return createdTeam, nil
 }
 
@@ -89,7 +136,7 @@ func (s *TeamService) UpdateTeam(ctx context.Context, team *domain.Team) (*domai
 		return nil, fmt.Errorf("validating team: %w", err)
 	}
 
-	_, err := s.repo.GetTeam(ctx, team.ID)
+	existingTeam, err := s.repo.GetTeam(ctx, team.ID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
 			return nil, apperr.NewNotFound("team_id")
@@ -107,9 +154,34 @@ func (s *TeamService) UpdateTeam(ctx context.Context, team *domain.Team) (*domai
 		}
 	}
 
-	err = s.repo.UpdateTeam(ctx, team)
+	slugChanged := team.Slug != "" && team.Slug != existingTeam.Slug
+	if slugChanged {
+		err = claimSlug(ctx, team.ID, team.Slug, s.repo.TeamSlugExists, s.repo.GetTeamSlugRedirect)
+		if err != nil {
+			return nil, fmt.Errorf("checking team slug: %w", err)
+		}
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		err := s.repo.UpdateTeam(ctx, team)
+		if err != nil {
+			return fmt.Errorf("updating team %d: %w", team.ID, err)
+		}
+
+		if slugChanged {
+			err = s.repo.ChangeTeamSlug(ctx, team.ID, team.Slug)
+			if err != nil {
+				if errors.Is(err, repository.ErrDuplicate) {
+					return apperr.NewDuplicate("Slug already taken.", "slug")
+				}
+				return fmt.Errorf("changing team %d slug: %w", team.ID, err)
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("creating team: %w", err)
+		return nil, err
 	}
 
 	updatedTeam, err := s.repo.GetTeam(ctx, team.ID)
@@ -117,24 +189,12 @@ func (s *TeamService) UpdateTeam(ctx context.Context, team *domain.Team) (*domai
 		return nil, fmt.Errorf("getting team %d: %w", team.ID, err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityTeam, team.ID, existingTeam, updatedTeam)
+
 	return updatedTeam, nil
 }
 
 func (s *TeamService) SetTeamImage(ctx context.Context, teamID int32, img []byte) error {
-	if len(img) > 5<<20 {
-		return apperr.NewInvalidRequest("Image is too big.", "file")
-	}
-
-	mt := mimetype.Detect(img)
-	if !mt.Is("image/jpeg") &&
-		!mt.Is("image/png") &&
-		!mt.Is("image/webp") {
-		return apperr.NewInvalidRequest("Invalid image mime type.", "file")
-	}
-
-	fileID := uuid.New().String()
-	fileName := fileID + mt.Extension()
-
 	team, err := s.repo.GetTeam(ctx, teamID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
@@ -143,50 +203,50 @@ func (s *TeamService) SetTeamImage(ctx context.Context, teamID int32, img []byte
 		return fmt.Errorf("getting team %d: %w", teamID, err)
 	}
 
-	err = s.repo.SetTeamImageID(ctx, teamID, fileName)
+	imageID, err := s.images.save(ctx, img)
 	if err != nil {
-		return fmt.Errorf("setting team %d image: %w", teamID, err)
+		return fmt.Errorf("saving team image: %w", err)
 	}
 
-	err = s.fileRepo.Save(ctx, img, filepath.Join(s.filesDir, fileName))
+	err = s.repo.SetTeamImageID(ctx, teamID, imageID)
 	if err != nil {
-		return fmt.Errorf("saving team image: %w", err)
+		s.images.delete(ctx, imageID)
+		return fmt.Errorf("setting team %d image: %w", teamID, err)
 	}
 
 	if team.ImageID != "" {
-		err = s.fileRepo.Delete(ctx, filepath.Join(s.filesDir, team.ImageID))
-		if err != nil {
-			slog.Error("Deleting old team image", slog.String("error", err.Error()))
-		}
+		s.images.delete(ctx, team.ImageID)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionSetImage, domain.AuditEntityTeam, teamID, nil, nil)
+
 	return nil
 }
 
-func (s *TeamService) GetTeamImage(ctx context.Context, teamID int32) (*domain.Team, error) {
+// GetTeamImage returns team and its opened image variant of the given size, the file must be closed by the caller.
+func (s *TeamService) GetTeamImage(ctx context.Context, teamID int32, size int) (*domain.Team, *domain.File, error) {
+	if err := validateImageSize(size); err != nil {
+		return nil, nil, err
+	}
+
 	team, err := s.repo.GetTeam(ctx, teamID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
-			return nil, apperr.NewNotFound("team_id")
+			return nil, nil, apperr.NewNotFound("team_id")
 		}
-		return nil, fmt.Errorf("getting team %d: %w", teamID, err)
+		return nil, nil, fmt.Errorf("getting team %d: %w", teamID, err)
 	}
 
 	if team.ImageID == "" {
-		return nil, apperr.NewNotFound("image_id")
+		return nil, nil, apperr.NewNotFound("image_id")
 	}
 
-	data, err := s.fileRepo.Read(ctx, filepath.Join(s.filesDir, team.ImageID))
+	file, err := s.images.open(ctx, team.ImageID, size)
 	if err != nil {
-		if errors.Is(err, repository.ErrObjectNotFound) {
-			return nil, apperr.NewNotFound("image_id")
-		}
-		return nil, fmt.Errorf("reading team image: %w", err)
+		return nil, nil, fmt.Errorf("opening team image: %w", err)
 	}
 
-	team.ImageContent = data
-
-	return team, nil
+	return team, file, nil
 }
 
 func (s *TeamService) DisableTeam(ctx context.Context, teamID int32) error {
@@ -203,6 +263,8 @@ func (s *TeamService) DisableTeam(ctx context.Context, teamID int32) error {
 		return fmt.Errorf("disabling team %d: %w", teamID, err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionDisable, domain.AuditEntityTeam, teamID, nil, nil)
+
 	return nil
 }
 
@@ -220,5 +282,204 @@ func (s *TeamService) EnableTeam(ctx context.Context, teamID int32) error {
 		return fmt.Errorf("enabling team %d: %w", teamID, err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionEnable, domain.AuditEntityTeam, teamID, nil, nil)
+
+	return nil
+}
+
+func (s *TeamService) GetMembers(ctx context.Context, teamID int32) ([]domain.TeamMember, error) {
+	_, err := s.repo.GetTeam(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("team_id")
+		}
+		return nil, fmt.Errorf("getting team %d: %w", teamID, err)
+	}
+
+	members, err := s.repo.GetMembers(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("getting team %d members: %w", teamID, err)
+	}
+
+	return members, nil
+}
+
+func (s *TeamService) GetMember(ctx context.Context, memberID, teamID int32) (*domain.TeamMember, error) {
+	_, err := s.repo.GetTeam(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("team_id")
+		}
+		return nil, fmt.Errorf("getting team %d: %w", teamID, err)
+	}
+
+	member, err := s.repo.GetMember(ctx, memberID, teamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("user_id")
+		}
+		return nil, fmt.Errorf("getting team member: %w", err)
+	}
+
+	return member, nil
+}
+
+func (s *TeamService) AddMember(ctx context.Context, member *domain.TeamMember) (*domain.TeamMember, error) {
+	if err := member.Validate(); err != nil {
+		return nil, fmt.Errorf("validating team member: %w", err)
+	}
+
+	_, err := s.userRepo.GetActiveUser(ctx, member.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("user_id")
+		}
+		return nil, fmt.Errorf("getting active user: %w", err)
+	}
+
+	_, err = s.repo.GetTeam(ctx, member.TeamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("team_id")
+		}
+		return nil, fmt.Errorf("getting team: %w", err)
+	}
+
+	_, err = s.repo.GetMember(ctx, member.UserID, member.TeamID)
+	if err != nil && !errors.Is(err, repository.ErrObjectNotFound) {
+		return nil, fmt.Errorf("getting team member: %w", err)
+	}
+	if err == nil {
+		return nil, apperr.NewDuplicate("User already in team members list.", "user_id")
+	}
+
+	// Concurrent request can add the user after the check above
+	err = s.repo.AddMember(ctx, member)
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, apperr.NewDuplicate("User already in team members list.", "user_id")
+		}
+		return nil, fmt.Errorf("adding team member: %w", err)
+	}
+
+	addedMember, err := s.repo.GetMember(ctx, member.UserID, member.TeamID)
+	if err != nil {
+		return nil, fmt.Errorf("getting added team member: %w", err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionAddMember, domain.AuditEntityTeam, member.TeamID, nil, addedMember)
+
+	return addedMember, nil
+}
+
+func (s *TeamService) UpdateMember(ctx context.Context, member *domain.TeamMember) (*domain.TeamMember, error) {
+	if err := member.Validate(); err != nil {
+		return nil, fmt.Errorf("validating team member: %w", err)
+	}
+
+	existingMember, err := s.repo.GetMember(ctx, member.UserID, member.TeamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("user_id")
+		}
+		return nil, fmt.Errorf("getting team member: %w", err)
+	}
+
+	err = s.repo.UpdateMember(ctx, member)
+	if err != nil {
+		return nil, fmt.Errorf("updating team member: %w", err)
+	}
+
+	updatedMember, err := s.repo.GetMember(ctx, member.UserID, member.TeamID)
+	if err != nil {
+		return nil, fmt.Errorf("getting updated team member: %w", err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionUpdateMember, domain.AuditEntityTeam, member.TeamID, existingMember, updatedMember)
+
+	return updatedMember, nil
+}
+
+func (s *TeamService) RemoveMember(ctx context.Context, memberID, teamID int32) error {
+	member, err := s.repo.GetMember(ctx, memberID, teamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewNotFound("user_id")
+		}
+		return fmt.Errorf("getting team member: %w", err)
+	}
+
+	err = s.repo.RemoveMember(ctx, memberID, teamID)
+	if err != nil {
+		return fmt.Errorf("removing team member: %w", err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionRemoveMember, domain.AuditEntityTeam, teamID, member, nil)
+
+	return nil
+}
+
+// GetTeamTranslations returns translations of the team to all languages except the default one.
+func (s *TeamService) GetTeamTranslations(ctx context.Context, teamID int32) ([]domain.Translation, error) {
+	_, err := s.repo.GetTeam(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("team_id")
+		}
+		return nil, fmt.Errorf("getting team %d: %w", teamID, err)
+	}
+
+	translations, err := s.repo.GetTeamTranslations(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("getting team %d translations: %w", teamID, err)
+	}
+
+	return translations, nil
+}
+
+// SetTeamTranslation creates or replaces translation of the team to the locale.
+func (s *TeamService) SetTeamTranslation(ctx context.Context, teamID int32, translation *domain.Translation) (*domain.Translation, error) {
+	if err := s.locales.validate(translation.Locale); err != nil {
+		return nil, err
+	}
+	if err := translation.ValidateTeam(); err != nil {
+		return nil, fmt.Errorf("validating team translation: %w", err)
+	}
+
+	_, err := s.repo.GetTeam(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("team_id")
+		}
+		return nil, fmt.Errorf("getting team %d: %w", teamID, err)
+	}
+
+	err = s.repo.SaveTeamTranslation(ctx, teamID, translation)
+	if err != nil {
+		return nil, fmt.Errorf("saving team %d translation: %w", teamID, err)
+	}
+
+	saved, err := s.repo.GetTeamTranslationsByLocale(ctx, []int32{teamID}, translation.Locale)
+	if err != nil {
+		return nil, fmt.Errorf("getting team %d translation: %w", teamID, err)
+	}
+	result := saved[teamID]
+
+	s.audit.Record(ctx, domain.AuditActionSetTranslation, domain.AuditEntityTeam, teamID, nil, &result)
+
+	return &result, nil
+}
+
+func (s *TeamService) DeleteTeamTranslation(ctx context.Context, teamID int32, locale string) error {
+	err := s.repo.DeleteTeamTranslation(ctx, teamID, locale)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewNotFound("locale")
+		}
+		return fmt.Errorf("deleting team %d translation: %w", teamID, err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionDeleteTranslation, domain.AuditEntityTeam, teamID, nil, map[string]string{"locale": locale})
+
 	return nil
 }
@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+)
+
+func TestTeamService_GetMember(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should return member of the team", func(t *testing.T) {
+		mockCtl := gomock.NewController(t)
+		repo := mocks.NewMockTeamRepository(mockCtl)
+		serv := service.NewTeamService(repo, mocks.NewMockUserRepository(mockCtl), mocks.NewMockFileRepository(mockCtl), mocks.NewMockTxManager(mockCtl), mocks.NewMockAuditor(mockCtl), locales)
+
+		repo.EXPECT().GetTeam(ctx, int32(1)).Return(&domain.Team{ID: 1}, nil)
+		repo.EXPECT().GetMember(ctx, int32(2), int32(1)).Return(&domain.TeamMember{UserID: 2, TeamID: 1}, nil)
+
+		member, err := serv.GetMember(ctx, 2, 1)
+		require.NoError(t, err)
+		require.Equal(t, int32(2), member.UserID)
+	})
+
+	t.Run("should report missing team", func(t *testing.T) {
+		mockCtl := gomock.NewController(t)
+		repo := mocks.NewMockTeamRepository(mockCtl)
+		serv := service.NewTeamService(repo, mocks.NewMockUserRepository(mockCtl), mocks.NewMockFileRepository(mockCtl), mocks.NewMockTxManager(mockCtl), mocks.NewMockAuditor(mockCtl), locales)
+
+		repo.EXPECT().GetTeam(ctx, int32(1)).Return(nil, repository.ErrObjectNotFound)
+
+		_, err := serv.GetMember(ctx, 2, 1)
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.NotFoundType, appErr.Type)
+		require.Equal(t, "team_id", appErr.Field)
+	})
+}
+
+func TestTeamService_AddMember(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockTeamRepository(mockCtl)
+	userRepo := mocks.NewMockUserRepository(mockCtl)
+	serv := service.NewTeamService(repo, userRepo, mocks.NewMockFileRepository(mockCtl), mocks.NewMockTxManager(mockCtl), mocks.NewMockAuditor(mockCtl), locales)
+
+	t.Run("should report member added concurrently", func(t *testing.T) {
+		member := &domain.TeamMember{UserID: 2, TeamID: 1, Role: domain.UserRoleUser, Position: domain.UserPositionFrontend}
+		userRepo.EXPECT().GetActiveUser(ctx, int32(2)).Return(&domain.User{ID: 2}, nil)
+		repo.EXPECT().GetTeam(ctx, int32(1)).Return(&domain.Team{ID: 1}, nil)
+		repo.EXPECT().GetMember(ctx, int32(2), int32(1)).Return(nil, repository.ErrObjectNotFound)
+		repo.EXPECT().AddMember(ctx, member).Return(repository.ErrDuplicate)
+
+		_, err := serv.AddMember(ctx, member)
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.DuplicateType, appErr.Type)
+		require.Equal(t, "user_id", appErr.Field)
+	})
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+//go:generate mockgen -source=technology.go -destination=./mocks/technology.go -package=mocks
+type TechnologyRepository interface {
+	GetTechnologies(ctx context.Context, filter *domain.TechnologyFilter) ([]domain.Technology, error)
+	GetTechnology(ctx context.Context, id int32) (*domain.Technology, error)
+	FindTechnologies(ctx context.Context, names []string) ([]domain.Technology, error)
+	CreateTechnology(ctx context.Context, t *domain.Technology) (int32, error)
+	UpdateTechnology(ctx context.Context, t *domain.Technology) error
+	DeleteTechnology(ctx context.Context, id int32) error
+	SetProjectTechnologies(ctx context.Context, projectID int32, technologyIDs []int32) error
+	GetTechnologyUsage(ctx context.Context, limit int, activeOnly bool) ([]domain.TechnologyUsage, error)
+}
+
+// TechnologyService manages the catalog of technologies used by projects.
+type TechnologyService struct {
+	repo        TechnologyRepository
+	projectRepo ProjectRepository
+	audit       Auditor
+}
+
+func NewTechnologyService(repo TechnologyRepository, projectRepo ProjectRepository, audit Auditor) *TechnologyService {
+	return &TechnologyService{repo, projectRepo, audit}
+}
+
+func (s *TechnologyService) GetTechnologies(ctx context.Context, filter *domain.TechnologyFilter) ([]domain.Technology, error) {
+	technologies, err := s.repo.GetTechnologies(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("getting technologies: %w", err)
+	}
+
+	return technologies, nil
+}
+
+func (s *TechnologyService) GetTechnology(ctx context.Context, id int32) (*domain.Technology, error) {
+	technology, err := s.repo.GetTechnology(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("technology_id")
+		}
+		return nil, fmt.Errorf("getting technology %d: %w", id, err)
+	}
+
+	return technology, nil
+}
+
+func (s *TechnologyService) CreateTechnology(ctx context.Context, technology *domain.Technology) (*domain.Technology, error) {
+	if err := technology.Validate(); err != nil {
+		return nil, fmt.Errorf("validating technology: %w", err)
+	}
+
+	if err := s.checkNames(ctx, technology); err != nil {
+		return nil, err
+	}
+
+	id, err := s.repo.CreateTechnology(ctx, technology)
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, apperr.NewDuplicate("Name or alias is already used by another technology.", "name")
+		}
+		return nil, fmt.Errorf("creating technology: %w", err)
+	}
+
+	created, err := s.GetTechnology(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, domain.AuditActionCreate, domain.AuditEntityTechnology, id, nil, created)
+
+	return created, nil
+}
+
+// UpdateTechnology changes the catalog entry, a new name is applied to all projects using the technology.
+func (s *TechnologyService) UpdateTechnology(ctx context.Context, technology *domain.Technology) (*domain.Technology, error) {
+	if err := technology.Validate(); err != nil {
+		return nil, fmt.Errorf("validating technology: %w", err)
+	}
+
+	old, err := s.GetTechnology(ctx, technology.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkNames(ctx, technology); err != nil {
+		return nil, err
+	}
+
+	err = s.repo.UpdateTechnology(ctx, technology)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewNotFound("technology_id")
+		}
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, apperr.NewDuplicate("Name or alias is already used by another technology.", "name")
+		}
+		return nil, fmt.Errorf("updating technology %d: %w", technology.ID, err)
+	}
+
+	updated, err := s.GetTechnology(ctx, technology.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityTechnology, technology.ID, old, updated)
+
+	return updated, nil
+}
+
+// DeleteTechnology removes the technology from the catalog and from all projects using it.
+func (s *TechnologyService) DeleteTechnology(ctx context.Context, id int32) error {
+	technology, err := s.GetTechnology(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteTechnology(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return apperr.NewNotFound("technology_id")
+		}
+		return fmt.Errorf("deleting technology %d: %w", id, err)
+	}
+
+	s.audit.Record(ctx, domain.AuditActionDelete, domain.AuditEntityTechnology, id, technology, nil)
+
+	return nil
+}
+
+// GetTechnologyProjects returns the requested page of projects using the technology and their total number.
+func (s *TechnologyService) GetTechnologyProjects(ctx context.Context, id int32, params *domain.ListParams) ([]domain.Project, int, error) {
+	technology, err := s.GetTechnology(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	projects, total, err := s.projectRepo.GetProjects(ctx, params, &domain.ProjectFilter{
+		TechnologyIDs: []int32{technology.ID},
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting projects using technology %d: %w", id, err)
+	}
+
+	return projects, total, nil
+}
+
+// GetPopularTechnologies returns technologies used by the most projects, projects in the trash are not counted.
+// Zero limit returns all used technologies.
+func (s *TechnologyService) GetPopularTechnologies(ctx context.Context, limit int) ([]domain.TechnologyUsage, error) {
+	usage, err := s.repo.GetTechnologyUsage(ctx, limit, false)
+	if err != nil {
+		return nil, fmt.Errorf("getting technology usage: %w", err)
+	}
+
+	return usage, nil
+}
+
+// checkNames reports a duplicate if the name or an alias of the technology belongs to another catalog entry.
+func (s *TechnologyService) checkNames(ctx context.Context, technology *domain.Technology) error {
+	existing, err := s.repo.FindTechnologies(ctx, technology.Names())
+	if err != nil {
+		return fmt.Errorf("finding technologies: %w", err)
+	}
+
+	for _, t := range existing {
+		if t.ID != technology.ID {
+			return apperr.NewDuplicate(fmt.Sprintf("Name or alias is already used by technology %q.", t.Name), "name")
+		}
+	}
+
+	return nil
+}
+
+// resolveTechnologies maps technology names and aliases to catalog entries, case is ignored.
+// Returns the matched technologies without repeats and the names missing from the catalog.
+func resolveTechnologies(ctx context.Context, repo TechnologyRepository, names []string) ([]domain.Technology, []string, error) {
+	if len(names) == 0 {
+		return nil, nil, nil
+	}
+
+	found, err := repo.FindTechnologies(ctx, names)
+	if err != nil {
+		return nil, nil, fmt.Errorf("finding technologies: %w", err)
+	}
+
+	known := make(map[string]bool)
+	for _, t := range found {
+		for _, name := range t.Names() {
+			known[strings.ToLower(name)] = true
+		}
+	}
+
+	var unknown []string
+	for _, name := range names {
+		if !known[strings.ToLower(strings.TrimSpace(name))] {
+			unknown = append(unknown, name)
+		}
+	}
+
+	return found, unknown, nil
+}
+
+func technologyNames(technologies []domain.Technology) []string {
+	names := make([]string, 0, len(technologies))
+	for _, t := range technologies {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+func technologyIDs(technologies []domain.Technology) []int32 {
+	ids := make([]int32, 0, len(technologies))
+	for _, t := range technologies {
+		ids = append(ids, t.ID)
+	}
+	return ids
+}
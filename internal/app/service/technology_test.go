@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+)
+
+var (
+	golang   = domain.Technology{ID: 1, Name: "Go", Aliases: []string{"golang"}, Category: domain.TechnologyCategoryLanguage}
+	postgres = domain.Technology{ID: 2, Name: "PostgreSQL", Aliases: []string{"postgres", "pg"}, Category: domain.TechnologyCategoryDatabase}
+)
+
+func TestTechnologyService_CreateTechnology(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mockCtl := gomock.NewController(t)
+	repo := mocks.NewMockTechnologyRepository(mockCtl)
+	auditor := mocks.NewMockAuditor(mockCtl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	serv := service.NewTechnologyService(repo, mocks.NewMockProjectRepository(mockCtl), auditor)
+
+	t.Run("should create technology", func(t *testing.T) {
+		technology := &domain.Technology{Name: "Rust", Aliases: []string{"rs"}, Category: domain.TechnologyCategoryLanguage}
+		repo.EXPECT().FindTechnologies(ctx, []string{"Rust", "rs"}).Return(nil, nil)
+		repo.EXPECT().CreateTechnology(ctx, technology).Return(int32(3), nil)
+		repo.EXPECT().GetTechnology(ctx, int32(3)).Return(&domain.Technology{
+			ID: 3, Name: "Rust", Aliases: []string{"rs"}, Category: domain.TechnologyCategoryLanguage,
+		}, nil)
+
+		created, err := serv.CreateTechnology(ctx, technology)
+		require.NoError(t, err)
+		require.Equal(t, int32(3), created.ID)
+	})
+
+	t.Run("should reject alias of another technology", func(t *testing.T) {
+		technology := &domain.Technology{Name: "Postgres SQL", Aliases: []string{"PG"}, Category: domain.TechnologyCategoryDatabase}
+		repo.EXPECT().FindTechnologies(ctx, []string{"Postgres SQL", "PG"}).Return([]domain.Technology{postgres}, nil)
+
+		_, err := serv.CreateTechnology(ctx, technology)
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.DuplicateType, appErr.Type)
+	})
+
+	t.Run("should report name taken concurrently", func(t *testing.T) {
+		technology := &domain.Technology{Name: "Rust", Aliases: []string{"rs"}, Category: domain.TechnologyCategoryLanguage}
+		repo.EXPECT().FindTechnologies(ctx, []string{"Rust", "rs"}).Return(nil, nil)
+		repo.EXPECT().CreateTechnology(ctx, technology).Return(int32(0), repository.ErrDuplicate)
+
+		_, err := serv.CreateTechnology(ctx, technology)
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.DuplicateType, appErr.Type)
+	})
+
+	t.Run("should reject alias repeating the name", func(t *testing.T) {
+		_, err := serv.CreateTechnology(ctx, &domain.Technology{Name: "Rust", Aliases: []string{"rust"}, Category: domain.TechnologyCategoryLanguage})
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+}
@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/pkg/locale"
+)
+
+func TestTeamService_SetTeamTranslation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should save translation", func(t *testing.T) {
+		serv, repo := team(t)
+
+		translation := &domain.Translation{Locale: "en", Title: "Web Studio"}
+		repo.EXPECT().GetTeam(ctx, int32(1)).Return(&domain.Team{ID: 1}, nil)
+		repo.EXPECT().SaveTeamTranslation(ctx, int32(1), translation).Return(nil)
+		repo.EXPECT().GetTeamTranslationsByLocale(ctx, []int32{1}, "en").
+			Return(map[int32]domain.Translation{1: *translation}, nil)
+
+		saved, err := serv.SetTeamTranslation(ctx, 1, translation)
+		require.NoError(t, err)
+		require.Equal(t, translation, saved)
+	})
+
+	t.Run("should reject default and unsupported locales", func(t *testing.T) {
+		serv, _ := team(t)
+
+		for _, loc := range []string{"ru", "de"} {
+			_, err := serv.SetTeamTranslation(ctx, 1, &domain.Translation{Locale: loc, Title: "Web Studio"})
+
+			var appErr *apperr.Error
+			require.ErrorAs(t, err, &appErr)
+			require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+		}
+	})
+
+	t.Run("should not find team", func(t *testing.T) {
+		serv, repo := team(t)
+
+		repo.EXPECT().GetTeam(ctx, int32(1)).Return(nil, repository.ErrObjectNotFound)
+
+		_, err := serv.SetTeamTranslation(ctx, 1, &domain.Translation{Locale: "en", Title: "Web Studio"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.NotFoundType, appErr.Type)
+	})
+}
+
+func TestPublicService_GetTeams_Translation(t *testing.T) {
+	t.Parallel()
+
+	params := &domain.ListParams{Limit: 50}
+	disabled := false
+
+	t.Run("should translate teams to the requested locale", func(t *testing.T) {
+		serv, m := public(t)
+		ctx := locale.NewContext(context.Background(), "en")
+
+		m.teamRepo.EXPECT().GetTeams(ctx, params, &domain.TeamFilter{Disabled: &disabled}).Return([]domain.Team{
+			{ID: 1, Slug: "studio", Title: "Студия", Description: "Описание"},
+			{ID: 2, Slug: "lab", Title: "Лаборатория"},
+		}, 2, nil)
+		m.teamRepo.EXPECT().GetTeamTranslationsByLocale(ctx, []int32{1, 2}, "en").Return(map[int32]domain.Translation{
+			1: {Locale: "en", Title: "Studio"},
+		}, nil)
+
+		teams, _, err := serv.GetTeams(ctx, params)
+		require.NoError(t, err)
+		require.Equal(t, []domain.PublicTeam{
+			{Slug: "studio", Title: "Studio", Description: "Описание"},
+			{Slug: "lab", Title: "Лаборатория"},
+		}, teams)
+	})
+
+	t.Run("should not look up translations for the default locale", func(t *testing.T) {
+		serv, m := public(t)
+		ctx := locale.NewContext(context.Background(), "ru")
+
+		m.teamRepo.EXPECT().GetTeams(ctx, params, &domain.TeamFilter{Disabled: &disabled}).
+			Return([]domain.Team{{ID: 1, Slug: "studio", Title: "Студия"}}, 1, nil)
+
+		teams, _, err := serv.GetTeams(ctx, params)
+		require.NoError(t, err)
+		require.Equal(t, []domain.PublicTeam{{Slug: "studio", Title: "Студия"}}, teams)
+	})
+}
@@ -0,0 +1,479 @@
+package service
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/pkg/auth"
+	"web-studio-backend/internal/pkg/totp"
+	"web-studio-backend/internal/pkg/wcrypto"
+)
+
+//go:generate mockgen -source=two_factor.go -destination=./mocks/two_factor.go -package=mocks
+type TwoFactorRepository interface {
+	GetTwoFactor(ctx context.Context, userID int32) (*domain.TwoFactor, error)
+	SaveTwoFactorSecret(ctx context.Context, userID int32, secret string) error
+	EnableTwoFactor(ctx context.Context, userID int32) error
+	UseTwoFactorStep(ctx context.Context, userID int32, step int64) error
+	AddTwoFactorCodeAttempt(ctx context.Context, userID int32, windowStart time.Time) (int, error)
+	ResetTwoFactorCodeAttempts(ctx context.Context, userID int32) error
+	DeleteTwoFactor(ctx context.Context, userID int32) error
+	ReplaceRecoveryCodes(ctx context.Context, userID int32, hashes []string) error
+	UseRecoveryCode(ctx context.Context, userID int32, hash string) error
+	CountRecoveryCodes(ctx context.Context, userID int32) (int, error)
+	CreateTwoFactorChallenge(ctx context.Context, challenge *domain.TwoFactorChallenge) error
+	GetTwoFactorChallenge(ctx context.Context, hash string) (*domain.TwoFactorChallenge, error)
+	AddTwoFactorChallengeAttempt(ctx context.Context, hash string) (int, error)
+	DeleteTwoFactorChallenge(ctx context.Context, hash string) error
+}
+
+const (
+	twoFactorChallengeTTL         = 5 * time.Minute
+	twoFactorChallengeMaxAttempts = 5
+	twoFactorCodeLockout          = 15 * time.Minute // Codes are not checked for it after too many attempts of a signed in user
+	recoveryCodesCount            = 10
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type TwoFactorPolicy struct {
+	Issuer           string // Shown in authenticator apps
+	RequireForAdmins bool   // Users with role Admin and higher must enable two-factor authentication
+}
+
+// TwoFactorService manages TOTP two-factor authentication and asks for the second factor on sign in.
+type TwoFactorService struct {
+	repo     TwoFactorRepository
+	sessions SessionStore
+	limiter  *SignInLimiter
+	tx       TxManager
+	block    cipher.Block // Encrypts TOTP secrets
+	policy   TwoFactorPolicy
+}
+
+func NewTwoFactorService(repo TwoFactorRepository, sessions SessionStore, limiter *SignInLimiter, tx TxManager, block cipher.Block, policy TwoFactorPolicy) *TwoFactorService {
+	return &TwoFactorService{repo, sessions, limiter, tx, block, policy}
+}
+
+// GetStatus returns two-factor authentication status of the authorized user.
+func (s *TwoFactorService) GetStatus(ctx context.Context) (*domain.TwoFactorStatus, error) {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperr.NewUnauthorized("Authorization required.")
+	}
+
+	tf, err := s.getTwoFactor(ctx, ac.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &domain.TwoFactorStatus{
+		Enabled:  tf.Enabled(),
+		Required: s.required(ac.Role),
+	}
+
+	if status.Enabled {
+		status.RecoveryCodesLeft, err = s.repo.CountRecoveryCodes(ctx, ac.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("counting user %d recovery codes: %w", ac.UserID, err)
+		}
+	}
+
+	return status, nil
+}
+
+// Setup starts enrollment of the authorized user. Two-factor authentication is enabled after the code is confirmed.
+func (s *TwoFactorService) Setup(ctx context.Context) (*domain.TwoFactorSetup, error) {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperr.NewUnauthorized("Authorization required.")
+	}
+
+	tf, err := s.getTwoFactor(ctx, ac.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if tf.Enabled() {
+		return nil, apperr.NewInvalidRequest("Two-factor authentication is already enabled.", "")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := wcrypto.EncodeToBase64([]byte(secret), s.block)
+	if err != nil {
+		return nil, fmt.Errorf("encrypting totp secret: %w", err)
+	}
+
+	err = s.repo.SaveTwoFactorSecret(ctx, ac.UserID, encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("saving user %d totp secret: %w", ac.UserID, err)
+	}
+
+	account := ac.Email
+	if account == "" {
+		account = ac.Username
+	}
+
+	return &domain.TwoFactorSetup{
+		Secret: secret,
+		URI:    totp.URI(s.policy.Issuer, account, secret),
+	}, nil
+}
+
+// Enable finishes enrollment of the authorized user and returns recovery codes.
+func (s *TwoFactorService) Enable(ctx context.Context, req *domain.TwoFactorCodeRequest) (*domain.RecoveryCodes, error) {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperr.NewUnauthorized("Authorization required.")
+	}
+
+	tf, err := s.getTwoFactor(ctx, ac.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil {
+		return nil, apperr.NewInvalidRequest("Two-factor authentication setup is not started.", "")
+	}
+	if tf.Enabled() {
+		return nil, apperr.NewInvalidRequest("Two-factor authentication is already enabled.", "")
+	}
+
+	var codes *domain.RecoveryCodes
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		valid, err := s.verifyTOTP(ctx, tf, req.Code)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return apperr.NewInvalidRequest("Invalid code.", "code")
+		}
+
+		err = s.repo.EnableTwoFactor(ctx, ac.UserID)
+		if err != nil {
+			return fmt.Errorf("enabling user %d totp: %w", ac.UserID, err)
+		}
+
+		codes, err = s.replaceRecoveryCodes(ctx, ac.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Disable turns off two-factor authentication of the authorized user, the code must be confirmed.
+func (s *TwoFactorService) Disable(ctx context.Context, req *domain.TwoFactorCodeRequest) error {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return apperr.NewUnauthorized("Authorization required.")
+	}
+
+	if s.required(ac.Role) {
+		return apperr.NewForbidden("Two-factor authentication is required for your role.")
+	}
+
+	tf, err := s.getTwoFactor(ctx, ac.UserID)
+	if err != nil {
+		return err
+	}
+	if !tf.Enabled() {
+		return apperr.NewInvalidRequest("Two-factor authentication is not enabled.", "")
+	}
+
+	err = s.confirmCode(ctx, tf, req.Code, s.verifyCode)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteTwoFactor(ctx, ac.UserID)
+	if err != nil {
+		return fmt.Errorf("deleting user %d totp: %w", ac.UserID, err)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces recovery codes of the authorized user, the TOTP code must be confirmed.
+func (s *TwoFactorService) RegenerateRecoveryCodes(ctx context.Context, req *domain.TwoFactorCodeRequest) (*domain.RecoveryCodes, error) {
+	ac, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperr.NewUnauthorized("Authorization required.")
+	}
+
+	tf, err := s.getTwoFactor(ctx, ac.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !tf.Enabled() {
+		return nil, apperr.NewInvalidRequest("Two-factor authentication is not enabled.", "")
+	}
+
+	err = s.confirmCode(ctx, tf, req.Code, s.verifyTOTP)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.replaceRecoveryCodes(ctx, ac.UserID)
+}
+
+// SetupRequired reports whether the policy requires the user to enable two-factor authentication
+// and the user hasn't done it yet.
+func (s *TwoFactorService) SetupRequired(ctx context.Context, user *domain.User) (bool, error) {
+	if !s.required(user.Role) {
+		return false, nil
+	}
+
+	tf, err := s.getTwoFactor(ctx, user.ID)
+	if err != nil {
+		return false, err
+	}
+
+	return !tf.Enabled(), nil
+}
+
+// CompleteSignIn checks the second factor of the sign in and starts user session.
+// Invalid codes are counted by the sign in limiter as well, so guessing across challenges locks the account.
+func (s *TwoFactorService) CompleteSignIn(ctx context.Context, req *domain.TwoFactorSignInRequest) (*domain.SignInResponse, error) {
+	hash := hashToken(req.Token)
+
+	challenge, err := s.repo.GetTwoFactorChallenge(ctx, hash)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, apperr.NewUnauthorized("Sign in is expired, sign in again.")
+		}
+		return nil, fmt.Errorf("getting two factor challenge: %w", err)
+	}
+
+	err = s.limiter.reserveIP(ctx, req.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	// Code is not checked while the account is locked
+	failures, err := s.limiter.reserveUser(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := s.getTwoFactor(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	valid := false
+	if tf.Enabled() {
+		valid, err = s.verifyCode(ctx, tf, req.Code)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !valid {
+		s.limiter.fail(ctx, challenge.UserID, req.IP, failures)
+
+		attempts, err := s.repo.AddTwoFactorChallengeAttempt(ctx, hash)
+		if err != nil && !errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, fmt.Errorf("counting two factor attempt: %w", err)
+		}
+		if attempts >= twoFactorChallengeMaxAttempts {
+			err = s.repo.DeleteTwoFactorChallenge(ctx, hash)
+			if err != nil {
+				return nil, fmt.Errorf("deleting two factor challenge: %w", err)
+			}
+			return nil, apperr.NewUnauthorized("Too many invalid codes, sign in again.")
+		}
+		return nil, apperr.NewInvalidRequest("Invalid code.", "code")
+	}
+
+	err = s.repo.DeleteTwoFactorChallenge(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("deleting two factor challenge: %w", err)
+	}
+
+	err = s.limiter.releaseIP(ctx, req.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.finishSignIn(ctx, challenge.UserID, req.IP, req.UserAgent)
+}
+
+// startSignIn starts session of the user authenticated by the first factor,
+// or asks for the second one if the user has enabled it.
+func (s *TwoFactorService) startSignIn(ctx context.Context, userID int32, ip, userAgent string) (*domain.SignInResponse, error) {
+	tf, err := s.getTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !tf.Enabled() {
+		return s.finishSignIn(ctx, userID, ip, userAgent)
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateTwoFactorChallenge(ctx, &domain.TwoFactorChallenge{
+		Hash:      hashToken(token),
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(twoFactorChallengeTTL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("saving two factor challenge: %w", err)
+	}
+
+	return &domain.SignInResponse{
+		UserID:            userID,
+		TwoFactorRequired: true,
+		TwoFactorToken:    token,
+	}, nil
+}
+
+// finishSignIn starts session of the fully authenticated user and forgets failed attempts to the account.
+func (s *TwoFactorService) finishSignIn(ctx context.Context, userID int32, ip, userAgent string) (*domain.SignInResponse, error) {
+	res, err := startSession(ctx, s.sessions, userID, ip, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.limiter.succeed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *TwoFactorService) required(role domain.UserRole) bool {
+	return s.policy.RequireForAdmins && role.AtLeast(domain.UserRoleAdmin)
+}
+
+// getTwoFactor returns TOTP settings of the user or nil if the user has never started enrollment.
+func (s *TwoFactorService) getTwoFactor(ctx context.Context, userID int32) (*domain.TwoFactor, error) {
+	tf, err := s.repo.GetTwoFactor(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting user %d totp: %w", userID, err)
+	}
+
+	return tf, nil
+}
+
+// confirmCode checks the code of a signed in user. Like sign in, only twoFactorChallengeMaxAttempts codes can be tried,
+// then codes are not checked until twoFactorCodeLockout passes since the last attempt.
+// The attempt is counted before checking, so concurrent requests can't exceed the limit.
+func (s *TwoFactorService) confirmCode(ctx context.Context, tf *domain.TwoFactor, code string,
+	verify func(ctx context.Context, tf *domain.TwoFactor, code string) (bool, error),
+) error {
+	attempts, err := s.repo.AddTwoFactorCodeAttempt(ctx, tf.UserID, time.Now().Add(-twoFactorCodeLockout))
+	if err != nil {
+		return fmt.Errorf("counting user %d code attempt: %w", tf.UserID, err)
+	}
+	if attempts > twoFactorChallengeMaxAttempts {
+		return apperr.NewTooManyRequests("Too many invalid codes, try again later.")
+	}
+
+	valid, err := verify(ctx, tf, code)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return apperr.NewInvalidRequest("Invalid code.", "code")
+	}
+
+	err = s.repo.ResetTwoFactorCodeAttempts(ctx, tf.UserID)
+	if err != nil {
+		return fmt.Errorf("resetting user %d code attempts: %w", tf.UserID, err)
+	}
+
+	return nil
+}
+
+// verifyCode accepts either TOTP or unused recovery code.
+func (s *TwoFactorService) verifyCode(ctx context.Context, tf *domain.TwoFactor, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		return s.verifyTOTP(ctx, tf, code)
+	}
+
+	err := s.repo.UseRecoveryCode(ctx, tf.UserID, hashToken(normalizeRecoveryCode(code)))
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("using recovery code: %w", err)
+	}
+
+	return true, nil
+}
+
+// verifyTOTP checks the code, each code can be used once.
+func (s *TwoFactorService) verifyTOTP(ctx context.Context, tf *domain.TwoFactor, code string) (bool, error) {
+	secret, err := wcrypto.DecodeFromBase64(tf.Secret, s.block)
+	if err != nil {
+		return false, fmt.Errorf("decrypting totp secret: %w", err)
+	}
+
+	step, ok := totp.Validate(string(secret), strings.TrimSpace(code), time.Now())
+	if !ok || step <= tf.LastStep {
+		return false, nil
+	}
+
+	err = s.repo.UseTwoFactorStep(ctx, tf.UserID, step)
+	if err != nil {
+		if errors.Is(err, repository.ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("saving totp step: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *TwoFactorService) replaceRecoveryCodes(ctx context.Context, userID int32) (*domain.RecoveryCodes, error) {
+	codes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+
+	for i := 0; i < recoveryCodesCount; i++ {
+		b := make([]byte, 10)
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, fmt.Errorf("generating recovery code: %w", err)
+		}
+
+		// 16 characters split in groups for readability: abcd-efgh-ijkl-mnop
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+		code = code[:4] + "-" + code[4:8] + "-" + code[8:12] + "-" + code[12:]
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashToken(normalizeRecoveryCode(code)))
+	}
+
+	err := s.repo.ReplaceRecoveryCodes(ctx, userID, hashes)
+	if err != nil {
+		return nil, fmt.Errorf("saving user %d recovery codes: %w", userID, err)
+	}
+
+	return &domain.RecoveryCodes{Codes: codes}, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
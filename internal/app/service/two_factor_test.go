@@ -0,0 +1,399 @@
+package service_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/app/service"
+	"web-studio-backend/internal/app/service/mocks"
+	"web-studio-backend/internal/pkg/auth"
+	"web-studio-backend/internal/pkg/totp"
+	"web-studio-backend/internal/pkg/wcrypto"
+)
+
+type twoFactorMocks struct {
+	repo      *mocks.MockTwoFactorRepository
+	sessions  *mocks.MockSessionStore
+	throttles *throttleStore
+	audit     *mocks.MockSystemAuditor
+}
+
+func twoFactorService(t *testing.T, policy service.TwoFactorPolicy) (*service.TwoFactorService, twoFactorMocks) {
+	t.Helper()
+
+	mockCtl := gomock.NewController(t)
+
+	m := twoFactorMocks{
+		repo:      mocks.NewMockTwoFactorRepository(mockCtl),
+		sessions:  mocks.NewMockSessionStore(mockCtl),
+		throttles: &throttleStore{throttles: make(map[string]*domain.SignInThrottle)},
+		audit:     mocks.NewMockSystemAuditor(mockCtl),
+	}
+
+	tx := mocks.NewMockTxManager(mockCtl)
+	tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(withinTx).AnyTimes()
+	limiter := service.NewSignInLimiter(m.throttles, tx, m.audit, service.SignInPolicy{
+		FreeAttempts:    10,
+		IPFreeAttempts:  10,
+		BackoffBase:     time.Second,
+		LockoutAttempts: 4,
+		LockoutDuration: time.Hour,
+		Window:          time.Hour,
+	})
+
+	return service.NewTwoFactorService(m.repo, m.sessions, limiter, tx, testBlock(t), policy), m
+}
+
+// throttleStore keeps sign in throttles in memory, so tests can follow failures across several calls.
+type throttleStore struct {
+	mu        sync.Mutex
+	throttles map[string]*domain.SignInThrottle
+}
+
+func (s *throttleStore) get(kind domain.SignInThrottleKind, subject string) *domain.SignInThrottle {
+	t, ok := s.throttles[string(kind)+":"+subject]
+	if !ok {
+		t = &domain.SignInThrottle{Kind: kind, Subject: subject}
+		s.throttles[string(kind)+":"+subject] = t
+	}
+	return t
+}
+
+func (s *throttleStore) GetSignInThrottle(_ context.Context, kind domain.SignInThrottleKind, subject string) (*domain.SignInThrottle, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := *s.get(kind, subject)
+	return &t, nil
+}
+
+func (s *throttleStore) AddSignInFailure(_ context.Context, kind domain.SignInThrottleKind, subject string, _ time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.get(kind, subject)
+	if t.Blocked(time.Now()) {
+		return 0, repository.ErrObjectNotFound
+	}
+	t.Failures++
+	return t.Failures, nil
+}
+
+func (s *throttleStore) RemoveSignInFailure(_ context.Context, kind domain.SignInThrottleKind, subject string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.get(kind, subject)
+	t.Failures = max(t.Failures-1, 0)
+	return nil
+}
+
+func (s *throttleStore) BlockSignIn(_ context.Context, kind domain.SignInThrottleKind, subject string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.get(kind, subject).BlockedUntil = &until
+	return nil
+}
+
+func (s *throttleStore) DeleteSignInThrottle(_ context.Context, kind domain.SignInThrottleKind, subject string, _ time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.throttles, string(kind)+":"+subject)
+	return nil
+}
+
+func (s *throttleStore) failures(kind domain.SignInThrottleKind, subject string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.get(kind, subject).Failures
+}
+
+func testBlock(t *testing.T) cipher.Block {
+	t.Helper()
+
+	block, err := aes.NewCipher([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	return block
+}
+
+// enabledTwoFactor returns enabled TOTP settings with the secret encrypted by the test block.
+func enabledTwoFactor(t *testing.T, userID int32, secret string) *domain.TwoFactor {
+	t.Helper()
+
+	encrypted, err := wcrypto.EncodeToBase64([]byte(secret), testBlock(t))
+	require.NoError(t, err)
+
+	enabledAt := time.Now()
+	return &domain.TwoFactor{UserID: userID, Secret: encrypted, EnabledAt: &enabledAt}
+}
+
+func TestTwoFactorService_SetupAndEnable(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 1, Email: "user@mail.com"})
+	serv, m := twoFactorService(t, service.TwoFactorPolicy{Issuer: "Web Studio"})
+
+	var saved string
+	m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(nil, repository.ErrObjectNotFound)
+	m.repo.EXPECT().SaveTwoFactorSecret(ctx, int32(1), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int32, secret string) error {
+			saved = secret
+			return nil
+		})
+
+	setup, err := serv.Setup(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, setup.Secret, saved)
+	require.Contains(t, setup.URI, "otpauth://totp/")
+
+	t.Run("should fail on invalid code", func(t *testing.T) {
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(&domain.TwoFactor{UserID: 1, Secret: saved}, nil)
+
+		_, err := serv.Enable(ctx, &domain.TwoFactorCodeRequest{Code: "000000x"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+
+	t.Run("should enable and return recovery codes", func(t *testing.T) {
+		code, err := totp.Code(setup.Secret, time.Now())
+		require.NoError(t, err)
+
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(&domain.TwoFactor{UserID: 1, Secret: saved}, nil)
+		m.repo.EXPECT().UseTwoFactorStep(ctx, int32(1), gomock.Any()).Return(nil)
+		m.repo.EXPECT().EnableTwoFactor(ctx, int32(1)).Return(nil)
+		m.repo.EXPECT().ReplaceRecoveryCodes(ctx, int32(1), gomock.Len(10)).Return(nil)
+
+		codes, err := serv.Enable(ctx, &domain.TwoFactorCodeRequest{Code: code})
+		require.NoError(t, err)
+		require.Len(t, codes.Codes, 10)
+		require.Len(t, codes.Codes[0], 19)
+	})
+}
+
+func TestTwoFactorService_CompleteSignIn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	challenge := &domain.TwoFactorChallenge{Hash: hashToken("token"), UserID: 1, ExpiresAt: time.Now().Add(time.Minute)}
+	req := func(code string) *domain.TwoFactorSignInRequest {
+		return &domain.TwoFactorSignInRequest{Token: "token", Code: code, IP: "127.0.0.1"}
+	}
+
+	t.Run("should start session with valid code", func(t *testing.T) {
+		serv, m := twoFactorService(t, service.TwoFactorPolicy{})
+
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+
+		m.repo.EXPECT().GetTwoFactorChallenge(ctx, challenge.Hash).Return(challenge, nil)
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(enabledTwoFactor(t, 1, secret), nil)
+		m.repo.EXPECT().UseTwoFactorStep(ctx, int32(1), totp.Step(time.Now())).Return(nil)
+		m.repo.EXPECT().DeleteTwoFactorChallenge(ctx, challenge.Hash).Return(nil)
+		m.sessions.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		res, err := serv.CompleteSignIn(ctx, req(code))
+		require.NoError(t, err)
+		require.NotEmpty(t, res.SessionID)
+		require.Equal(t, int32(1), res.UserID)
+		require.Zero(t, m.throttles.failures(domain.SignInThrottleUser, "1"))
+		require.Zero(t, m.throttles.failures(domain.SignInThrottleIP, "127.0.0.1"))
+	})
+
+	t.Run("should start session with recovery code", func(t *testing.T) {
+		serv, m := twoFactorService(t, service.TwoFactorPolicy{})
+
+		m.repo.EXPECT().GetTwoFactorChallenge(ctx, challenge.Hash).Return(challenge, nil)
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(enabledTwoFactor(t, 1, secret), nil)
+		m.repo.EXPECT().UseRecoveryCode(ctx, int32(1), hashToken("abcdefghijklmnop")).Return(nil)
+		m.repo.EXPECT().DeleteTwoFactorChallenge(ctx, challenge.Hash).Return(nil)
+		m.sessions.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		res, err := serv.CompleteSignIn(ctx, req("ABCD-efgh-ijkl-mnop"))
+		require.NoError(t, err)
+		require.NotEmpty(t, res.SessionID)
+	})
+
+	t.Run("should count invalid code", func(t *testing.T) {
+		serv, m := twoFactorService(t, service.TwoFactorPolicy{})
+
+		m.repo.EXPECT().GetTwoFactorChallenge(ctx, challenge.Hash).Return(challenge, nil)
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(enabledTwoFactor(t, 1, secret), nil)
+		m.repo.EXPECT().UseRecoveryCode(ctx, int32(1), gomock.Any()).Return(repository.ErrObjectNotFound)
+		m.repo.EXPECT().AddTwoFactorChallengeAttempt(ctx, challenge.Hash).Return(1, nil)
+
+		_, err := serv.CompleteSignIn(ctx, req("wrong"))
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+
+	t.Run("should drop challenge after too many attempts", func(t *testing.T) {
+		serv, m := twoFactorService(t, service.TwoFactorPolicy{})
+
+		m.repo.EXPECT().GetTwoFactorChallenge(ctx, challenge.Hash).Return(challenge, nil)
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(enabledTwoFactor(t, 1, secret), nil)
+		m.repo.EXPECT().UseRecoveryCode(ctx, int32(1), gomock.Any()).Return(repository.ErrObjectNotFound)
+		m.repo.EXPECT().AddTwoFactorChallengeAttempt(ctx, challenge.Hash).Return(5, nil)
+		m.repo.EXPECT().DeleteTwoFactorChallenge(ctx, challenge.Hash).Return(nil)
+
+		_, err := serv.CompleteSignIn(ctx, req("wrong"))
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.UnauthorizedType, appErr.Type)
+	})
+
+	t.Run("should lock account after invalid codes across challenges", func(t *testing.T) {
+		serv, m := twoFactorService(t, service.TwoFactorPolicy{})
+
+		// Two challenges with two invalid codes each reach the lockout, although none of them is dropped
+		other := &domain.TwoFactorChallenge{Hash: hashToken("other"), UserID: 1, ExpiresAt: time.Now().Add(time.Minute)}
+		m.repo.EXPECT().GetTwoFactorChallenge(ctx, challenge.Hash).Return(challenge, nil).Times(2)
+		m.repo.EXPECT().GetTwoFactorChallenge(ctx, other.Hash).Return(other, nil).Times(3)
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(enabledTwoFactor(t, 1, secret), nil).Times(4)
+		m.repo.EXPECT().UseRecoveryCode(ctx, int32(1), gomock.Any()).Return(repository.ErrObjectNotFound).Times(4)
+		m.repo.EXPECT().AddTwoFactorChallengeAttempt(ctx, challenge.Hash).Return(1, nil)
+		m.repo.EXPECT().AddTwoFactorChallengeAttempt(ctx, challenge.Hash).Return(2, nil)
+		m.repo.EXPECT().AddTwoFactorChallengeAttempt(ctx, other.Hash).Return(1, nil)
+		m.repo.EXPECT().AddTwoFactorChallengeAttempt(ctx, other.Hash).Return(2, nil)
+		m.audit.EXPECT().RecordSystem(ctx, domain.AuditActionLock, domain.AuditEntityUser, int32(1), gomock.Any())
+
+		var appErr *apperr.Error
+		for _, token := range []string{"token", "token", "other", "other"} {
+			_, err := serv.CompleteSignIn(ctx, &domain.TwoFactorSignInRequest{Token: token, Code: "wrong", IP: "127.0.0.1"})
+			require.ErrorAs(t, err, &appErr)
+			require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+		}
+		require.Equal(t, 4, m.throttles.failures(domain.SignInThrottleUser, "1"))
+
+		// Even a valid code is not checked while the account is locked
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+
+		_, err = serv.CompleteSignIn(ctx, &domain.TwoFactorSignInRequest{Token: "other", Code: code, IP: "127.0.0.1"})
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.TooManyRequestsType, appErr.Type)
+	})
+
+	t.Run("should fail on expired challenge", func(t *testing.T) {
+		serv, m := twoFactorService(t, service.TwoFactorPolicy{})
+
+		m.repo.EXPECT().GetTwoFactorChallenge(ctx, challenge.Hash).Return(nil, repository.ErrObjectNotFound)
+
+		_, err := serv.CompleteSignIn(ctx, req("123456"))
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.UnauthorizedType, appErr.Type)
+	})
+}
+
+func TestTwoFactorService_Policy(t *testing.T) {
+	t.Parallel()
+
+	policy := service.TwoFactorPolicy{RequireForAdmins: true}
+
+	t.Run("should require setup for admin", func(t *testing.T) {
+		serv, m := twoFactorService(t, policy)
+		ctx := context.Background()
+
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(nil, repository.ErrObjectNotFound)
+
+		required, err := serv.SetupRequired(ctx, &domain.User{ID: 1, Role: domain.UserRoleAdmin})
+		require.NoError(t, err)
+		require.True(t, required)
+
+		required, err = serv.SetupRequired(ctx, &domain.User{ID: 2, Role: domain.UserRoleUser})
+		require.NoError(t, err)
+		require.False(t, required)
+	})
+
+	t.Run("should forbid disabling for admin", func(t *testing.T) {
+		serv, _ := twoFactorService(t, policy)
+		ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 1, Role: domain.UserRoleAdmin})
+
+		err := serv.Disable(ctx, &domain.TwoFactorCodeRequest{Code: "123456"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.ForbiddenType, appErr.Type)
+	})
+}
+
+func TestTwoFactorService_ConfirmCodeAttempts(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{UserID: 1, Role: domain.UserRoleUser})
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	t.Run("should count invalid code", func(t *testing.T) {
+		serv, m := twoFactorService(t, service.TwoFactorPolicy{})
+
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(enabledTwoFactor(t, 1, secret), nil)
+		m.repo.EXPECT().AddTwoFactorCodeAttempt(ctx, int32(1), gomock.Any()).Return(1, nil)
+		m.repo.EXPECT().UseRecoveryCode(ctx, int32(1), gomock.Any()).Return(repository.ErrObjectNotFound)
+
+		err := serv.Disable(ctx, &domain.TwoFactorCodeRequest{Code: "wrong"})
+
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.InvalidRequestType, appErr.Type)
+	})
+
+	t.Run("should not check code after too many attempts", func(t *testing.T) {
+		serv, m := twoFactorService(t, service.TwoFactorPolicy{})
+
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(enabledTwoFactor(t, 1, secret), nil).Times(2)
+		m.repo.EXPECT().AddTwoFactorCodeAttempt(ctx, int32(1), gomock.Any()).Return(6, nil).Times(2)
+
+		err = serv.Disable(ctx, &domain.TwoFactorCodeRequest{Code: code})
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.TooManyRequestsType, appErr.Type)
+
+		_, err = serv.RegenerateRecoveryCodes(ctx, &domain.TwoFactorCodeRequest{Code: code})
+		require.ErrorAs(t, err, &appErr)
+		require.Equal(t, apperr.TooManyRequestsType, appErr.Type)
+	})
+
+	t.Run("should forget attempts after valid code", func(t *testing.T) {
+		serv, m := twoFactorService(t, service.TwoFactorPolicy{})
+
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+
+		m.repo.EXPECT().GetTwoFactor(ctx, int32(1)).Return(enabledTwoFactor(t, 1, secret), nil)
+		m.repo.EXPECT().AddTwoFactorCodeAttempt(ctx, int32(1), gomock.Any()).Return(5, nil)
+		m.repo.EXPECT().UseTwoFactorStep(ctx, int32(1), totp.Step(time.Now())).Return(nil)
+		m.repo.EXPECT().ResetTwoFactorCodeAttempts(ctx, int32(1)).Return(nil)
+		m.repo.EXPECT().ReplaceRecoveryCodes(ctx, int32(1), gomock.Any()).Return(nil)
+
+		codes, err := serv.RegenerateRecoveryCodes(ctx, &domain.TwoFactorCodeRequest{Code: code})
+		require.NoError(t, err)
+		require.Len(t, codes.Codes, 10)
+	})
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"web-studio-backend/internal/app/infrastructure/repository"
+)
+
+//go:generate mockgen -source=tx.go -destination=./mocks/tx.go -package=mocks
+type TxManager interface {
+	// WithinTx runs fn atomically, repositories must be called with the context passed to fn.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// fileChanges collects file storage changes made within a unit of work.
+type fileChanges struct {
+	saved   []string
+	deleted []string
+}
+
+// Saved registers a stored file which must be removed if the unit of work fails.
+func (c *fileChanges) Saved(fileName string) {
+	c.saved = append(c.saved, fileName)
+}
+
+// Delete registers a file which must be removed only when the unit of work succeeds.
+func (c *fileChanges) Delete(fileName string) {
+	c.deleted = append(c.deleted, fileName)
+}
+
+// unitOfWork runs repository calls in one transaction and keeps the file storage consistent with it.
+// Files can't take part in database transactions, so they are compensated instead:
+// saved files are removed on rollback and deletions are postponed until commit.
+type unitOfWork struct {
+	tx       TxManager
+	fileRepo FileRepository
+}
+
+// fileChangesKey keeps file changes of the outermost unit of work in the context.
+type fileChangesKey struct{}
+
+// do runs fn in a transaction applying the file changes according to its result.
+// Files may be saved before the transaction begins, so it is not kept open while they are uploaded.
+//
+// Nested call joins the outer transaction, so its file changes are passed to the outer unit of work
+// and applied when that one ends. Saved files are kept even if fn fails, because the outer
+// transaction may still commit what fn has written. The transaction must not be started
+// with TxManager directly around do, its commit can't be waited for.
+func (u unitOfWork) do(ctx context.Context, files *fileChanges, fn func(ctx context.Context) error) error {
+	if outer, ok := ctx.Value(fileChangesKey{}).(*fileChanges); ok {
+		err := u.tx.WithinTx(ctx, fn)
+		outer.saved = append(outer.saved, files.saved...)
+		if err != nil {
+			return err
+		}
+		outer.deleted = append(outer.deleted, files.deleted...)
+		return nil
+	}
+
+	err := u.tx.WithinTx(context.WithValue(ctx, fileChangesKey{}, files), fn)
+	if err != nil {
+		u.deleteFiles(ctx, files.saved)
+		return err
+	}
+
+	u.deleteFiles(ctx, files.deleted)
+
+	return nil
+}
+
+// deleteFiles removes files which are not referenced anymore, failures only leave garbage in the storage.
+func (u unitOfWork) deleteFiles(ctx context.Context, fileNames []string) {
+	for _, fileName := range fileNames {
+		err := u.fileRepo.Delete(context.WithoutCancel(ctx), fileName)
+		if err != nil && !errors.Is(err, repository.ErrObjectNotFound) {
+			slog.Error("Deleting file", slog.String("error", err.Error()), slog.String("file", fileName))
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"web-studio-backend/internal/app/service/mocks"
+)
+
+func TestUnitOfWork_Nested(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errInner := errors.New("inner error")
+
+	withinTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	t.Run("should apply inner changes when outer commits", func(t *testing.T) {
+		mockCtl := gomock.NewController(t)
+		tx := mocks.NewMockTxManager(mockCtl)
+		fileRepo := mocks.NewMockFileRepository(mockCtl)
+		uow := unitOfWork{tx, fileRepo}
+
+		tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(withinTx).Times(2)
+
+		var outer, inner fileChanges
+		inner.Delete("old")
+		err := uow.do(ctx, &outer, func(ctx context.Context) error {
+			err := uow.do(ctx, &inner, func(context.Context) error { return nil })
+			require.NoError(t, err)
+
+			// Nothing is deleted until the outer transaction commits
+			fileRepo.EXPECT().Delete(gomock.Any(), "old").Return(nil)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("should remove inner saved files when outer fails", func(t *testing.T) {
+		mockCtl := gomock.NewController(t)
+		tx := mocks.NewMockTxManager(mockCtl)
+		fileRepo := mocks.NewMockFileRepository(mockCtl)
+		uow := unitOfWork{tx, fileRepo}
+
+		tx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(withinTx).Times(2)
+
+		var outer, inner fileChanges
+		inner.Saved("new")
+		inner.Delete("old")
+		err := uow.do(ctx, &outer, func(ctx context.Context) error {
+			err := uow.do(ctx, &inner, func(context.Context) error { return errInner })
+			require.ErrorIs(t, err, errInner)
+
+			fileRepo.EXPECT().Delete(gomock.Any(), "new").Return(nil)
+			return err
+		})
+		require.ErrorIs(t, err, errInner)
+	})
+}
@@ -5,21 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"path/filepath"
-
-	"github.com/gabriel-vasile/mimetype"
-	"github.com/google/uuid"
 
 	"web-studio-backend/internal/app/domain"
 	"web-studio-backend/internal/app/domain/apperr"
 	"web-studio-backend/internal/app/infrastructure/repository"
+	"web-studio-backend/internal/pkg/auth"
+	"web-studio-backend/internal/pkg/passhash"
 	"web-studio-backend/internal/pkg/strhelp"
 )
 
 //go:generate mockgen -source=user.go -destination=./mocks/user.go -package=mocks
 type UserRepository interface {
 	GetUser(ctx context.Context, id int32) (*domain.User, error)
-	GetUsers(ctx context.Context) ([]domain.User, error)
+	GetUsers(ctx context.Context, params *domain.ListParams, filter *domain.UserFilter) ([]domain.User, int, error)
 	GetActiveUser(ctx context.Context, id int32) (*domain.User, error)
 	CreateUser(ctx context.Context, user *domain.User) (int32, error)
 	UpdateUser(ctx context.Context, user *domain.User) error
@@ -30,13 +28,25 @@ type UserRepository interface {
 }
 
 type UserService struct {
-	filesDir string
-	repo     UserRepository
-	fileRepo FileRepository
+	images    imageStorage
+	repo      UserRepository
+	sessions  SessionStore
+	apiTokens APITokenRepository
+	hasher    passhash.Hasher
+	accounts  *AccountMailer
+	audit     Auditor
 }
 
-func NewUserService(repo UserRepository, fileRepo FileRepository) *UserService {
-	return &UserService{"users", repo, fileRepo}
+func NewUserService(
+	repo UserRepository,
+	fileRepo FileRepository,
+	sessions SessionStore,
+	apiTokens APITokenRepository,
+	hasher passhash.Hasher,
+	accounts *AccountMailer,
+	audit Auditor,
+) *UserService {
+	return &UserService{newImageStorage("users", fileRepo), repo, sessions, apiTokens, hasher, accounts, audit}
 }
 
 func (s *UserService) GetUser(ctx context.Context, id int32) (*domain.User, error) {
@@ -51,13 +61,14 @@ func (s *UserService) GetUser(ctx context.Context, id int32) (*domain.User, erro
 	return user, nil
 }
 
-func (s *UserService) GetUsers(ctx context.Context) ([]domain.User, error) {
-	users, err := s.repo.GetUsers(ctx)
+// GetUsers returns the requested page of users and total number of users matching the filter.
+func (s *UserService) GetUsers(ctx context.Context, params *domain.ListParams, filter *domain.UserFilter) ([]domain.User, int, error) {
+	users, total, err := s.repo.GetUsers(ctx, params, filter)
 	if err != nil {
-		return nil, fmt.Errorf("getting users: %w", err)
+		return nil, 0, fmt.Errorf("getting users: %w", err)
 	}
 
-	return users, nil
+	return users, total, nil
 }
 
 func (s *UserService) CreateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
@@ -72,6 +83,10 @@ func (s *UserService) CreateUser(ctx context.Context, user *domain.User) (*domai
 		)
 	}
 
+	if ac, ok := auth.FromContext(ctx); ok && !ac.Role.AtLeast(user.Role) {
+		return nil, apperr.NewForbidden("Cannot create user with a role higher than yours.")
+	}
+
 	if !strhelp.ValidateEmail(user.Email) {
 		return nil, apperr.NewInvalidRequest("Email has invalid format.", "email")
 	}
@@ -103,7 +118,7 @@ func (s *UserService) CreateUser(ctx context.Context, user *domain.User) (*domai
 		)
 	}
 
-	err = user.EncodePassword()
+	err = user.EncodePassword(s.hasher)
 	if err != nil {
 		return nil, fmt.Errorf("encoding user password: %w", err)
 	}
@@ -118,6 +133,14 @@ func (s *UserService) CreateUser(ctx context.Context, user *domain.User) (*domai
 		return nil, fmt.Errorf("getting user %d: %w", userId, err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionCreate, domain.AuditEntityUser, userId, nil, createdUser)
+
+	// User can request the verification link again, so failure doesn't fail creation
+	err = s.accounts.sendEmailVerification(ctx, createdUser)
+	if err != nil {
+		slog.Error("Sending email verification", slog.Int("user_id", int(userId)), slog.String("error", err.Error()))
+	}
+
 	return createdUser, nil
 }
 
@@ -126,7 +149,7 @@ func (s *UserService) UpdateUser(ctx context.Context, user *domain.User) (*domai
 		return nil, fmt.Errorf("validating user: %w", err)
 	}
 
-	_, err := s.repo.GetUser(ctx, user.ID)
+	existingUser, err := s.repo.GetUser(ctx, user.ID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
 			return nil, apperr.NewNotFound("user_id")
@@ -134,6 +157,15 @@ func (s *UserService) UpdateUser(ctx context.Context, user *domain.User) (*domai
 		return nil, fmt.Errorf("getting user %d: %w", user.ID, err)
 	}
 
+	if ac, ok := auth.FromContext(ctx); ok && user.Role != existingUser.Role {
+		if !ac.Role.AtLeast(domain.UserRoleAdmin) {
+			return nil, apperr.NewForbidden("Not enough permissions to change user role.")
+		}
+		if !ac.Role.AtLeast(user.Role) || !ac.Role.AtLeast(existingUser.Role) {
+			return nil, apperr.NewForbidden("Cannot change role of a user with a role higher than yours.")
+		}
+	}
+
 	err = s.repo.UpdateUser(ctx, user)
 	if err != nil {
 		return nil, fmt.Errorf("updating user %d: %w", user.ID, err)
@@ -144,6 +176,8 @@ func (s *UserService) UpdateUser(ctx context.Context, user *domain.User) (*domai
 		return nil, fmt.Errorf("updating user %d: %w", user.ID, err)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityUser, user.ID, existingUser, updatedUser)
+
 	return updatedUser, nil
 }
 
@@ -155,33 +189,32 @@ func (s *UserService) RemoveUser(ctx context.Context, id int32) error {
 		}
 		return fmt.Errorf("getting user %d: %w", id, err)
 	}
-	_ = user
 
-	// TODO: compare user role
+	if ac, ok := auth.FromContext(ctx); ok && !ac.Role.AtLeast(user.Role) {
+		return apperr.NewForbidden("Cannot remove user with a role higher than yours.")
+	}
 
 	err = s.repo.DisableUser(ctx, id)
 	if err != nil {
 		return fmt.Errorf("marking user %d disabled: %w", id, err)
 	}
 
-	return nil
-}
-
-func (s *UserService) SetUserImage(ctx context.Context, userID int32, img []byte) error {
-	if len(img) > 5<<20 {
-		return apperr.NewInvalidRequest("Image is too big.", "file")
+	err = s.sessions.DeleteUserSessions(ctx, id)
+	if err != nil {
+		return fmt.Errorf("deleting user %d sessions: %w", id, err)
 	}
 
-	mt := mimetype.Detect(img)
-	if !mt.Is("image/jpeg") &&
-		!mt.Is("image/png") &&
-		!mt.Is("image/webp") {
-		return apperr.NewInvalidRequest("Invalid image mime type.", "file")
+	err = s.apiTokens.DeleteUserAPITokens(ctx, id)
+	if err != nil {
+		return fmt.Errorf("deleting user %d api tokens: %w", id, err)
 	}
 
-	fileID := uuid.New().String()
-	fileName := fileID + mt.Extension()
+	s.audit.Record(ctx, domain.AuditActionDisable, domain.AuditEntityUser, id, nil, nil)
 
+	return nil
+}
+
+func (s *UserService) SetUserImage(ctx context.Context, userID int32, img []byte) error {
 	user, err := s.repo.GetUser(ctx, userID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
@@ -190,48 +223,48 @@ func (s *UserService) SetUserImage(ctx context.Context, userID int32, img []byte
 		return fmt.Errorf("getting user %d: %w", userID, err)
 	}
 
-	err = s.repo.SetUserImage(ctx, userID, fileName)
+	imageID, err := s.images.save(ctx, img)
 	if err != nil {
-		return fmt.Errorf("setting user %d image: %w", userID, err)
+		return fmt.Errorf("saving user image: %w", err)
 	}
 
-	err = s.fileRepo.Save(ctx, img, filepath.Join(s.filesDir, fileName))
+	err = s.repo.SetUserImage(ctx, userID, imageID)
 	if err != nil {
-		return fmt.Errorf("saving user image: %w", err)
+		s.images.delete(ctx, imageID)
+		return fmt.Errorf("setting user %d image: %w", userID, err)
 	}
 
 	if user.ImageID != "" {
-		err = s.fileRepo.Delete(ctx, filepath.Join(s.filesDir, user.ImageID))
-		if err != nil {
-			slog.Error("Deleting old user image", slog.String("error", err.Error()))
-		}
+		s.images.delete(ctx, user.ImageID)
 	}
 
+	s.audit.Record(ctx, domain.AuditActionSetImage, domain.AuditEntityUser, userID, nil, nil)
+
 	return nil
 }
 
-func (s *UserService) GetUserImage(ctx context.Context, userID int32) (*domain.User, error) {
+// GetUserImage returns user and its opened image variant of the given size, the file must be closed by the caller.
+func (s *UserService) GetUserImage(ctx context.Context, userID int32, size int) (*domain.User, *domain.File, error) {
+	if err := validateImageSize(size); err != nil {
+		return nil, nil, err
+	}
+
 	user, err := s.repo.GetUser(ctx, userID)
 	if err != nil {
 		if errors.Is(err, repository.ErrObjectNotFound) {
-			return nil, apperr.NewNotFound("user_id")
+			return nil, nil, apperr.NewNotFound("user_id")
 		}
-		return nil, fmt.Errorf("getting user %d: %w", userID, err)
+		return nil, nil, fmt.Errorf("getting user %d: %w", userID, err)
 	}
 
 	if user.ImageID == "" {
-		return nil, apperr.NewNotFound("image_id")
+		return nil, nil, apperr.NewNotFound("image_id")
 	}
 
-	data, err := s.fileRepo.Read(ctx, filepath.Join(s.filesDir, user.ImageID))
+	file, err := s.images.open(ctx, user.ImageID, size)
 	if err != nil {
-		if errors.Is(err, repository.ErrObjectNotFound) {
-			return nil, apperr.NewNotFound("image_id")
-		}
-		return nil, fmt.Errorf("reading user image: %w", err)
+		return nil, nil, fmt.Errorf("opening user image: %w", err)
 	}
 
-	user.ImageContent = data
-
-	return user, nil
+	return user, file, nil
 }
@@ -1,9 +1,12 @@
 package service_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"image"
+	"image/png"
 	"path/filepath"
 	"testing"
 	"time"
@@ -12,8 +15,12 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"web-studio-backend/internal/app/domain"
+	"web-studio-backend/internal/app/domain/apperr"
+	"web-studio-backend/internal/app/infrastructure/repository"
 	"web-studio-backend/internal/app/service"
 	"web-studio-backend/internal/app/service/mocks"
+	"web-studio-backend/internal/pkg/auth"
+	"web-studio-backend/internal/pkg/passhash"
 )
 
 func user(t *testing.T) (*service.UserService, *mocks.MockUserRepository, *mocks.MockFileRepository) {
@@ -24,7 +31,18 @@ func user(t *testing.T) (*service.UserService, *mocks.MockUserRepository, *mocks
 
 	userRepo := mocks.NewMockUserRepository(mockCtl)
 	fileRepo := mocks.NewMockFileRepository(mockCtl)
-	userService := service.NewUserService(userRepo, fileRepo)
+	auditor := mocks.NewMockAuditor(mockCtl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	tokenRepo := mocks.NewMockUserTokenRepository(mockCtl)
+	tokenRepo.EXPECT().CreateUserToken(gomock.Any(), gomock.Any()).AnyTimes()
+	mailer := mocks.NewMockMailer(mockCtl)
+	mailer.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes()
+	accounts := service.NewAccountMailer(tokenRepo, mailer, "http://localhost")
+	sessions := mocks.NewMockSessionStore(mockCtl)
+	sessions.EXPECT().DeleteUserSessions(gomock.Any(), gomock.Any()).AnyTimes()
+	apiTokens := mocks.NewMockAPITokenRepository(mockCtl)
+	apiTokens.EXPECT().DeleteUserAPITokens(gomock.Any(), gomock.Any()).AnyTimes()
+	userService := service.NewUserService(userRepo, fileRepo, sessions, apiTokens, &passhash.Bcrypt{Cost: 4}, accounts, auditor)
 
 	return userService, userRepo, fileRepo
 }
@@ -263,9 +281,13 @@ func TestUserService_GetUsers(t *testing.T) {
 
 	tempTime := time.Now()
 
+	params := &domain.ListParams{Limit: 2, Offset: 0}
+	filter := &domain.UserFilter{}
+
 	type test struct {
 		name    string
 		res     []domain.User
+		total   int
 		err     error
 		wantErr bool
 	}
@@ -276,7 +298,8 @@ func TestUserService_GetUsers(t *testing.T) {
 	}{
 		{
 			test: test{
-				name: "should pass",
+				name:  "should pass",
+				total: 5,
 				res: []domain.User{
 					{
 						ID:         1,
@@ -307,7 +330,7 @@ func TestUserService_GetUsers(t *testing.T) {
 				},
 			},
 			mock: func() {
-				repo.EXPECT().GetUsers(ctx).Return([]domain.User{
+				repo.EXPECT().GetUsers(ctx, params, filter).Return([]domain.User{
 					{
 						ID:         1,
 						Name:       "name1",
@@ -334,7 +357,7 @@ func TestUserService_GetUsers(t *testing.T) {
 						DisabledAt: &tempTime,
 						ImageID:    "image_id2",
 					},
-				}, nil)
+				}, 5, nil)
 			},
 		},
 		{
@@ -343,7 +366,7 @@ func TestUserService_GetUsers(t *testing.T) {
 				wantErr: true,
 			},
 			mock: func() {
-				repo.EXPECT().GetUsers(ctx).Return(nil, errors.New("unknown"))
+				repo.EXPECT().GetUsers(ctx, params, filter).Return(nil, 0, errors.New("unknown"))
 			},
 		},
 	}
@@ -354,7 +377,7 @@ func TestUserService_GetUsers(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.mock()
 
-			res, err := serv.GetUsers(ctx)
+			res, total, err := serv.GetUsers(ctx, params, filter)
 			if !tc.wantErr {
 				require.NoError(t, err)
 			} else {
@@ -362,6 +385,7 @@ func TestUserService_GetUsers(t *testing.T) {
 			}
 
 			require.Equal(t, tc.res, res)
+			require.Equal(t, tc.total, total)
 
 			if !tc.wantErr {
 				return
@@ -515,10 +539,13 @@ func TestUserService_GetUserImage(t *testing.T) {
 	type test struct {
 		name    string
 		res     *domain.User
+		file    *domain.File
 		err     error
 		wantErr bool
 	}
 
+	imageFile := &domain.File{Size: 13, ETag: `"etag"`}
+
 	tests := []struct {
 		test
 		id   int32
@@ -528,10 +555,10 @@ func TestUserService_GetUserImage(t *testing.T) {
 			test: test{
 				name: "should pass",
 				res: &domain.User{
-					ID:           1,
-					ImageID:      "image_id",
-					ImageContent: []byte("image_content"),
+					ID:      1,
+					ImageID: "image_id",
 				},
+				file:    imageFile,
 				err:     nil,
 				wantErr: false,
 			},
@@ -541,7 +568,7 @@ func TestUserService_GetUserImage(t *testing.T) {
 					ID:      1,
 					ImageID: "image_id",
 				}, nil)
-				fileRepo.EXPECT().Read(ctx, filepath.Join("users", "image_id")).Return([]byte("image_content"), nil)
+				fileRepo.EXPECT().Open(ctx, filepath.Join("users", "image_id_1024")).Return(imageFile, nil)
 			},
 		},
 		{
@@ -576,7 +603,7 @@ func TestUserService_GetUserImage(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.mock(tc.id)
 
-			res, err := serv.GetUserImage(ctx, tc.id)
+			res, file, err := serv.GetUserImage(ctx, tc.id, 0)
 			if !tc.wantErr {
 				require.NoError(t, err)
 			} else {
@@ -584,6 +611,7 @@ func TestUserService_GetUserImage(t *testing.T) {
 			}
 
 			require.Equal(t, tc.res, res)
+			require.Equal(t, tc.file, file)
 
 			if !tc.wantErr {
 				return
@@ -677,3 +705,101 @@ func TestUserService_RemoveUser(t *testing.T) {
 		})
 	}
 }
+
+func TestUserService_RemoveUser_RevokesSessions(t *testing.T) {
+	t.Parallel()
+
+	mockCtl := gomock.NewController(t)
+
+	repo := mocks.NewMockUserRepository(mockCtl)
+	sessions := mocks.NewMockSessionStore(mockCtl)
+	apiTokens := mocks.NewMockAPITokenRepository(mockCtl)
+	auditor := mocks.NewMockAuditor(mockCtl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	serv := service.NewUserService(repo, mocks.NewMockFileRepository(mockCtl), sessions, apiTokens, &passhash.Bcrypt{Cost: 4}, nil, auditor)
+
+	ctx := context.Background()
+
+	gomock.InOrder(
+		repo.EXPECT().GetUser(ctx, int32(1)).Return(&domain.User{ID: 1}, nil),
+		repo.EXPECT().DisableUser(ctx, int32(1)).Return(nil),
+		sessions.EXPECT().DeleteUserSessions(ctx, int32(1)).Return(nil),
+		apiTokens.EXPECT().DeleteUserAPITokens(ctx, int32(1)).Return(nil),
+	)
+
+	err := serv.RemoveUser(ctx, 1)
+	require.NoError(t, err)
+}
+
+func TestUserService_RemoveUser_Forbidden(t *testing.T) {
+	t.Parallel()
+
+	serv, repo, _ := user(t)
+
+	ctx := auth.NewContext(context.Background(), &domain.AuthContext{
+		UserID: 2,
+		Role:   domain.UserRoleModerator,
+	})
+
+	repo.EXPECT().GetUser(ctx, int32(1)).Return(&domain.User{
+		ID:   1,
+		Role: domain.UserRoleAdmin,
+	}, nil)
+
+	err := serv.RemoveUser(ctx, 1)
+	require.Error(t, err)
+
+	var ae *apperr.Error
+	require.ErrorAs(t, err, &ae)
+	require.Equal(t, apperr.ForbiddenType, ae.Type)
+}
+
+func TestUserService_SetUserImage(t *testing.T) {
+	t.Parallel()
+
+	serv, repo, fileRepo := user(t)
+
+	ctx := context.Background()
+
+	img := &bytes.Buffer{}
+	require.NoError(t, png.Encode(img, image.NewRGBA(image.Rect(0, 0, 300, 300))))
+
+	repo.EXPECT().GetUser(ctx, int32(1)).Return(&domain.User{ID: 1, ImageID: "old.png"}, nil).Times(2)
+	fileRepo.EXPECT().Save(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(3).Return(nil)
+	repo.EXPECT().SetUserImage(ctx, int32(1), gomock.Any()).Return(nil)
+	fileRepo.EXPECT().Delete(ctx, filepath.Join("users", "old.png")).Return(nil)
+	for _, name := range []string{"old_64.png", "old_256.png", "old_1024.png"} {
+		fileRepo.EXPECT().Delete(ctx, filepath.Join("users", name)).Return(repository.ErrObjectNotFound)
+	}
+
+	err := serv.SetUserImage(ctx, 1, img.Bytes())
+	require.NoError(t, err)
+
+	err = serv.SetUserImage(ctx, 1, []byte("not an image"))
+	var ae *apperr.Error
+	require.ErrorAs(t, err, &ae)
+	require.Equal(t, apperr.InvalidRequestType, ae.Type)
+}
+
+func TestUserService_GetUserImageSize(t *testing.T) {
+	t.Parallel()
+
+	serv, repo, fileRepo := user(t)
+
+	ctx := context.Background()
+	imageFile := &domain.File{Size: 13}
+
+	// Images uploaded before variants were introduced are served as is.
+	repo.EXPECT().GetUser(ctx, int32(1)).Return(&domain.User{ID: 1, ImageID: "legacy.png"}, nil)
+	fileRepo.EXPECT().Open(ctx, filepath.Join("users", "legacy_64.png")).Return(nil, repository.ErrObjectNotFound)
+	fileRepo.EXPECT().Open(ctx, filepath.Join("users", "legacy.png")).Return(imageFile, nil)
+
+	_, file, err := serv.GetUserImage(ctx, 1, 64)
+	require.NoError(t, err)
+	require.Equal(t, imageFile, file)
+
+	_, _, err = serv.GetUserImage(ctx, 1, 100)
+	var ae *apperr.Error
+	require.ErrorAs(t, err, &ae)
+	require.Equal(t, "size", ae.Field)
+}
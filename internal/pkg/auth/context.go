@@ -13,6 +13,12 @@ func NewContext(ctx context.Context, ac *domain.AuthContext) context.Context {
 	return context.WithValue(ctx, userKey, ac)
 }
 
+// FromContext returns auth context from given context if it exists.
+func FromContext(ctx context.Context) (*domain.AuthContext, bool) {
+	ac, ok := ctx.Value(userKey).(*domain.AuthContext)
+	return ac, ok
+}
+
 // MustFromContext returns auth context from given context.
 //
 //	Panics if auth context not found.
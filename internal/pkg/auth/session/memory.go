@@ -0,0 +1,131 @@
+package session
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps sessions in process memory.
+// All sessions are lost on restart, so it is suitable only for development and single instance setups.
+type MemoryStore struct {
+	m        sync.RWMutex
+	sessions map[string]Session
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sessions: map[string]Session{}}
+}
+
+func (s *MemoryStore) Create(_ context.Context, sess *Session) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.sessions[sess.ID] = *sess
+
+	return nil
+}
+
+// Get returns session by sessionID.
+func (s *MemoryStore) Get(_ context.Context, sessionID string) (*Session, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	sess, ok := s.sessions[sessionID]
+	if !ok || sess.Expired(time.Now()) {
+		return nil, ErrSessionNotFound
+	}
+
+	return &sess, nil
+}
+
+// Touch updates the last time the session was used.
+func (s *MemoryStore) Touch(_ context.Context, sessionID string, at time.Time) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sess, ok := s.sessions[sessionID]
+	if ok {
+		sess.LastSeenAt = at
+		s.sessions[sessionID] = sess
+	}
+
+	return nil
+}
+
+// ListUserSessions returns active sessions of the given user, recently used first.
+func (s *MemoryStore) ListUserSessions(_ context.Context, userID int32) ([]Session, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	now := time.Now()
+	sessions := make([]Session, 0)
+	for _, sess := range s.sessions {
+		if sess.UserID == userID && !sess.Expired(now) {
+			sessions = append(sessions, sess)
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+
+	return sessions, nil
+}
+
+// Delete deactivates the session.
+func (s *MemoryStore) Delete(_ context.Context, sessionID string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	delete(s.sessions, sessionID)
+
+	return nil
+}
+
+// DeleteUserSessions deletes all sessions of the given user.
+func (s *MemoryStore) DeleteUserSessions(_ context.Context, userID int32) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for sid, sess := range s.sessions {
+		if sess.UserID == userID {
+			delete(s.sessions, sid)
+		}
+	}
+
+	return nil
+}
+
+// DeleteOtherUserSessions deletes all sessions of the given user except the kept one.
+func (s *MemoryStore) DeleteOtherUserSessions(_ context.Context, userID int32, keepSessionID string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for sid, sess := range s.sessions {
+		if sess.UserID == userID && sid != keepSessionID {
+			delete(s.sessions, sid)
+		}
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) DeleteExpired(_ context.Context) (int64, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	var (
+		now     = time.Now()
+		deleted int64
+	)
+	for sid, sess := range s.sessions {
+		if sess.Expired(now) {
+			delete(s.sessions, sid)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
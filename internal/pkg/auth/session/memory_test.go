@@ -0,0 +1,106 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	sess, err := New(1)
+	require.NoError(t, err)
+	require.NoError(t, store.Create(ctx, sess))
+
+	got, err := store.Get(ctx, sess.ID)
+	require.NoError(t, err)
+	require.Equal(t, sess.UserID, got.UserID)
+	require.Equal(t, sess.CSRFToken, got.CSRFToken)
+
+	require.NoError(t, store.Delete(ctx, sess.ID))
+
+	_, err = store.Get(ctx, sess.ID)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+func TestMemoryStore_DeleteUserSessions(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		sess, err := New(1)
+		require.NoError(t, err)
+		require.NoError(t, store.Create(ctx, sess))
+		ids = append(ids, sess.ID)
+	}
+
+	other, err := New(2)
+	require.NoError(t, err)
+	require.NoError(t, store.Create(ctx, other))
+
+	require.NoError(t, store.DeleteUserSessions(ctx, 1))
+
+	for _, id := range ids {
+		_, err = store.Get(ctx, id)
+		require.ErrorIs(t, err, ErrSessionNotFound)
+	}
+
+	_, err = store.Get(ctx, other.ID)
+	require.NoError(t, err)
+}
+
+func TestMemoryStore_DeleteExpired(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	expired, err := New(1)
+	require.NoError(t, err)
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	require.NoError(t, store.Create(ctx, expired))
+
+	active, err := New(1)
+	require.NoError(t, err)
+	require.NoError(t, store.Create(ctx, active))
+
+	_, err = store.Get(ctx, expired.ID)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+
+	n, err := store.DeleteExpired(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+
+	_, err = store.Get(ctx, active.ID)
+	require.NoError(t, err)
+}
+
+func TestMemoryStore_UserSessions(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		sess, err := New(1)
+		require.NoError(t, err)
+		require.NoError(t, store.Create(ctx, sess))
+		ids = append(ids, sess.ID)
+	}
+
+	require.NoError(t, store.Touch(ctx, ids[1], time.Now().Add(time.Minute)))
+
+	sessions, err := store.ListUserSessions(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+	require.Equal(t, ids[1], sessions[0].ID)
+
+	require.NoError(t, store.DeleteOtherUserSessions(ctx, 1, ids[1]))
+
+	sessions, err = store.ListUserSessions(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, ids[1], sessions[0].ID)
+}
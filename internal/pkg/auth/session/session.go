@@ -1,9 +1,12 @@
 package session
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"sync"
+	"log/slog"
 	"time"
 
 	"web-studio-backend/internal/pkg/strhelp"
@@ -14,49 +17,52 @@ const TTL = 24 * time.Hour
 var ErrSessionNotFound = errors.New("session not found")
 
 type Session struct {
-	UserID    int32
-	CSRFToken string
+	ID         string
+	UserID     int32
+	CSRFToken  string
+	IP         string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
 }
 
-// sessions are used to map 'session_id' cookie to user ID.
-var (
-	sessions = map[string]Session{}
-	m        sync.RWMutex
-)
-
-func New(userID int32) (string, string, error) {
-	m.Lock()
-	defer m.Unlock()
-
-	var sessionID string
-
-	for {
-		sid, err := generateSessionID()
-		if err != nil {
-			return "", "", fmt.Errorf("generating session id: %w", err)
-		}
-
-		if _, ok := sessions[sid]; !ok {
-			sessionID = sid
-			break
-		}
+// New creates a new session for the given user.
+// Session is not stored anywhere, it must be saved by the caller.
+// Client IP and user agent are filled by the caller as well.
+func New(userID int32) (*Session, error) {
+	sessionID, err := generateSessionID()
+	if err != nil {
+		return nil, fmt.Errorf("generating session id: %w", err)
 	}
 
 	csrfToken, err := generateCSRFToken()
 	if err != nil {
-		return "", "", fmt.Errorf("generating session id: %w", err)
+		return nil, fmt.Errorf("generating csrf token: %w", err)
 	}
 
-	sessions[sessionID] = Session{
-		UserID:    userID,
-		CSRFToken: csrfToken,
-	}
+	now := time.Now()
+
+	return &Session{
+		ID:         sessionID,
+		UserID:     userID,
+		CSRFToken:  csrfToken,
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(TTL),
+	}, nil
+}
 
-	time.AfterFunc(TTL, func() {
-		Delete(sessionID)
-	})
+// Expired reports whether the session is expired at the given moment.
+func (s *Session) Expired(at time.Time) bool {
+	return !at.Before(s.ExpiresAt)
+}
 
-	return sessionID, csrfToken, nil
+// PublicID returns identifier of the session which can be shown to the user.
+// Session ID itself authorizes requests, so it never leaves the cookie.
+func (s *Session) PublicID() string {
+	sum := sha256.Sum256([]byte(s.ID))
+	return hex.EncodeToString(sum[:16])
 }
 
 func generateSessionID() (string, error) {
@@ -69,40 +75,28 @@ func generateCSRFToken() (string, error) {
 	return strhelp.GenerateRandomString(32)
 }
 
-// GetSession returns session by sessionID.
-func GetSession(sessionID string) (*Session, error) {
-	m.RLock()
-	defer m.RUnlock()
-
-	session, ok := sessions[sessionID]
-	if !ok {
-		return nil, ErrSessionNotFound
-	}
-
-	return &session, nil
+type expiredDeleter interface {
+	DeleteExpired(ctx context.Context) (int64, error)
 }
 
-// Delete deactivates the session.
-func Delete(sessionID string) {
-	m.Lock()
-	defer m.Unlock()
+// Sweep periodically deletes expired sessions from the store until ctx is done.
+func Sweep(ctx context.Context, store expiredDeleter, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-	delete(sessions, sessionID)
-}
-
-// DeleteUserSession deletes session for given user.
-func DeleteUserSession(userID int32) {
-	m.Lock()
-	defer m.Unlock()
-
-	var sessionID string
-
-	for sid, sess := range sessions {
-		if sess.UserID == userID {
-			sessionID = sid
-			break
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := store.DeleteExpired(ctx)
+			if err != nil {
+				slog.Error("Deleting expired sessions", slog.String("error", err.Error()))
+				continue
+			}
+			if n > 0 {
+				slog.Debug("Expired sessions deleted", slog.Int64("count", n))
+			}
 		}
 	}
-
-	delete(sessions, sessionID)
 }
@@ -4,8 +4,12 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"log"
+	"net/netip"
 	"sync"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -21,6 +25,7 @@ type Config struct {
 		KeyFilePath    string   `yaml:"key_file_path"`
 		CertFilePath   string   `yaml:"cert_file_path"`
 		AllowedOrigins []string `yaml:"allowed_origins" env-default:"http://localhost:*,http://127.0.0.1:*"`
+		TrustedProxies []string `yaml:"trusted_proxies"` // Addresses or CIDR ranges of proxies setting X-Forwarded-For, e.g. 10.0.0.0/8
 	} `yaml:"http"`
 	Database struct {
 		User     string `yaml:"user"`
@@ -28,6 +33,71 @@ type Config struct {
 		Host     string `yaml:"host"`
 		Database string `yaml:"database"`
 	} `yaml:"database" env-required:"true"`
+	Session struct {
+		Store         string        `yaml:"store" env-default:"memory"` // One of: memory, postgres
+		SweepInterval time.Duration `yaml:"sweep_interval" env-default:"10m"`
+	} `yaml:"session"`
+	Password struct {
+		Algorithm string `yaml:"algorithm" env-default:"argon2id"` // One of: argon2id, bcrypt
+	} `yaml:"password"`
+	Storage struct {
+		Backend string `yaml:"backend" env-default:"filesystem"` // One of: filesystem, s3
+		Dir     string `yaml:"dir" env-default:"files"`          // Root directory of filesystem backend
+		S3      struct {
+			Endpoint  string `yaml:"endpoint"`
+			Region    string `yaml:"region"`
+			Bucket    string `yaml:"bucket"`
+			AccessKey string `yaml:"access_key"` // Encoded the same way as database credentials
+			SecretKey string `yaml:"secret_key"`
+			UseSSL    bool   `yaml:"use_ssl" env-default:"true"`
+		} `yaml:"s3"`
+	} `yaml:"storage"`
+	Trash struct {
+		Retention     time.Duration `yaml:"retention" env-default:"720h"` // Deleted objects are purged after it
+		PurgeInterval time.Duration `yaml:"purge_interval" env-default:"1h"`
+	} `yaml:"trash"`
+	Mail struct {
+		Backend string `yaml:"backend" env-default:"log"` // One of: smtp, file, log. Log is not allowed in prod
+		From    string `yaml:"from" env-default:"Web Studio <noreply@localhost>"`
+		Dir     string `yaml:"dir" env-default:"mail"`                       // Directory of file backend
+		LinkURL string `yaml:"link_url" env-default:"http://localhost:3000"` // Frontend URL of links sent by email
+		SMTP    struct {
+			Host     string `yaml:"host"`
+			Port     int    `yaml:"port" env-default:"587"`
+			Username string `yaml:"username"` // Encoded the same way as database credentials
+			Password string `yaml:"password"`
+		} `yaml:"smtp"`
+	} `yaml:"mail"`
+	OIDC struct {
+		Providers []struct {
+			Name         string   `yaml:"name"` // Used in URLs, e.g. "google"
+			Issuer       string   `yaml:"issuer"`
+			ClientID     string   `yaml:"client_id"` // Encoded the same way as database credentials
+			ClientSecret string   `yaml:"client_secret"`
+			RedirectURL  string   `yaml:"redirect_url"` // Frontend page which receives the code from provider
+			Scopes       []string `yaml:"scopes"`       // Default is "email profile"
+		} `yaml:"providers"`
+	} `yaml:"oidc"`
+	TwoFactor struct {
+		Issuer           string `yaml:"issuer" env-default:"Web Studio"` // Shown in authenticator apps
+		RequireForAdmins bool   `yaml:"require_for_admins"`              // Admins and global admins must enable TOTP
+	} `yaml:"two_factor"`
+	SignIn struct {
+		FreeAttempts    int           `yaml:"free_attempts" env-default:"5"`     // Failed attempts to an account without delay
+		IPFreeAttempts  int           `yaml:"ip_free_attempts" env-default:"20"` // Failed attempts from an IP address without delay
+		BackoffBase     time.Duration `yaml:"backoff_base" env-default:"1s"`     // Doubled with each next failed attempt
+		BackoffMax      time.Duration `yaml:"backoff_max" env-default:"5m"`
+		LockoutAttempts int           `yaml:"lockout_attempts" env-default:"10"` // Zero disables account lockout
+		LockoutDuration time.Duration `yaml:"lockout_duration" env-default:"30m"`
+		Window          time.Duration `yaml:"window" env-default:"1h"` // Failed attempts are forgotten after this time
+	} `yaml:"sign_in"`
+	Locale struct {
+		Default   string   `yaml:"default" env-default:"ru"` // Language of project and team fields, used if there is no translation
+		Supported []string `yaml:"supported" env-default:"ru,en"`
+	} `yaml:"locale"`
+	Public struct {
+		CacheMaxAge time.Duration `yaml:"cache_max_age" env-default:"5m"` // Browsers and proxies may reuse public API responses for this time
+	} `yaml:"public"`
 }
 
 var (
@@ -45,6 +115,10 @@ func Read(configPath string) {
 			log.Fatalf("Failed to read config: %v", err)
 		}
 
+		if err = cfg.validate(); err != nil {
+			log.Fatalf("Invalid config: %v", err)
+		}
+
 		decodedKey, err := hex.DecodeString(k)
 		if err != nil {
 			log.Fatalf("Failed to decode app key: %v", err)
@@ -57,6 +131,31 @@ func Read(configPath string) {
 	})
 }
 
+// validate rejects values which would break the app at run time rather than at start.
+func (c *Config) validate() error {
+	if c.Session.SweepInterval <= 0 {
+		return errors.New("session.sweep_interval must be positive")
+	}
+	if c.Trash.PurgeInterval <= 0 {
+		return errors.New("trash.purge_interval must be positive")
+	}
+	if c.Trash.Retention < 0 {
+		return errors.New("trash.retention cannot be negative")
+	}
+	for _, proxy := range c.Http.TrustedProxies {
+		_, prefixErr := netip.ParsePrefix(proxy)
+		_, addrErr := netip.ParseAddr(proxy)
+		if prefixErr != nil && addrErr != nil {
+			return fmt.Errorf("http.trusted_proxies: invalid address %q", proxy)
+		}
+	}
+	if c.App.Env == "prod" && c.Mail.Backend == "log" {
+		return errors.New("mail.backend must be set to smtp or file in prod")
+	}
+
+	return nil
+}
+
 func Get() *Config {
 	return &cfg
 }
@@ -0,0 +1,108 @@
+package imgproc
+
+import (
+	"encoding/binary"
+	"image"
+	"image/draw"
+)
+
+// jpegOrientation returns value of EXIF Orientation tag of JPEG image or 1 if it is missing.
+// Variants don't have EXIF, so the orientation has to be applied to pixels.
+func jpegOrientation(data []byte) int {
+	const orientationTag = 0x0112
+
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	// Walk over JPEG segments until APP1 with EXIF header or start of scan.
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		segLen := int(binary.BigEndian.Uint16(data[i+2:]))
+		if marker == 0xDA || segLen < 2 || i+2+segLen > len(data) {
+			return 1
+		}
+
+		seg := data[i+4 : i+2+segLen]
+		if marker == 0xE1 && len(seg) > 14 && string(seg[:6]) == "Exif\x00\x00" {
+			tiff := seg[6:]
+
+			var bo binary.ByteOrder
+			switch string(tiff[:2]) {
+			case "II":
+				bo = binary.LittleEndian
+			case "MM":
+				bo = binary.BigEndian
+			default:
+				return 1
+			}
+
+			ifd := int(bo.Uint32(tiff[4:]))
+			if ifd+2 > len(tiff) {
+				return 1
+			}
+			n := int(bo.Uint16(tiff[ifd:]))
+			for e := ifd + 2; e+12 <= len(tiff) && n > 0; e, n = e+12, n-1 {
+				if bo.Uint16(tiff[e:]) == orientationTag {
+					if o := int(bo.Uint16(tiff[e+8:])); o >= 1 && o <= 8 {
+						return o
+					}
+					return 1
+				}
+			}
+			return 1
+		}
+
+		i += 2 + segLen
+	}
+
+	return 1
+}
+
+// applyOrientation transforms image so that it looks as intended with EXIF orientation.
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	src := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+
+	// Orientations 5-8 swap width and height.
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // Mirrored horizontally
+				dx, dy = w-1-x, y
+			case 3: // Rotated 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // Mirrored vertically
+				dx, dy = x, h-1-y
+			case 5: // Transposed
+				dx, dy = y, x
+			case 6: // Rotated 90 clockwise
+				dx, dy = h-1-y, x
+			case 7: // Transversed
+				dx, dy = h-1-y, w-1-x
+			case 8: // Rotated 90 counter-clockwise
+				dx, dy = y, w-1-x
+			}
+			dst.SetNRGBA(dx, dy, src.NRGBAAt(x, y))
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,152 @@
+// Package imgproc prepares uploaded images for serving: it generates downsized variants
+// and re-encodes them, which drops EXIF and other metadata of the original file.
+package imgproc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // Register WebP decoder
+)
+
+// Sizes are maximum width and height of generated variants in ascending order.
+var Sizes = []int{64, 256, 1024}
+
+// DefaultSize is the variant returned when size is not requested.
+const DefaultSize = 1024
+
+const jpegQuality = 85
+
+// MaxPixels limits width times height of processed images. Decoded image takes 4-8 bytes per pixel,
+// so tiny files declaring huge dimensions would otherwise exhaust memory.
+const MaxPixels = 40_000_000
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooLarge          = errors.New("image dimensions are too large")
+)
+
+type Variant struct {
+	Size int
+	Data []byte
+}
+
+// Result contains encoded variants for every size from Sizes.
+// Opaque images are encoded as JPEG, images with transparency as PNG.
+type Result struct {
+	Ext      string // File extension with leading dot
+	Variants []Variant
+}
+
+// Process decodes JPEG, PNG or WebP image and generates its variants.
+// Images are never upscaled, so variants of a small image may have the same dimensions.
+func Process(data []byte) (*Result, error) {
+	// Dimensions are checked from the header before the pixels are allocated
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, ErrUnsupportedFormat
+		}
+		return nil, fmt.Errorf("decoding image config: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return nil, ErrTooLarge
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, ErrUnsupportedFormat
+		}
+		return nil, fmt.Errorf("decoding image: %w", err)
+	}
+
+	if format == "jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+
+	res := &Result{Ext: ".jpg"}
+	if !isOpaque(img) {
+		res.Ext = ".png"
+	}
+
+	for _, size := range Sizes {
+		buf := &bytes.Buffer{}
+
+		resized := resize(img, size)
+		if res.Ext == ".png" {
+			err = png.Encode(buf, resized)
+		} else {
+			err = jpeg.Encode(buf, resized, &jpeg.Options{Quality: jpegQuality})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("encoding %dpx variant: %w", size, err)
+		}
+
+		res.Variants = append(res.Variants, Variant{Size: size, Data: buf.Bytes()})
+	}
+
+	return res, nil
+}
+
+// IsValidSize reports whether variants of the size are generated.
+func IsValidSize(size int) bool {
+	for _, s := range Sizes {
+		if s == size {
+			return true
+		}
+	}
+	return false
+}
+
+// VariantName returns file name of image variant, e.g. "id_64.jpg" for "id.jpg".
+func VariantName(imageID string, size int) string {
+	ext := filepath.Ext(imageID)
+	return strings.TrimSuffix(imageID, ext) + "_" + strconv.Itoa(size) + ext
+}
+
+// resize scales image down to fit into size x size square keeping aspect ratio.
+func resize(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= size && h <= size {
+		return img
+	}
+
+	if w > h {
+		h = max(1, h*size/w)
+		w = size
+	} else {
+		w = max(1, w*size/h)
+		h = size
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+
+	return dst
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a != 0xffff {
+				return false
+			}
+		}
+	}
+	return true
+}
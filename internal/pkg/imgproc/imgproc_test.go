@@ -0,0 +1,160 @@
+package imgproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodeJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, jpeg.Encode(buf, img, nil))
+	return buf.Bytes()
+}
+
+// withOrientation inserts EXIF segment with the orientation tag right after SOI marker.
+func withOrientation(data []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")  // Big endian header, IFD at offset 8
+	tiff = binary.BigEndian.AppendUint16(tiff, 1) // One entry
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3) // SHORT
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+
+	seg := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(seg)+2))
+	app1 = append(app1, seg...)
+
+	res := append([]byte{}, data[:2]...)
+	res = append(res, app1...)
+	return append(res, data[2:]...)
+}
+
+func decodeConfig(t *testing.T, data []byte) (image.Config, string) {
+	t.Helper()
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	return cfg, format
+}
+
+func TestProcess(t *testing.T) {
+	t.Run("jpeg is resized", func(t *testing.T) {
+		res, err := Process(encodeJPEG(t, 2000, 1000))
+		require.NoError(t, err)
+		require.Equal(t, ".jpg", res.Ext)
+		require.Len(t, res.Variants, len(Sizes))
+
+		for i, size := range Sizes {
+			require.Equal(t, size, res.Variants[i].Size)
+
+			cfg, format := decodeConfig(t, res.Variants[i].Data)
+			require.Equal(t, "jpeg", format)
+			require.Equal(t, size, cfg.Width)
+			require.Equal(t, size/2, cfg.Height)
+		}
+	})
+
+	t.Run("small image is not upscaled", func(t *testing.T) {
+		res, err := Process(encodeJPEG(t, 100, 50))
+		require.NoError(t, err)
+
+		cfg, _ := decodeConfig(t, res.Variants[2].Data)
+		require.Equal(t, 100, cfg.Width)
+		require.Equal(t, 50, cfg.Height)
+	})
+
+	t.Run("exif is stripped and orientation applied", func(t *testing.T) {
+		data := withOrientation(encodeJPEG(t, 300, 100), 6)
+		require.Equal(t, 6, jpegOrientation(data))
+
+		res, err := Process(data)
+		require.NoError(t, err)
+
+		for _, v := range res.Variants {
+			require.False(t, bytes.Contains(v.Data, []byte("Exif")))
+		}
+
+		cfg, _ := decodeConfig(t, res.Variants[2].Data)
+		require.Equal(t, 100, cfg.Width)
+		require.Equal(t, 300, cfg.Height)
+	})
+
+	t.Run("transparent png stays png", func(t *testing.T) {
+		img := image.NewNRGBA(image.Rect(0, 0, 512, 512))
+		buf := &bytes.Buffer{}
+		require.NoError(t, png.Encode(buf, img))
+
+		res, err := Process(buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, ".png", res.Ext)
+
+		cfg, format := decodeConfig(t, res.Variants[0].Data)
+		require.Equal(t, "png", format)
+		require.Equal(t, 64, cfg.Width)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := Process([]byte("GIF89a not really"))
+		require.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+
+	t.Run("decompression bomb", func(t *testing.T) {
+		_, err := Process(withPNGSize(t, 50000, 50000))
+		require.ErrorIs(t, err, ErrTooLarge)
+	})
+}
+
+// withPNGSize returns 1x1 PNG whose header declares the given dimensions.
+func withPNGSize(t *testing.T, w, h uint32) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, png.Encode(buf, image.NewGray(image.Rect(0, 0, 1, 1))))
+	data := buf.Bytes()
+
+	// Signature (8 bytes), IHDR length (4) and type (4) precede width and height
+	binary.BigEndian.PutUint32(data[16:], w)
+	binary.BigEndian.PutUint32(data[20:], h)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+
+	return data
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 2x1 image: red pixel on the left, blue on the right.
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	red, blue := color.NRGBA{R: 255, A: 255}, color.NRGBA{B: 255, A: 255}
+	src.SetNRGBA(0, 0, red)
+	src.SetNRGBA(1, 0, blue)
+
+	dst := applyOrientation(src, 6).(*image.NRGBA)
+	require.Equal(t, image.Rect(0, 0, 1, 2), dst.Bounds())
+	require.Equal(t, red, dst.NRGBAAt(0, 0))
+	require.Equal(t, blue, dst.NRGBAAt(0, 1))
+
+	dst = applyOrientation(src, 3).(*image.NRGBA)
+	require.Equal(t, blue, dst.NRGBAAt(0, 0))
+	require.Equal(t, red, dst.NRGBAAt(1, 0))
+}
+
+func TestVariantName(t *testing.T) {
+	require.Equal(t, "abc_64.jpg", VariantName("abc.jpg", 64))
+	require.Equal(t, "abc_1024", VariantName("abc", 1024))
+}
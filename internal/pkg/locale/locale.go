@@ -0,0 +1,97 @@
+// Package locale negotiates the content language with clients.
+package locale
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type contextKey struct{}
+
+// NewContext puts the locale in given context.
+func NewContext(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext returns the locale from given context, empty if it was not negotiated.
+func FromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(contextKey{}).(string)
+	return locale
+}
+
+// Negotiate returns the supported locale preferred by the client.
+// Explicitly requested locale takes precedence over Accept-Language header,
+// the fallback is returned if none of the client languages is supported.
+// Regional variants match their base language, e.g. "en-US" matches "en".
+func Negotiate(requested, acceptLanguage string, supported []string, fallback string) string {
+	if requested != "" {
+		if locale, ok := match(requested, supported); ok {
+			return locale
+		}
+	}
+
+	for _, tag := range parseAcceptLanguage(acceptLanguage) {
+		if tag == "*" {
+			return fallback
+		}
+		if locale, ok := match(tag, supported); ok {
+			return locale
+		}
+	}
+
+	return fallback
+}
+
+func match(tag string, supported []string) (string, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	base, _, _ := strings.Cut(tag, "-")
+
+	for _, locale := range supported {
+		if strings.EqualFold(locale, tag) || strings.EqualFold(locale, base) {
+			return locale, true
+		}
+	}
+
+	return "", false
+}
+
+// parseAcceptLanguage returns language tags ordered by quality, tags with zero quality are dropped.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+
+		tags = append(tags, weighted{tag, q})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	result := make([]string, 0, len(tags))
+	for _, t := range tags {
+		result = append(result, t.tag)
+	}
+
+	return result
+}
@@ -0,0 +1,35 @@
+package locale_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/pkg/locale"
+)
+
+func TestNegotiate(t *testing.T) {
+	supported := []string{"ru", "en"}
+
+	tests := []struct {
+		name           string
+		requested      string
+		acceptLanguage string
+		want           string
+	}{
+		{"nothing requested", "", "", "ru"},
+		{"requested explicitly", "en", "ru", "en"},
+		{"unsupported request falls back to header", "de", "en", "en"},
+		{"regional variant", "", "en-US,en;q=0.9", "en"},
+		{"quality order", "", "de, ru;q=0.5, en;q=0.8", "en"},
+		{"zero quality is excluded", "", "en;q=0, fr", "ru"},
+		{"wildcard", "", "de, *;q=0.5", "ru"},
+		{"case insensitive", "EN", "", "en"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, locale.Negotiate(tc.requested, tc.acceptLanguage, supported, "ru"))
+		})
+	}
+}
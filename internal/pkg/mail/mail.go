@@ -0,0 +1,99 @@
+// Package mail sends plain text emails.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"mime"
+	"mime/quotedprintable"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Format returns message in RFC 5322 format with CRLF line endings.
+func Format(from string, msg *Message, date time.Time) ([]byte, error) {
+	for _, v := range []string{from, msg.To, msg.Subject} {
+		if strings.ContainsAny(v, "\r\n") {
+			return nil, fmt.Errorf("header contains line break: %q", v)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from + "\r\n")
+	buf.WriteString("To: " + msg.To + "\r\n")
+	buf.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject) + "\r\n")
+	buf.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	_, err := qp.Write([]byte(strings.ReplaceAll(msg.Body, "\n", "\r\n")))
+	if err != nil {
+		return nil, fmt.Errorf("encoding body: %w", err)
+	}
+	err = qp.Close()
+	if err != nil {
+		return nil, fmt.Errorf("encoding body: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// File writes messages to .eml files in the directory instead of sending them, it is intended for local development.
+type File struct {
+	dir  string
+	from string
+}
+
+func NewFile(dir, from string) (*File, error) {
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, fmt.Errorf("creating mail directory: %w", err)
+	}
+
+	return &File{dir, from}, nil
+}
+
+func (m *File) Send(_ context.Context, msg *Message) error {
+	now := time.Now()
+
+	data, err := Format(m.from, msg, now)
+	if err != nil {
+		return fmt.Errorf("formatting message: %w", err)
+	}
+
+	name := filepath.Join(m.dir, now.Format("20060102T150405")+"-"+uuid.NewString()+".eml")
+
+	err = os.WriteFile(name, data, 0o640)
+	if err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+
+	slog.Debug("Mail saved", slog.String("to", msg.To), slog.String("file", name))
+
+	return nil
+}
+
+// Log writes messages to the log instead of sending them, it is intended for local development.
+// Bodies carry account tokens, so they are logged only at debug level.
+type Log struct{}
+
+func (Log) Send(_ context.Context, msg *Message) error {
+	slog.Info("Mail", slog.String("to", msg.To), slog.String("subject", msg.Subject))
+	slog.Debug("Mail body", slog.String("to", msg.To), slog.String("body", msg.Body))
+	return nil
+}
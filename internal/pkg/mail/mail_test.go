@@ -0,0 +1,173 @@
+package mail_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"mime/quotedprintable"
+	"net"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/pkg/mail"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	msg := &mail.Message{To: "user@example.com", Subject: "Сброс пароля", Body: "Line one\nСсылка: http://localhost/?token=abc"}
+
+	data, err := mail.Format("Web Studio <noreply@example.com>", msg, time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	header, body, ok := strings.Cut(string(data), "\r\n\r\n")
+	require.True(t, ok)
+	require.Contains(t, header, "From: Web Studio <noreply@example.com>\r\n")
+	require.Contains(t, header, "To: user@example.com\r\n")
+	require.Contains(t, header, "Subject: =?utf-8?q?")
+	require.Contains(t, header, "Date: Fri, 01 Sep 2023 12:00:00 +0000\r\n")
+
+	decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(body)))
+	require.NoError(t, err)
+	require.Equal(t, "Line one\r\nСсылка: http://localhost/?token=abc", string(decoded))
+
+	_, err = mail.Format("noreply@example.com", &mail.Message{To: "user@example.com\r\nBcc: other@example.com"}, time.Now())
+	require.Error(t, err)
+}
+
+func TestFile_Send(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "mail")
+
+	m, err := mail.NewFile(dir, "noreply@example.com")
+	require.NoError(t, err)
+
+	err = m.Send(context.Background(), &mail.Message{To: "user@example.com", Subject: "Subject", Body: "Body"})
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, ".eml", filepath.Ext(entries[0].Name()))
+
+	data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+	require.Contains(t, string(data), "To: user@example.com\r\n")
+}
+
+// fakeSMTP accepts a single message and sends it to the returned channel.
+func fakeSMTP(t *testing.T) (port int, received <-chan string) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	ch := make(chan string, 1)
+
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+
+		tp := textproto.NewConn(c)
+		_ = tp.PrintfLine("220 localhost ESMTP")
+
+		var msg strings.Builder
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+
+			cmd := strings.ToUpper(strings.Fields(line + " ")[0])
+			switch cmd {
+			case "EHLO":
+				_ = tp.PrintfLine("250-localhost")
+				_ = tp.PrintfLine("250 AUTH PLAIN")
+			case "AUTH":
+				_ = tp.PrintfLine("235 Authenticated")
+			case "MAIL", "RCPT":
+				msg.WriteString(line + "\n")
+				_ = tp.PrintfLine("250 OK")
+			case "DATA":
+				_ = tp.PrintfLine("354 Go ahead")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				msg.Write(data)
+				_ = tp.PrintfLine("250 OK")
+			case "QUIT":
+				_ = tp.PrintfLine("221 Bye")
+				ch <- msg.String()
+				return
+			default:
+				_ = tp.PrintfLine("502 Unknown command")
+			}
+		}
+	}()
+
+	_, p, _ := net.SplitHostPort(l.Addr().String())
+	port, _ = strconv.Atoi(p)
+
+	return port, ch
+}
+
+func TestLog_Send(t *testing.T) {
+	// Replaces the default logger, so the test cannot run in parallel
+	defer slog.SetDefault(slog.Default())
+
+	msg := &mail.Message{To: "user@example.com", Subject: "Password reset", Body: "http://localhost/?token=secret"}
+
+	var buf bytes.Buffer
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	require.NoError(t, mail.Log{}.Send(context.Background(), msg))
+	require.Contains(t, buf.String(), "user@example.com")
+	require.Contains(t, buf.String(), "Password reset")
+	require.NotContains(t, buf.String(), "token=secret")
+
+	buf.Reset()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	require.NoError(t, mail.Log{}.Send(context.Background(), msg))
+	require.Contains(t, buf.String(), "token=secret")
+}
+
+func TestSMTP_Send(t *testing.T) {
+	t.Parallel()
+
+	port, received := fakeSMTP(t)
+
+	m := mail.NewSMTP(mail.SMTPConfig{
+		Host:     "localhost",
+		Port:     port,
+		Username: "user",
+		Password: "password",
+		From:     "Web Studio <noreply@example.com>",
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := m.Send(ctx, &mail.Message{To: "user@example.com", Subject: "Subject", Body: "Body"})
+	require.NoError(t, err)
+
+	msg := <-received
+	require.True(t, strings.HasPrefix(msg, "MAIL FROM:<noreply@example.com>"), msg)
+	require.Contains(t, msg, "RCPT TO:<user@example.com>")
+	require.Contains(t, msg, "Subject: Subject\n")
+	require.Contains(t, msg, "Body")
+}
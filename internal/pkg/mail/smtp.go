@@ -0,0 +1,99 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // Authentication is skipped if empty
+	Password string
+	From     string // Address with optional display name: "Web Studio <noreply@example.com>"
+}
+
+// SMTP sends messages to SMTP server, STARTTLS is used if the server supports it.
+type SMTP struct {
+	cfg SMTPConfig
+}
+
+func NewSMTP(cfg SMTPConfig) *SMTP {
+	return &SMTP{cfg}
+}
+
+func (m *SMTP) Send(ctx context.Context, msg *Message) error {
+	from, err := netmail.ParseAddress(m.cfg.From)
+	if err != nil {
+		return fmt.Errorf("parsing sender address: %w", err)
+	}
+
+	data, err := Format(m.cfg.From, msg, time.Now())
+	if err != nil {
+		return fmt.Errorf("formatting message: %w", err)
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port)))
+	if err != nil {
+		return fmt.Errorf("connecting to smtp server: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, m.cfg.Host)
+	if err != nil {
+		return fmt.Errorf("creating smtp client: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err = c.StartTLS(&tls.Config{ServerName: m.cfg.Host})
+		if err != nil {
+			return fmt.Errorf("starting tls: %w", err)
+		}
+	}
+
+	if m.cfg.Username != "" {
+		err = c.Auth(smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host))
+		if err != nil {
+			return fmt.Errorf("authenticating: %w", err)
+		}
+	}
+
+	err = c.Mail(from.Address)
+	if err != nil {
+		return fmt.Errorf("setting sender: %w", err)
+	}
+
+	err = c.Rcpt(msg.To)
+	if err != nil {
+		return fmt.Errorf("setting recipient: %w", err)
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("starting data: %w", err)
+	}
+
+	_, err = w.Write(data)
+	if err != nil {
+		return fmt.Errorf("writing data: %w", err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		return fmt.Errorf("finishing data: %w", err)
+	}
+
+	return c.Quit()
+}
@@ -0,0 +1,431 @@
+// Package markdown renders a safe subset of Markdown to HTML.
+//
+// Raw HTML is not supported, it is escaped like any other text, and links are limited to
+// http, https, mailto and relative URLs, so the output can be embedded into pages as is.
+// Supported blocks are paragraphs, headings, fenced code, block quotes, flat lists and rules;
+// inline elements are emphasis, strong emphasis, strikethrough, code spans and links.
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+)
+
+// lineBreak marks hard line breaks in paragraph text, the character is removed from the source.
+const lineBreak = "\x00"
+
+// Each level of nesting scans its text again, so nesting is limited to bound the cost of rendering.
+const (
+	// maxQuoteDepth limits nesting of block quotes, deeper quote markers are rendered as text.
+	maxQuoteDepth = 8
+	// maxInlineDepth limits nesting of emphasis and links, deeper delimiters are rendered as text.
+	maxInlineDepth = 8
+)
+
+// Render converts Markdown source to sanitized HTML.
+func Render(src string) string {
+	src = strings.ReplaceAll(src, lineBreak, "")
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+
+	var b strings.Builder
+	renderBlocks(&b, strings.Split(src, "\n"), 0)
+
+	return b.String()
+}
+
+func renderBlocks(b *strings.Builder, lines []string, depth int) {
+	for i := 0; i < len(lines); {
+		line := strings.TrimSpace(lines[i])
+
+		switch {
+		case line == "":
+			i++
+
+		case strings.HasPrefix(line, "```"):
+			i++
+			start := i
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+				i++
+			}
+			code := strings.Join(lines[start:i], "\n")
+			if i < len(lines) {
+				i++ // Closing fence
+			}
+
+			b.WriteString("<pre><code>")
+			b.WriteString(html.EscapeString(code))
+			b.WriteString("</code></pre>\n")
+
+		case isRule(line):
+			i++
+			b.WriteString("<hr>\n")
+
+		case headingLevel(line) > 0:
+			i++
+			level := headingLevel(line)
+			text := strings.TrimSpace(strings.TrimRight(line[level:], "#"))
+
+			fmt.Fprintf(b, "<h%d>", level)
+			renderInline(b, text, 0)
+			fmt.Fprintf(b, "</h%d>\n", level)
+
+		case depth < maxQuoteDepth && strings.HasPrefix(line, ">"):
+			var quoted []string
+			for i < len(lines) {
+				l := strings.TrimSpace(lines[i])
+				if !strings.HasPrefix(l, ">") {
+					break
+				}
+				quoted = append(quoted, strings.TrimPrefix(l[1:], " "))
+				i++
+			}
+
+			b.WriteString("<blockquote>\n")
+			renderBlocks(b, quoted, depth+1)
+			b.WriteString("</blockquote>\n")
+
+		case isListItem(line):
+			_, ordered := listItem(line)
+			tag := "ul"
+			if ordered {
+				tag = "ol"
+			}
+
+			b.WriteString("<" + tag + ">\n")
+			for i < len(lines) {
+				item, itemOrdered := listItem(strings.TrimSpace(lines[i]))
+				if !isListItem(strings.TrimSpace(lines[i])) || itemOrdered != ordered {
+					break
+				}
+				i++
+
+				// Indented lines continue the item
+				text := []string{item}
+				for i < len(lines) && isIndented(lines[i]) && !isListItem(strings.TrimSpace(lines[i])) {
+					text = append(text, strings.TrimSpace(lines[i]))
+					i++
+				}
+
+				b.WriteString("<li>")
+				renderInline(b, strings.Join(text, "\n"), 0)
+				b.WriteString("</li>\n")
+			}
+			b.WriteString("</" + tag + ">\n")
+
+		default:
+			var text []string
+			for i < len(lines) {
+				l := strings.TrimSpace(lines[i])
+				if l == "" || (len(text) > 0 && startsBlock(l)) {
+					break
+				}
+				// Two trailing spaces or a backslash break the line
+				if strings.HasSuffix(lines[i], "  ") || strings.HasSuffix(l, `\`) {
+					l = strings.TrimSuffix(l, `\`) + lineBreak
+				}
+				text = append(text, l)
+				i++
+			}
+
+			b.WriteString("<p>")
+			renderInline(b, strings.TrimSuffix(strings.Join(text, "\n"), lineBreak), 0)
+			b.WriteString("</p>\n")
+		}
+	}
+}
+
+func startsBlock(line string) bool {
+	return strings.HasPrefix(line, "```") ||
+		strings.HasPrefix(line, ">") ||
+		isRule(line) ||
+		headingLevel(line) > 0 ||
+		isListItem(line)
+}
+
+// headingLevel returns level of ATX heading, zero if the line is not a heading.
+func headingLevel(line string) int {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(line) && line[level] != ' ') {
+		return 0
+	}
+	return level
+}
+
+// isRule reports whether the line is a thematic break: three or more of the same -, * or _ characters.
+func isRule(line string) bool {
+	s := strings.ReplaceAll(line, " ", "")
+	if len(s) < 3 || !strings.ContainsRune("-*_", rune(s[0])) {
+		return false
+	}
+	return strings.Count(s, s[:1]) == len(s)
+}
+
+func isListItem(line string) bool {
+	item, _ := listItem(line)
+	return item != "" || line == "-" || line == "*" || line == "+"
+}
+
+// listItem returns text of the list item and whether the list is ordered.
+// Text is empty if the line is not a list item.
+func listItem(line string) (string, bool) {
+	if len(line) > 1 && strings.ContainsRune("-*+", rune(line[0])) && line[1] == ' ' && !isRule(line) {
+		return strings.TrimSpace(line[2:]), false
+	}
+
+	digits := 0
+	for digits < len(line) && digits < 9 && line[digits] >= '0' && line[digits] <= '9' {
+		digits++
+	}
+	if digits > 0 && digits+1 < len(line) && (line[digits] == '.' || line[digits] == ')') && line[digits+1] == ' ' {
+		return strings.TrimSpace(line[digits+2:]), true
+	}
+
+	return "", false
+}
+
+func isIndented(line string) bool {
+	return strings.TrimSpace(line) != "" && (strings.HasPrefix(line, "  ") || strings.HasPrefix(line, "\t"))
+}
+
+func renderInline(b *strings.Builder, s string, depth int) {
+	var text strings.Builder
+	flush := func() {
+		writeText(b, text.String())
+		text.Reset()
+	}
+
+	// Delimiters which have no closing one after an opener, they have none after later openers either,
+	// so the rest of the text is searched once for each of them
+	unclosed := make(map[string]bool)
+	// Closing brackets of link labels and the next '>' of autolinks are found once for the whole text
+	var brackets map[int]int
+	nextGT := -1
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch c {
+		case '\\':
+			if i+1 < len(s) && isPunct(s[i+1]) {
+				text.WriteByte(s[i+1])
+				i += 2
+				continue
+			}
+
+		case '`':
+			n := runLength(s, i)
+			delim := s[i : i+n]
+			if !unclosed[delim] {
+				if end := strings.Index(s[i+n:], delim); end >= 0 {
+					flush()
+					b.WriteString("<code>")
+					writeText(b, strings.TrimSpace(s[i+n:i+n+end]))
+					b.WriteString("</code>")
+					i += n + end + n
+					continue
+				}
+				unclosed[delim] = true
+			}
+			text.WriteString(delim)
+			i += n
+			continue
+
+		case '*', '_', '~':
+			if depth < maxInlineDepth {
+				if inner, n, ok := emphasis(s, i, unclosed); ok {
+					tag := "em"
+					switch {
+					case c == '~':
+						tag = "del"
+					case n == 2:
+						tag = "strong"
+					}
+
+					flush()
+					b.WriteString("<" + tag + ">")
+					renderInline(b, inner, depth+1)
+					b.WriteString("</" + tag + ">")
+					i += n + len(inner) + n
+					continue
+				}
+			}
+			n := runLength(s, i)
+			text.WriteString(s[i : i+n])
+			i += n
+			continue
+
+		case '[':
+			if depth < maxInlineDepth {
+				if brackets == nil {
+					brackets = matchBrackets(s)
+				}
+				if label, href, end, ok := link(s, i, brackets[i]); ok {
+					flush()
+					if safeURL(href) {
+						b.WriteString(`<a href="` + html.EscapeString(href) + `" rel="nofollow noopener">`)
+						renderInline(b, label, depth+1)
+						b.WriteString("</a>")
+					} else {
+						renderInline(b, label, depth+1)
+					}
+					i = end
+					continue
+				}
+			}
+
+		case '<':
+			// Autolink
+			if nextGT < i {
+				nextGT = strings.IndexByte(s[i:], '>')
+				if nextGT < 0 {
+					nextGT = len(s)
+				} else {
+					nextGT += i
+				}
+			}
+			if end := nextGT - i; nextGT < len(s) && end > 0 {
+				href := s[i+1 : i+end]
+				if !strings.ContainsAny(href, " \n") && safeURL(href) && strings.Contains(href, ":") {
+					flush()
+					b.WriteString(`<a href="` + html.EscapeString(href) + `" rel="nofollow noopener">`)
+					writeText(b, strings.TrimPrefix(href, "mailto:"))
+					b.WriteString("</a>")
+					i += end + 1
+					continue
+				}
+			}
+		}
+
+		text.WriteByte(c)
+		i++
+	}
+
+	flush()
+}
+
+// emphasis parses emphasis delimited by *, _ or ~ starting at i.
+// Returns the emphasized text and length of the delimiter.
+// Whether a delimiter closes emphasis doesn't depend on the opener, so delimiters found unclosed are remembered
+// and not searched for again.
+func emphasis(s string, i int, unclosed map[string]bool) (string, int, bool) {
+	c := s[i]
+	n := min(runLength(s, i), 2)
+	if c == '~' && n < 2 {
+		return "", 0, false
+	}
+	// Underscores inside words are literal, e.g. snake_case
+	if c == '_' && i > 0 && isAlnum(s[i-1]) {
+		return "", 0, false
+	}
+
+	start := i + n
+	if start >= len(s) || s[start] == ' ' || s[start] == '\n' {
+		return "", 0, false
+	}
+
+	delim := s[i:start]
+	if unclosed[delim] {
+		return "", 0, false
+	}
+	for j := start + 1; j+n <= len(s); j++ {
+		if s[j:j+n] != delim || s[j-1] == ' ' || s[j-1] == '\n' {
+			continue
+		}
+		// Single delimiter must not close on a part of a double one
+		if n == 1 && ((j+1 < len(s) && s[j+1] == c) || s[j-1] == c) {
+			j++
+			continue
+		}
+		if c == '_' && j+n < len(s) && isAlnum(s[j+n]) {
+			continue
+		}
+		return s[start:j], n, true
+	}
+
+	unclosed[delim] = true
+	return "", 0, false
+}
+
+// link parses [label](href) starting at i, j is the matching closing bracket or zero if there is none.
+// Returns the label, href and position after the link.
+func link(s string, i, j int) (string, string, int, bool) {
+	if j == 0 || j+1 >= len(s) || s[j+1] != '(' {
+		return "", "", 0, false
+	}
+	end := strings.IndexByte(s[j+2:], ')')
+	if end < 0 {
+		return "", "", 0, false
+	}
+	href := strings.TrimSpace(s[j+2 : j+2+end])
+	if strings.ContainsAny(href, " \n") {
+		return "", "", 0, false
+	}
+	return s[i+1 : j], href, j + 2 + end + 1, true
+}
+
+// matchBrackets maps positions of opening square brackets to positions of their closing ones.
+// Brackets without a pair are left out.
+func matchBrackets(s string) map[int]int {
+	pairs := make(map[int]int)
+
+	var open []int
+	for j := 0; j < len(s); j++ {
+		switch s[j] {
+		case '[':
+			open = append(open, j)
+		case ']':
+			if len(open) > 0 {
+				pairs[open[len(open)-1]] = j
+				open = open[:len(open)-1]
+			}
+		}
+	}
+
+	return pairs
+}
+
+// safeURL reports whether the link can't run scripts: only http, https, mailto and relative URLs are allowed.
+func safeURL(href string) bool {
+	if href == "" || strings.HasPrefix(href, "//") {
+		return false
+	}
+
+	u, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "mailto":
+		return true
+	case "":
+		return strings.HasPrefix(href, "/") || strings.HasPrefix(href, "#")
+	default:
+		return false
+	}
+}
+
+func writeText(b *strings.Builder, s string) {
+	b.WriteString(strings.ReplaceAll(html.EscapeString(s), lineBreak, "<br>"))
+}
+
+func runLength(s string, i int) int {
+	n := 1
+	for i+n < len(s) && s[i+n] == s[i] {
+		n++
+	}
+	return n
+}
+
+func isAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+func isPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
@@ -0,0 +1,120 @@
+package markdown_test
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/pkg/markdown"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"empty", "", ""},
+		{"paragraphs", "First line\nsame paragraph\n\nSecond", "<p>First line\nsame paragraph</p>\n<p>Second</p>\n"},
+		{"hard break", "First  \nSecond", "<p>First<br>\nSecond</p>\n"},
+		{"heading", "## Case *study* ##", "<h2>Case <em>study</em></h2>\n"},
+		{"not a heading", "#hashtag", "<p>#hashtag</p>\n"},
+		{"emphasis", "**bold**, *italic*, _also_ and ~~gone~~", "<p><strong>bold</strong>, <em>italic</em>, <em>also</em> and <del>gone</del></p>\n"},
+		{"nested emphasis", "*a **b** c*", "<p><em>a <strong>b</strong> c</em></p>\n"},
+		{"intraword underscore", "snake_case_name", "<p>snake_case_name</p>\n"},
+		{"unclosed emphasis", "2 * 3 = 6", "<p>2 * 3 = 6</p>\n"},
+		{"escaped delimiter", `\*not em\*`, "<p>*not em*</p>\n"},
+		{"code span", "Run `go <test>`", "<p>Run <code>go &lt;test&gt;</code></p>\n"},
+		{"fenced code", "```go\nif a < b {\n```", "<pre><code>if a &lt; b {</code></pre>\n"},
+		{"unordered list", "- one\n- *two*\n  continued", "<ul>\n<li>one</li>\n<li><em>two</em>\ncontinued</li>\n</ul>\n"},
+		{"ordered list", "1. one\n2. two", "<ol>\n<li>one</li>\n<li>two</li>\n</ol>\n"},
+		{"block quote", "> quoted\n> - item", "<blockquote>\n<p>quoted</p>\n<ul>\n<li>item</li>\n</ul>\n</blockquote>\n"},
+		{"rule", "a\n\n***\n\nb", "<p>a</p>\n<hr>\n<p>b</p>\n"},
+		{"link", "[our *site*](https://example.com/?a=1&b=2)", `<p><a href="https://example.com/?a=1&amp;b=2" rel="nofollow noopener">our <em>site</em></a></p>` + "\n"},
+		{"relative link", "[team](/teams/web)", `<p><a href="/teams/web" rel="nofollow noopener">team</a></p>` + "\n"},
+		{"autolink", "<mailto:hi@example.com>", `<p><a href="mailto:hi@example.com" rel="nofollow noopener">hi@example.com</a></p>` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, markdown.Render(tt.src))
+		})
+	}
+}
+
+func TestRender_Sanitizes(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"raw html", `<script>alert("x")</script>`, "<p>&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;</p>\n"},
+		{"javascript link", "[click](javascript:alert(1))", "<p>click)</p>\n"},
+		{"mixed case scheme", "[click](JavaScript:alert)", "<p>click</p>\n"},
+		{"data link", "[click](data:text/html;base64,PHNjcmlwdD4=)", "<p>click</p>\n"},
+		{"protocol relative link", "[click](//evil.com)", "<p>click</p>\n"},
+		{"attribute injection", `[x](https://a.com/"onmouseover="alert)`, `<p><a href="https://a.com/&#34;onmouseover=&#34;alert" rel="nofollow noopener">x</a></p>` + "\n"},
+		{"script autolink", "<javascript:alert(1)>", "<p>&lt;javascript:alert(1)&gt;</p>\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, markdown.Render(tt.src))
+		})
+	}
+}
+
+func TestRender_LimitsNesting(t *testing.T) {
+	src := "x"
+	for i := 0; i < 10; i++ {
+		src = "[" + src + "](/" + strconv.Itoa(i) + ")"
+	}
+
+	out := markdown.Render(src)
+	require.Equal(t, 8, strings.Count(out, "<a "))
+	require.Contains(t, out, "[[x](/0)](/1)")
+
+	out = markdown.Render(strings.Repeat("> ", 10) + "x")
+	require.Equal(t, 8, strings.Count(out, "<blockquote>"))
+	require.Contains(t, out, "<p>&gt; &gt; x</p>")
+}
+
+func TestRender_UnclosedDelimiters(t *testing.T) {
+	// Every opener used to search the rest of the text for a closing delimiter
+	src := strings.TrimSpace(strings.Repeat("*a _b ~~c ", 50000))
+	require.True(t, markdown.Render(src) == "<p>"+src+"</p>\n")
+}
+
+var (
+	allowedTag = regexp.MustCompile(`</?(p|h[1-6]|pre|code|hr|blockquote|ul|ol|li|em|strong|del|br|a)>|<a href="[^"<>]*" rel="nofollow noopener">`)
+	linkHref   = regexp.MustCompile(`<a href="([^"]*)"`)
+)
+
+func FuzzRender(f *testing.F) {
+	for _, src := range []string{
+		"**bold** and *em* with `code`",
+		"[link](https://example.com) <https://example.com>",
+		"[x](javascript:alert(1)) <javascript:alert(1)>",
+		"[x](JaVaScRiPt:alert) [y](java\nscript:alert)",
+		`<script>alert("x")</script>`,
+		"> quote\n- item\n  more\n1. one\n\n```\n<b>\n```",
+		"*a **b** c* _d_ ~~e~~ [*f*](/g)",
+	} {
+		f.Add(src)
+	}
+
+	f.Fuzz(func(t *testing.T, src string) {
+		out := markdown.Render(src)
+
+		require.NotContains(t, allowedTag.ReplaceAllString(out, ""), "<", "unescaped tag in %q", out)
+
+		for _, m := range linkHref.FindAllStringSubmatch(out, -1) {
+			href := strings.ToLower(strings.Join(strings.Fields(html.UnescapeString(m[1])), ""))
+			require.False(t, strings.HasPrefix(href, "javascript:"), "script link in %q", out)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package oidc_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/pkg/oidc"
+	"web-studio-backend/internal/pkg/oidc/oidctest"
+)
+
+func TestProvider_Authenticate(t *testing.T) {
+	ctx := context.Background()
+
+	server := oidctest.NewServer("client", "secret")
+	defer server.Close()
+
+	server.SetUser(oidctest.User{Subject: "42", Email: "user@mail.com", EmailVerified: true, Name: "User"})
+
+	provider := oidc.NewProvider(oidc.Config{
+		Issuer:       server.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost:3000/sso/callback",
+		Scopes:       []string{"email", "profile"},
+	}, nil)
+
+	authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", "verifier")
+	require.NoError(t, err)
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	require.Equal(t, "openid email profile", u.Query().Get("scope"))
+	require.Equal(t, "S256", u.Query().Get("code_challenge_method"))
+
+	t.Run("should return claims", func(t *testing.T) {
+		code, state, err := server.Authorize(authURL)
+		require.NoError(t, err)
+		require.Equal(t, "state", state)
+
+		claims, err := provider.Authenticate(ctx, code, "verifier", "nonce")
+		require.NoError(t, err)
+		require.Equal(t, "42", claims.Subject)
+		require.Equal(t, "user@mail.com", claims.Email)
+		require.True(t, bool(claims.EmailVerified))
+	})
+
+	t.Run("should fail on wrong verifier", func(t *testing.T) {
+		code, _, err := server.Authorize(authURL)
+		require.NoError(t, err)
+
+		_, err = provider.Authenticate(ctx, code, "other", "nonce")
+		require.Error(t, err)
+	})
+
+	t.Run("should fail on wrong nonce", func(t *testing.T) {
+		code, _, err := server.Authorize(authURL)
+		require.NoError(t, err)
+
+		_, err = provider.Authenticate(ctx, code, "verifier", "other")
+		require.ErrorIs(t, err, oidc.ErrInvalidToken)
+	})
+
+	t.Run("should fail on expired token", func(t *testing.T) {
+		server.Claims = func(claims map[string]any) {
+			claims["exp"] = time.Now().Add(-time.Hour).Unix()
+		}
+		defer func() { server.Claims = nil }()
+
+		code, _, err := server.Authorize(authURL)
+		require.NoError(t, err)
+
+		_, err = provider.Authenticate(ctx, code, "verifier", "nonce")
+		require.ErrorIs(t, err, oidc.ErrInvalidToken)
+	})
+
+	t.Run("should fail on another audience", func(t *testing.T) {
+		server.Claims = func(claims map[string]any) {
+			claims["aud"] = []string{"another"}
+		}
+		defer func() { server.Claims = nil }()
+
+		code, _, err := server.Authorize(authURL)
+		require.NoError(t, err)
+
+		_, err = provider.Authenticate(ctx, code, "verifier", "nonce")
+		require.ErrorIs(t, err, oidc.ErrInvalidToken)
+	})
+}
@@ -0,0 +1,69 @@
+// Package passhash provides password hashers producing PHC formatted strings
+// (https://github.com/P-H-C/phc-string-format).
+package passhash
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownFormat is returned by Hasher.Verify when the encoded hash was produced by another algorithm.
+var ErrUnknownFormat = errors.New("unknown password hash format")
+
+type Hasher interface {
+	// Hash returns encoded hash of the password.
+	Hash(password string) (string, error)
+	// Verify reports whether the password matches the encoded hash.
+	Verify(password, encoded string) (bool, error)
+	// NeedsRehash reports whether the encoded hash must be replaced with a fresh one,
+	// because it was produced by another algorithm or with outdated parameters.
+	NeedsRehash(encoded string) bool
+}
+
+// New returns hasher which hashes passwords with the given algorithm
+// and verifies hashes produced by any supported algorithm.
+//
+// Supported algorithms: argon2id, bcrypt.
+func New(algorithm string) (Hasher, error) {
+	var (
+		argon  = NewArgon2id()
+		bcrypt = NewBcrypt()
+		legacy = SHA512{}
+	)
+
+	switch algorithm {
+	case "argon2id":
+		return &Upgrading{Current: argon, Legacy: []Hasher{bcrypt, legacy}}, nil
+	case "bcrypt":
+		return &Upgrading{Current: bcrypt, Legacy: []Hasher{argon, legacy}}, nil
+	default:
+		return nil, fmt.Errorf("unknown password hashing algorithm %q", algorithm)
+	}
+}
+
+// Upgrading hashes passwords with the current hasher, but also verifies hashes of the legacy ones.
+// Every hash which was not produced by the current hasher needs rehash.
+type Upgrading struct {
+	Current Hasher
+	Legacy  []Hasher
+}
+
+func (u *Upgrading) Hash(password string) (string, error) {
+	return u.Current.Hash(password)
+}
+
+func (u *Upgrading) Verify(password, encoded string) (bool, error) {
+	for _, h := range append([]Hasher{u.Current}, u.Legacy...) {
+		ok, err := h.Verify(password, encoded)
+		if errors.Is(err, ErrUnknownFormat) {
+			continue
+		}
+		return ok, err
+	}
+
+	return false, ErrUnknownFormat
+}
+
+func (u *Upgrading) NeedsRehash(encoded string) bool {
+	return u.Current.NeedsRehash(encoded)
+}
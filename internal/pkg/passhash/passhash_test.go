@@ -0,0 +1,75 @@
+package passhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashers(t *testing.T) {
+	tests := []struct {
+		name   string
+		hasher Hasher
+	}{
+		{
+			name:   "argon2id",
+			hasher: &Argon2id{Time: 1, Memory: 1024, Threads: 1, KeyLen: 32, SaltLen: 16},
+		},
+		{
+			name:   "bcrypt",
+			hasher: &Bcrypt{Cost: 4},
+		},
+		{
+			name:   "sha512",
+			hasher: SHA512{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			encoded, err := tc.hasher.Hash("password123")
+			require.NoError(tt, err)
+
+			ok, err := tc.hasher.Verify("password123", encoded)
+			require.NoError(tt, err)
+			require.True(tt, ok)
+
+			ok, err = tc.hasher.Verify("password1234", encoded)
+			require.NoError(tt, err)
+			require.False(tt, ok)
+		})
+	}
+}
+
+func TestUpgrading(t *testing.T) {
+	var (
+		argon  = &Argon2id{Time: 1, Memory: 1024, Threads: 1, KeyLen: 32, SaltLen: 16}
+		bcrypt = &Bcrypt{Cost: 4}
+		h      = &Upgrading{Current: argon, Legacy: []Hasher{bcrypt, SHA512{}}}
+	)
+
+	current, err := h.Hash("password123")
+	require.NoError(t, err)
+	require.False(t, h.NeedsRehash(current))
+
+	legacy, err := SHA512{}.Hash("password123")
+	require.NoError(t, err)
+	require.True(t, h.NeedsRehash(legacy))
+
+	outdated, err := bcrypt.Hash("password123")
+	require.NoError(t, err)
+	require.True(t, h.NeedsRehash(outdated))
+
+	for _, encoded := range []string{current, legacy, outdated} {
+		ok, err := h.Verify("password123", encoded)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	// Parameters changed since the hash was produced
+	stronger := &Upgrading{Current: &Argon2id{Time: 2, Memory: 1024, Threads: 1, KeyLen: 32, SaltLen: 16}}
+	require.True(t, stronger.NeedsRehash(current))
+
+	_, err = h.Verify("password123", "plain")
+	require.ErrorIs(t, err, ErrUnknownFormat)
+}
@@ -0,0 +1,55 @@
+package passhash
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"web-studio-backend/internal/pkg/strhelp"
+)
+
+const sha512ID = "sha512"
+
+// SHA512 is the legacy salted SHA-512 hasher.
+// It is kept only to verify passwords of users created before migration to modern algorithms.
+//
+// Encoded format: $sha512$<salt>$<hex hash>
+type SHA512 struct{}
+
+// FormatSHA512 builds encoded hash from legacy salt and hex hash stored separately.
+func FormatSHA512(salt, hash string) string {
+	return fmt.Sprintf("$%s$%s$%s", sha512ID, salt, hash)
+}
+
+func (SHA512) Hash(password string) (string, error) {
+	salt, err := strhelp.GenerateRandomString(32)
+	if err != nil {
+		return "", fmt.Errorf("generating salt: %w", err)
+	}
+
+	sum := sha512.Sum512([]byte(password + salt))
+
+	return FormatSHA512(salt, hex.EncodeToString(sum[:])), nil
+}
+
+func (SHA512) Verify(password, encoded string) (bool, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 4 || parts[1] != sha512ID {
+		return false, ErrUnknownFormat
+	}
+
+	salt, hash := parts[2], parts[3]
+	if salt == "" || hash == "" {
+		return false, nil
+	}
+
+	sum := sha512.Sum512([]byte(password + salt))
+
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(hash)) == 1, nil
+}
+
+func (SHA512) NeedsRehash(string) bool {
+	return true
+}
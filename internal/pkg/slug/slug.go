@@ -0,0 +1,82 @@
+// Package slug makes URL-friendly identifiers from titles.
+package slug
+
+import "strings"
+
+// MaxLength is the maximum length of a slug.
+const MaxLength = 80
+
+// cyrillic maps lower case Cyrillic letters to their Latin transliteration.
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u",
+}
+
+// Make returns lower case slug of Latin letters and digits separated by hyphens.
+// Cyrillic letters are transliterated, other characters are dropped, so the result may be empty.
+func Make(s string) string {
+	var b strings.Builder
+
+	hyphen := false
+	write := func(s string) {
+		if hyphen && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		hyphen = false
+		b.WriteString(s)
+	}
+
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case 'a' <= r && r <= 'z', '0' <= r && r <= '9':
+			write(string(r))
+		default:
+			latin, ok := cyrillic[r]
+			if !ok {
+				hyphen = true
+			} else if latin != "" {
+				write(latin)
+			}
+		}
+	}
+
+	return truncate(b.String())
+}
+
+// Valid reports whether s is a slug which Make could return: non-empty, not longer than MaxLength,
+// of lower case Latin letters and digits separated by single hyphens.
+func Valid(s string) bool {
+	if s == "" || len(s) > MaxLength {
+		return false
+	}
+
+	prev := '-'
+	for _, r := range s {
+		switch {
+		case 'a' <= r && r <= 'z', '0' <= r && r <= '9':
+		case r == '-' && prev != '-':
+		default:
+			return false
+		}
+		prev = r
+	}
+
+	return prev != '-'
+}
+
+// truncate cuts the slug to MaxLength at a word boundary if possible.
+func truncate(s string) string {
+	if len(s) <= MaxLength {
+		return s
+	}
+
+	s = s[:MaxLength]
+	if i := strings.LastIndexByte(s, '-'); i > 0 {
+		s = s[:i]
+	}
+
+	return strings.TrimSuffix(s, "-")
+}
@@ -0,0 +1,59 @@
+package slug_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"web-studio-backend/internal/pkg/slug"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Web Studio", "web-studio"},
+		{"  CRM: v2.0 (beta)!  ", "crm-v2-0-beta"},
+		{"already-a-slug", "already-a-slug"},
+		{"Портфолио", "portfolio"},
+		{"Съёмка: Щука и Ёж", "semka-shchuka-i-ezh"},
+		{"Їжак у Києві", "yizhak-u-kiyevi"},
+		{"日本", ""},
+		{"", ""},
+		{strings.Repeat("word ", 30), strings.TrimSuffix(strings.Repeat("word-", 16), "-")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			got := slug.Make(tc.in)
+			require.Equal(t, tc.want, got)
+			require.LessOrEqual(t, len(got), slug.MaxLength)
+		})
+	}
+}
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"web-studio", true},
+		{"crm2", true},
+		{"", false},
+		{"Web-Studio", false},
+		{"-web", false},
+		{"web-", false},
+		{"web--studio", false},
+		{"web studio", false},
+		{"портфолио", false},
+		{strings.Repeat("a", slug.MaxLength+1), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			require.Equal(t, tc.want, slug.Valid(tc.in))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package totp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	// Test vectors of RFC 6238 for SHA1
+	key := []byte("12345678901234567890")
+
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.code, generate(key, Step(time.Unix(tc.unix, 0)), 8))
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := Code(secret, now)
+	require.NoError(t, err)
+
+	step, ok := Validate(secret, code, now)
+	require.True(t, ok)
+	require.Equal(t, Step(now), step)
+
+	_, ok = Validate(secret, code, now.Add(Period))
+	require.True(t, ok, "previous period code must be accepted")
+
+	_, ok = Validate(secret, code, now.Add(3*Period))
+	require.False(t, ok)
+
+	_, ok = Validate(secret, "12345", now)
+	require.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Web Studio", "user@mail.com", "JBSWY3DPEHPK3PXP")
+	require.Equal(t, "otpauth://totp/Web%20Studio:user@mail.com?algorithm=SHA1&digits=6&issuer=Web+Studio&period=30&secret=JBSWY3DPEHPK3PXP", uri)
+}
@@ -0,0 +1,18 @@
+ALTER TABLE board_task_members
+    DROP CONSTRAINT board_task_members_pkey,
+    DROP CONSTRAINT board_task_members_task_id_fkey,
+    ADD CONSTRAINT board_task_members_task_id_fkey FOREIGN KEY (task_id) REFERENCES board_tasks (id);
+
+ALTER TABLE board_tasks
+    DROP CONSTRAINT board_tasks_column_id_fkey,
+    ADD CONSTRAINT board_tasks_column_id_fkey FOREIGN KEY (column_id) REFERENCES board_columns (id),
+    DROP CONSTRAINT board_tasks_board_id_fkey,
+    ADD CONSTRAINT board_tasks_board_id_fkey FOREIGN KEY (board_id) REFERENCES boards (id);
+
+ALTER TABLE board_columns
+    DROP CONSTRAINT board_columns_board_id_fkey,
+    ADD CONSTRAINT board_columns_board_id_fkey FOREIGN KEY (board_id) REFERENCES boards (id);
+
+ALTER TABLE boards
+    DROP CONSTRAINT boards_project_id_fkey,
+    ADD CONSTRAINT boards_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
\ No newline at end of file
@@ -0,0 +1,26 @@
+ALTER TABLE boards
+    DROP CONSTRAINT boards_project_id_fkey,
+    ADD CONSTRAINT boards_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE;
+
+ALTER TABLE board_columns
+    DROP CONSTRAINT board_columns_board_id_fkey,
+    ADD CONSTRAINT board_columns_board_id_fkey FOREIGN KEY (board_id) REFERENCES boards (id) ON DELETE CASCADE;
+
+ALTER TABLE board_tasks
+    DROP CONSTRAINT board_tasks_board_id_fkey,
+    ADD CONSTRAINT board_tasks_board_id_fkey FOREIGN KEY (board_id) REFERENCES boards (id) ON DELETE CASCADE,
+    DROP CONSTRAINT board_tasks_column_id_fkey,
+    ADD CONSTRAINT board_tasks_column_id_fkey FOREIGN KEY (column_id) REFERENCES board_columns (id) ON DELETE CASCADE;
+
+-- Task members had no key, remove repeated rows before adding it
+DELETE
+FROM board_task_members a
+    USING board_task_members b
+WHERE a.task_id = b.task_id
+  AND a.user_id = b.user_id
+  AND a.ctid > b.ctid;
+
+ALTER TABLE board_task_members
+    DROP CONSTRAINT board_task_members_task_id_fkey,
+    ADD CONSTRAINT board_task_members_task_id_fkey FOREIGN KEY (task_id) REFERENCES board_tasks (id) ON DELETE CASCADE,
+    ADD PRIMARY KEY (task_id, user_id);
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE team_members DROP CONSTRAINT team_members_pkey;
+ALTER TABLE team_members DROP COLUMN updated_at;
\ No newline at end of file
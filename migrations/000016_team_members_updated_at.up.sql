@@ -0,0 +1,12 @@
+ALTER TABLE team_members ADD COLUMN updated_at timestamptz NOT NULL DEFAULT now();
+
+-- Team members had no key, remove repeated rows before adding it
+DELETE
+FROM team_members a
+    USING team_members b
+WHERE a.user_id = b.user_id
+  AND a.team_id = b.team_id
+  AND a.ctid > b.ctid;
+
+ALTER TABLE team_members
+    ADD PRIMARY KEY (user_id, team_id);
\ No newline at end of file
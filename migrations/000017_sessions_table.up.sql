@@ -0,0 +1,11 @@
+CREATE TABLE sessions
+(
+    id         text PRIMARY KEY, -- SHA-256 of the session ID sent in the cookie
+    user_id    int4        NOT NULL REFERENCES users (id),
+    csrf_token text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL
+);
+
+CREATE INDEX sessions_user_id_idx ON sessions (user_id);
+CREATE INDEX sessions_expires_at_idx ON sessions (expires_at);
\ No newline at end of file
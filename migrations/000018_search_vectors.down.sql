@@ -0,0 +1,6 @@
+ALTER TABLE projects DROP COLUMN search_vector;
+ALTER TABLE users DROP COLUMN search_vector;
+ALTER TABLE teams DROP COLUMN search_vector;
+ALTER TABLE documents DROP COLUMN search_vector;
+
+DROP FUNCTION immutable_array_to_string(text[], text);
\ No newline at end of file
@@ -0,0 +1,34 @@
+-- array_to_string is not immutable, so it cannot be used in generated columns directly
+CREATE FUNCTION immutable_array_to_string(text[], text) RETURNS text
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE AS
+'SELECT array_to_string($1, $2)';
+
+ALTER TABLE projects
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(immutable_array_to_string(technologies, ' '), '')), 'B') ||
+        setweight(to_tsvector('russian', coalesce(description, '')), 'C')
+    ) STORED;
+
+ALTER TABLE users
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', username), 'A') ||
+        setweight(to_tsvector('simple', name || ' ' || surname), 'A')
+    ) STORED;
+
+ALTER TABLE teams
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', title), 'A') ||
+        setweight(to_tsvector('russian', description), 'C')
+    ) STORED;
+
+-- Split file names like "annual_report-2023.pdf" into separate words
+ALTER TABLE documents
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        to_tsvector('simple', translate(filename, '._-', '   '))
+    ) STORED;
+
+CREATE INDEX projects_search_vector_idx ON projects USING gin (search_vector);
+CREATE INDEX users_search_vector_idx ON users USING gin (search_vector);
+CREATE INDEX teams_search_vector_idx ON teams USING gin (search_vector);
+CREATE INDEX documents_search_vector_idx ON documents USING gin (search_vector);
\ No newline at end of file
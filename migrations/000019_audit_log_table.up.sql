@@ -0,0 +1,14 @@
+CREATE TABLE audit_log
+(
+    id          bigserial PRIMARY KEY,
+    actor_id    int4        REFERENCES users (id), -- Empty for events recorded by the application itself
+    action      text        NOT NULL,
+    entity_type text        NOT NULL,
+    entity_id   int4        NOT NULL,
+    diff        jsonb       NOT NULL DEFAULT '{}',
+    created_at  timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX audit_log_actor_id_idx ON audit_log (actor_id);
+CREATE INDEX audit_log_entity_idx ON audit_log (entity_type, entity_id);
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at);
\ No newline at end of file
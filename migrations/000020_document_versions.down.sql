@@ -0,0 +1,4 @@
+DROP TABLE document_versions;
+
+ALTER TABLE documents
+    DROP COLUMN version;
\ No newline at end of file
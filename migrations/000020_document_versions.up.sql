@@ -0,0 +1,20 @@
+ALTER TABLE documents
+    ADD COLUMN version int4 NOT NULL DEFAULT 1;
+
+-- Every upload is kept as a version, documents row holds the current one
+CREATE TABLE document_versions
+(
+    document_id int4        NOT NULL REFERENCES documents (id) ON DELETE CASCADE,
+    version     int4        NOT NULL,
+    filename    text        NOT NULL,
+    file_id     text        NOT NULL,
+    user_id     int4        NOT NULL REFERENCES users (id),
+    mime        text        NOT NULL,
+    size        int4        NOT NULL,
+    created_at  timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (document_id, version)
+);
+
+INSERT INTO document_versions(document_id, version, filename, file_id, user_id, mime, size, created_at)
+SELECT id, 1, filename, file_id, user_id, mime, size, created_at
+FROM documents;
\ No newline at end of file
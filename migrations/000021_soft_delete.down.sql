@@ -0,0 +1,12 @@
+DROP INDEX documents_deleted_at_idx;
+DROP INDEX projects_deleted_at_idx;
+
+ALTER TABLE project_participants
+    DROP CONSTRAINT project_participants_project_id_fkey,
+    ADD CONSTRAINT project_participants_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id);
+
+ALTER TABLE documents
+    DROP COLUMN deleted_at;
+
+ALTER TABLE projects
+    DROP COLUMN deleted_at;
\ No newline at end of file
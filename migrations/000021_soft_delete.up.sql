@@ -0,0 +1,13 @@
+ALTER TABLE projects
+    ADD COLUMN deleted_at timestamptz;
+
+ALTER TABLE documents
+    ADD COLUMN deleted_at timestamptz;
+
+-- Purge of deleted projects removes their participants too
+ALTER TABLE project_participants
+    DROP CONSTRAINT project_participants_project_id_fkey,
+    ADD CONSTRAINT project_participants_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE;
+
+CREATE INDEX projects_deleted_at_idx ON projects (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX documents_deleted_at_idx ON documents (deleted_at) WHERE deleted_at IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE users
+    ADD COLUMN email_verified_at timestamptz;
+
+-- Single-use tokens sent to users by email, only their hashes are stored
+CREATE TABLE user_tokens
+(
+    token_hash text PRIMARY KEY,
+    user_id    int4        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    purpose    text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL
+);
+
+CREATE INDEX user_tokens_user_id_idx ON user_tokens (user_id, purpose);
\ No newline at end of file
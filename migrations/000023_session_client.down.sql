@@ -0,0 +1,4 @@
+ALTER TABLE sessions
+    DROP COLUMN ip,
+    DROP COLUMN user_agent,
+    DROP COLUMN last_seen_at;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE sessions
+    ADD COLUMN ip           text        NOT NULL DEFAULT '',
+    ADD COLUMN user_agent   text        NOT NULL DEFAULT '',
+    ADD COLUMN last_seen_at timestamptz NOT NULL DEFAULT now();
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- Personal access tokens of users for machine clients, only their hashes are stored
+CREATE TABLE api_tokens
+(
+    id           serial PRIMARY KEY,
+    user_id      int4        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name         text        NOT NULL,
+    token_hash   text        NOT NULL UNIQUE,
+    scopes       text[]      NOT NULL,
+    created_at   timestamptz NOT NULL DEFAULT now(),
+    expires_at   timestamptz,
+    last_used_at timestamptz
+);
+
+CREATE INDEX api_tokens_user_id_idx ON api_tokens (user_id);
\ No newline at end of file
@@ -0,0 +1,22 @@
+-- Accounts of external identity providers linked to users
+CREATE TABLE user_identities
+(
+    provider   text        NOT NULL,
+    subject    text        NOT NULL,
+    user_id    int4        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    email      text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX user_identities_user_id_idx ON user_identities (user_id);
+
+-- Sign-ins started with external identity providers, only hashes of states are stored
+CREATE TABLE oidc_states
+(
+    state_hash    text PRIMARY KEY,
+    provider      text        NOT NULL,
+    nonce         text        NOT NULL,
+    code_verifier text        NOT NULL,
+    expires_at    timestamptz NOT NULL
+);
\ No newline at end of file
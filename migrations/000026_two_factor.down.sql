@@ -0,0 +1,3 @@
+DROP TABLE two_factor_challenges;
+DROP TABLE recovery_codes;
+DROP TABLE user_totp;
\ No newline at end of file
@@ -0,0 +1,26 @@
+-- TOTP secrets of users, secrets are encrypted with the application key
+CREATE TABLE user_totp
+(
+    user_id    int4 PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    secret     text        NOT NULL,
+    last_step  int8        NOT NULL DEFAULT 0, -- Time step of the last used code, codes can not be reused
+    created_at timestamptz NOT NULL DEFAULT now(),
+    enabled_at timestamptz
+);
+
+CREATE TABLE recovery_codes
+(
+    user_id   int4 NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    code_hash text NOT NULL,
+    used_at   timestamptz,
+    PRIMARY KEY (user_id, code_hash)
+);
+
+-- Sign-ins waiting for the second factor
+CREATE TABLE two_factor_challenges
+(
+    token_hash text PRIMARY KEY,
+    user_id    int4        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    attempts   int4        NOT NULL DEFAULT 0,
+    expires_at timestamptz NOT NULL
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE sign_in_throttles;
\ No newline at end of file
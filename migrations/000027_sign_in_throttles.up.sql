@@ -0,0 +1,10 @@
+-- Failed sign in attempts of accounts, logins and IP addresses, shared by all application instances
+CREATE TABLE sign_in_throttles
+(
+    kind            text        NOT NULL, -- user, login or ip
+    subject         text        NOT NULL, -- User identifier, login in lower case or IP address
+    failures        int4        NOT NULL DEFAULT 0,
+    last_failure_at timestamptz NOT NULL DEFAULT now(),
+    blocked_until   timestamptz,
+    PRIMARY KEY (kind, subject)
+);
\ No newline at end of file
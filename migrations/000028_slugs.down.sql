@@ -0,0 +1,4 @@
+ALTER TABLE teams
+    DROP COLUMN slug;
+ALTER TABLE projects
+    DROP COLUMN slug;
\ No newline at end of file
@@ -0,0 +1,64 @@
+ALTER TABLE projects
+    ADD COLUMN slug text;
+ALTER TABLE teams
+    ADD COLUMN slug text;
+
+-- make_slug makes slug the way internal/pkg/slug does: Cyrillic letters are transliterated,
+-- other characters separate words and the result is cut to 80 characters at a word boundary
+CREATE FUNCTION pg_temp.make_slug(title text, fallback text) RETURNS text
+    LANGUAGE sql IMMUTABLE AS
+$$
+SELECT coalesce(nullif(CASE WHEN length(s) > 80 THEN regexp_replace(left(s, 80), '-[^-]*$', '') ELSE s END, ''), fallback)
+FROM (SELECT trim(BOTH '-' FROM regexp_replace(
+        translate(
+            replace(replace(replace(replace(replace(replace(replace(replace(replace(replace(
+                lower(title),
+                'ж', 'zh'), 'х', 'kh'), 'ц', 'ts'), 'ч', 'ch'), 'щ', 'shch'),
+                'ш', 'sh'), 'ю', 'yu'), 'я', 'ya'), 'є', 'ye'), 'ї', 'yi'),
+            'абвгдеёзийклмнопрстуфыэіґўъь', 'abvgdeeziyklmnoprstufyeigu'),
+        '[^a-z0-9]+', '-', 'g')) AS s) t
+$$;
+
+-- Existing rows get slugs from their titles, repeated slugs get numeric suffix like new rows do
+DO
+$$
+DECLARE
+    r         record;
+    base      text;
+    candidate text;
+    i         int;
+BEGIN
+    FOR r IN SELECT id, title FROM projects ORDER BY id
+        LOOP
+            base := pg_temp.make_slug(r.title, 'project');
+            candidate := base;
+            i := 2;
+            WHILE EXISTS(SELECT 1 FROM projects WHERE slug = candidate)
+                LOOP
+                    candidate := rtrim(left(base, 80 - length('-' || i)), '-') || '-' || i;
+                    i := i + 1;
+                END LOOP;
+            UPDATE projects SET slug = candidate WHERE id = r.id;
+        END LOOP;
+
+    FOR r IN SELECT id, title FROM teams ORDER BY id
+        LOOP
+            base := pg_temp.make_slug(r.title, 'team');
+            candidate := base;
+            i := 2;
+            WHILE EXISTS(SELECT 1 FROM teams WHERE slug = candidate)
+                LOOP
+                    candidate := rtrim(left(base, 80 - length('-' || i)), '-') || '-' || i;
+                    i := i + 1;
+                END LOOP;
+            UPDATE teams SET slug = candidate WHERE id = r.id;
+        END LOOP;
+END
+$$;
+
+ALTER TABLE projects
+    ALTER COLUMN slug SET NOT NULL,
+    ADD CONSTRAINT projects_slug_key UNIQUE (slug);
+ALTER TABLE teams
+    ALTER COLUMN slug SET NOT NULL,
+    ADD CONSTRAINT teams_slug_key UNIQUE (slug);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE team_slug_redirects;
+DROP TABLE project_slug_redirects;
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- Former slugs of renamed projects and teams, requests by them are redirected to the current slug
+CREATE TABLE project_slug_redirects
+(
+    slug       text PRIMARY KEY,
+    project_id int4        NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE team_slug_redirects
+(
+    slug       text PRIMARY KEY,
+    team_id    int4        NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE team_translations;
+DROP TABLE project_translations;
\ No newline at end of file
@@ -0,0 +1,20 @@
+-- Titles and descriptions in languages other than the default one, which is kept in projects and teams
+CREATE TABLE project_translations
+(
+    project_id  int4        NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    locale      text        NOT NULL,
+    title       text        NOT NULL,
+    description text        NOT NULL DEFAULT '',
+    updated_at  timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (project_id, locale)
+);
+
+CREATE TABLE team_translations
+(
+    team_id     int4        NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    locale      text        NOT NULL,
+    title       text        NOT NULL,
+    description text        NOT NULL DEFAULT '',
+    updated_at  timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (team_id, locale)
+);
\ No newline at end of file
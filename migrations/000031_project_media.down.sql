@@ -0,0 +1 @@
+DROP TABLE project_media;
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- Ordered gallery of project images and video links for portfolio case studies
+CREATE TABLE project_media
+(
+    id         serial PRIMARY KEY,
+    project_id int4        NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    type       text        NOT NULL CHECK (type IN ('image', 'video')),
+    image_id   text,
+    video_url  text,
+    caption    text        NOT NULL DEFAULT '',
+    position   int4        NOT NULL,
+    is_cover   bool        NOT NULL DEFAULT false,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CHECK ((type = 'image' AND image_id IS NOT NULL) OR (type = 'video' AND video_url IS NOT NULL))
+);
+
+CREATE INDEX project_media_project_id_idx ON project_media (project_id, position);
+
+-- A project has at most one cover
+CREATE UNIQUE INDEX project_media_cover_idx ON project_media (project_id) WHERE is_cover;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- Arrays of projects keep canonical names, original spellings are not restored
+DROP TABLE project_technologies;
+DROP TABLE technology_names;
+DROP TABLE technologies;
\ No newline at end of file
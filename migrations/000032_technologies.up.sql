@@ -0,0 +1,77 @@
+-- Catalog of technologies replacing free-form projects.technologies.
+-- Names and aliases are matched case-insensitively, so differently spelled technologies are merged.
+CREATE TABLE technologies
+(
+    id         serial PRIMARY KEY,
+    name       text        NOT NULL,
+    aliases    text[]      NOT NULL DEFAULT '{}',
+    icon       text        NOT NULL DEFAULT '',
+    category   text        NOT NULL DEFAULT 'other',
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- Names and aliases of all technologies in lower case, so no spelling can belong to two technologies
+CREATE TABLE technology_names
+(
+    lower_name    text PRIMARY KEY,
+    technology_id int4 NOT NULL REFERENCES technologies (id) ON DELETE CASCADE
+);
+
+CREATE INDEX technology_names_technology_id_idx ON technology_names (technology_id);
+
+CREATE TABLE project_technologies
+(
+    project_id    int4 NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    technology_id int4 NOT NULL REFERENCES technologies (id) ON DELETE CASCADE,
+    PRIMARY KEY (project_id, technology_id)
+);
+
+CREATE INDEX project_technologies_technology_id_idx ON project_technologies (technology_id);
+
+-- Well-known spellings of the same technology
+INSERT INTO technologies(name, aliases, category)
+VALUES ('Go', '{golang}', 'language'),
+       ('JavaScript', '{js}', 'language'),
+       ('TypeScript', '{ts}', 'language'),
+       ('Python', '{py}', 'language'),
+       ('C#', '{csharp}', 'language'),
+       ('PostgreSQL', '{postgres,pg}', 'database'),
+       ('MongoDB', '{mongo}', 'database'),
+       ('Node.js', '{node,nodejs}', 'platform'),
+       ('React', '{reactjs,react.js}', 'framework'),
+       ('Vue.js', '{vue,vuejs}', 'framework'),
+       ('Kubernetes', '{k8s}', 'tool'),
+       ('Docker', '{}', 'tool');
+
+-- Other technologies get the most frequent spelling as the name
+INSERT INTO technologies(name)
+SELECT DISTINCT ON (lower(used.name)) used.name
+FROM (SELECT trim(t) AS name, count(*) AS n
+      FROM projects, unnest(technologies) AS t
+      WHERE trim(t) <> ''
+      GROUP BY trim(t)) used
+WHERE NOT EXISTS (SELECT 1
+                  FROM technologies c
+                  WHERE lower(c.name) = lower(used.name)
+                     OR lower(used.name) IN (SELECT lower(a) FROM unnest(c.aliases) a))
+ORDER BY lower(used.name), used.n DESC, used.name;
+
+INSERT INTO technology_names(lower_name, technology_id)
+SELECT DISTINCT lower(n), c.id
+FROM technologies c,
+     unnest(c.name || c.aliases) AS n;
+
+INSERT INTO project_technologies(project_id, technology_id)
+SELECT DISTINCT p.id, n.technology_id
+FROM projects p,
+     unnest(p.technologies) AS t,
+     technology_names n
+WHERE n.lower_name = lower(trim(t));
+
+-- The array keeps canonical names of the linked technologies for full-text search
+UPDATE projects p
+SET technologies = COALESCE((SELECT array_agg(c.name ORDER BY lower(c.name))
+                             FROM project_technologies pt
+                                      JOIN technologies c ON c.id = pt.technology_id
+                             WHERE pt.project_id = p.id), '{}')
+WHERE technologies IS NOT NULL;
\ No newline at end of file
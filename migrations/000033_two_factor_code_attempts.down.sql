@@ -0,0 +1,3 @@
+ALTER TABLE user_totp
+    DROP COLUMN code_attempts,
+    DROP COLUMN code_attempted_at;
\ No newline at end of file
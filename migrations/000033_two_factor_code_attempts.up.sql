@@ -0,0 +1,4 @@
+-- Failed confirmations of codes by signed in users, e.g. to disable two-factor authentication
+ALTER TABLE user_totp
+    ADD COLUMN code_attempts     int4 NOT NULL DEFAULT 0,
+    ADD COLUMN code_attempted_at timestamptz;
\ No newline at end of file